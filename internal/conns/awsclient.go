@@ -31,6 +31,7 @@ type AWSClient struct {
 	awsConfig                 *aws.Config
 	clients                   map[string]any
 	defaultTagsConfig         *tftags.DefaultConfig
+	deletionGuardConfig       *DeletionGuardConfig
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
 	ignoreTagsConfig          *tftags.IgnoreConfig
@@ -44,6 +45,8 @@ type AWSClient struct {
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
 	stsRegion                 string // From provider configuration.
+	tagPolicy                 tagPolicyLoader
+	tagPolicyConfig           *tftags.TagPolicyConfig
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -70,10 +73,28 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
+// DefaultTagsConfig returns the default tags configuration.
+// Within a resource's handlers the configuration resolved for that resource (see ResourceDefaultTagsConfig) is returned.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		return inContext.DefaultConfig
+	}
+
 	return c.defaultTagsConfig
 }
 
+// ResourceDefaultTagsConfig returns the default tags configuration for a resource of the specified type,
+// with any template variables in tag values resolved.
+// nil is returned if the resource skips default tags.
+func (c *AWSClient) ResourceDefaultTagsConfig(ctx context.Context, typeName string, skip bool) *tftags.DefaultConfig {
+	return c.defaultTagsConfig.ForResource(skip, map[string]string{
+		tftags.DefaultTagsTemplateVariableAccountID:    c.AccountID(ctx),
+		tftags.DefaultTagsTemplateVariablePartition:    c.Partition(ctx),
+		tftags.DefaultTagsTemplateVariableRegion:       c.Region(ctx),
+		tftags.DefaultTagsTemplateVariableResourceType: typeName,
+	})
+}
+
 func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
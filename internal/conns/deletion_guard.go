@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	// Environment variable that, when set to a true value, allows the deletion of resources protected by the deletion guard.
+	DeletionGuardOverrideEnvVar = "TF_AWS_DELETION_GUARD_OVERRIDE"
+)
+
+// DeletionGuardConfig contains the selectors for resources that must not be deleted.
+type DeletionGuardConfig struct {
+	// ResourceTypes are the Terraform resource type names, e.g. `aws_db_instance`, whose resources are protected.
+	ResourceTypes []string
+	// Tags protect any resource with at least one matching tag key and value.
+	Tags tftags.KeyValueTags
+}
+
+// Protects returns whether a resource of the specified type and with the specified tags is protected from deletion.
+func (c *DeletionGuardConfig) Protects(typeName string, tags tftags.KeyValueTags) bool {
+	if c == nil {
+		return false
+	}
+
+	if slices.Contains(c.ResourceTypes, typeName) {
+		return true
+	}
+
+	for k, v := range c.Tags {
+		if tags.KeyExists(k) && tags.KeyTagData(k).ValueString() == v.ValueString() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckDeletionAllowed returns an error if deletion of the specified resource is prevented by the deletion guard.
+func (c *AWSClient) CheckDeletionAllowed(ctx context.Context, typeName, id string, tags tftags.KeyValueTags) error {
+	if !c.DeletionGuardConfig(ctx).Protects(typeName, tags) {
+		return nil
+	}
+
+	if v, err := strconv.ParseBool(os.Getenv(DeletionGuardOverrideEnvVar)); err == nil && v {
+		return nil
+	}
+
+	resource := typeName
+	if id != "" {
+		resource = fmt.Sprintf("%s (%s)", typeName, id)
+	}
+
+	return fmt.Errorf("deleting %s is prevented by the provider deletion_guard configuration; set the %s environment variable to true to allow deletion", resource, DeletionGuardOverrideEnvVar)
+}
+
+// DeletionGuardConfig returns the deletion guard configuration.
+// A nil value indicates that the deletion guard is disabled.
+func (c *AWSClient) DeletionGuardConfig(context.Context) *DeletionGuardConfig {
+	return c.deletionGuardConfig
+}
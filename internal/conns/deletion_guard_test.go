@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestDeletionGuardConfigProtects(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	config := &conns.DeletionGuardConfig{
+		ResourceTypes: []string{"aws_db_instance", "aws_kms_key"},
+		Tags: tftags.New(ctx, map[string]string{
+			"protected": "true",
+		}),
+	}
+
+	testCases := map[string]struct {
+		config   *conns.DeletionGuardConfig
+		typeName string
+		tags     tftags.KeyValueTags
+		want     bool
+	}{
+		"nil config": {
+			typeName: "aws_db_instance",
+		},
+		"resource type": {
+			config:   config,
+			typeName: "aws_kms_key",
+			want:     true,
+		},
+		"other resource type": {
+			config:   config,
+			typeName: "aws_kms_alias",
+		},
+		"matching tag": {
+			config:   config,
+			typeName: "aws_s3_bucket",
+			tags: tftags.New(ctx, map[string]string{
+				"Name":      "test",
+				"protected": "true",
+			}),
+			want: true,
+		},
+		"tag value mismatch": {
+			config:   config,
+			typeName: "aws_s3_bucket",
+			tags: tftags.New(ctx, map[string]string{
+				"protected": "false",
+			}),
+		},
+		"no tags": {
+			config:   config,
+			typeName: "aws_s3_bucket",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.config.Protects(testCase.typeName, testCase.tags), testCase.want; got != want {
+				t.Errorf("Protects() = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// tagPolicyLoader loads the effective tag policy at most once per provider instance.
+type tagPolicyLoader struct {
+	once   sync.Once
+	policy *tftags.TagPolicy
+	err    error
+}
+
+// TagPolicyConfig returns the tag policy compliance configuration.
+// A nil value indicates that tag policy compliance checking is disabled.
+func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.TagPolicyConfig {
+	return c.tagPolicyConfig
+}
+
+// TagPolicy returns the effective tag policy, loading it on first use.
+// A nil policy with no error is returned if tag policy compliance checking is disabled or no tag policy is in effect.
+func (c *AWSClient) TagPolicy(ctx context.Context) (*tftags.TagPolicy, error) {
+	config := c.TagPolicyConfig(ctx)
+	if config == nil {
+		return nil, nil
+	}
+
+	c.tagPolicy.once.Do(func() {
+		var content string
+
+		if config.PolicyFile != "" {
+			b, err := os.ReadFile(config.PolicyFile)
+			if err != nil {
+				c.tagPolicy.err = fmt.Errorf("reading tag policy file (%s): %w", config.PolicyFile, err)
+				return
+			}
+
+			content = string(b)
+		} else {
+			v, err := findEffectiveTagPolicyContent(ctx, c.OrganizationsClient(ctx))
+			if err != nil {
+				c.tagPolicy.err = fmt.Errorf("reading effective tag policy: %w", err)
+				return
+			}
+
+			if v == "" {
+				tflog.Info(ctx, "No effective tag policy found")
+				return
+			}
+
+			content = v
+		}
+
+		c.tagPolicy.policy, c.tagPolicy.err = tftags.ParseTagPolicy(content)
+	})
+
+	return c.tagPolicy.policy, c.tagPolicy.err
+}
+
+func findEffectiveTagPolicyContent(ctx context.Context, conn *organizations.Client) (string, error) {
+	input := organizations.DescribeEffectivePolicyInput{
+		PolicyType: awstypes.EffectivePolicyTypeTagPolicy,
+	}
+
+	output, err := conn.DescribeEffectivePolicy(ctx, &input)
+
+	if errs.IsA[*awstypes.EffectivePolicyNotFoundException](err) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return "", nil
+	}
+
+	return aws.ToString(output.EffectivePolicy.PolicyContent), nil
+}
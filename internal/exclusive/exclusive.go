@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package exclusive
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// Reconciler keeps a remote collection in sync with a configured collection.
+// Add is called for each configured member not present remotely and Remove for
+// each remote member not present in configuration. Adds are applied before removals
+// so that a parent is never left with an empty collection mid-update.
+type Reconciler[T any] struct {
+	// Equal reports whether two members are the same.
+	Equal func(T, T) bool
+	// Add creates a configured member that is missing remotely.
+	Add func(context.Context, T) error
+	// Remove deletes a remote member that is not configured.
+	Remove func(context.Context, T) error
+}
+
+// Sync reconciles have (the remote members) with want (the configured members).
+// Processing stops at the first error.
+func (r Reconciler[T]) Sync(ctx context.Context, have, want []T) error {
+	add, remove, _ := intflex.DiffSlices(have, slices.Clone(want), r.Equal)
+
+	for _, v := range add {
+		if err := r.Add(ctx, v); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range remove {
+		if err := r.Remove(ctx, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Sync reconciles collections of comparable members.
+func Sync[T comparable](ctx context.Context, have, want []T, add, remove func(context.Context, T) error) error {
+	r := Reconciler[T]{
+		Equal:  func(v1, v2 T) bool { return v1 == v2 },
+		Add:    add,
+		Remove: remove,
+	}
+
+	return r.Sync(ctx, have, want)
+}
+
+// NotManagedError is returned when a configured member is missing remotely and
+// cannot be created by the exclusive resource, because it is owned by another resource.
+type NotManagedError struct {
+	Kind string
+	ID   string
+}
+
+func (e *NotManagedError) Error() string {
+	return fmt.Sprintf("%s (%s) not found; it must be created by its own resource before it can be listed", e.Kind, e.ID)
+}
+
+// AddNotSupported returns an Add function that fails for every member.
+// It is used by exclusive resources that only remove unlisted members.
+func AddNotSupported(kind string) func(context.Context, string) error {
+	return func(_ context.Context, id string) error {
+		return &NotManagedError{Kind: kind, ID: id}
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package exclusive_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
+)
+
+func TestSync(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		have, want  []string
+		wantAdded   []string
+		wantRemoved []string
+	}{
+		"empty": {},
+		"no changes": {
+			have: []string{"a", "b"},
+			want: []string{"b", "a"},
+		},
+		"add": {
+			have:      []string{"a"},
+			want:      []string{"a", "b", "c"},
+			wantAdded: []string{"b", "c"},
+		},
+		"remove": {
+			have:        []string{"a", "b", "c"},
+			want:        []string{"b"},
+			wantRemoved: []string{"a", "c"},
+		},
+		"replace all": {
+			have:        []string{"a", "b"},
+			want:        []string{"c"},
+			wantAdded:   []string{"c"},
+			wantRemoved: []string{"a", "b"},
+		},
+		"remove all": {
+			have:        []string{"a", "b"},
+			wantRemoved: []string{"a", "b"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var added, removed []string
+			add := func(_ context.Context, v string) error {
+				added = append(added, v)
+				return nil
+			}
+			remove := func(_ context.Context, v string) error {
+				removed = append(removed, v)
+				return nil
+			}
+
+			if err := exclusive.Sync(context.Background(), testCase.have, testCase.want, add, remove); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			slices.Sort(added)
+			slices.Sort(removed)
+
+			if diff := cmp.Diff(added, testCase.wantAdded); diff != "" {
+				t.Errorf("unexpected added diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(removed, testCase.wantRemoved); diff != "" {
+				t.Errorf("unexpected removed diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSync_error(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errTest := errors.New("test")
+	var removed []string
+	remove := func(_ context.Context, v string) error {
+		removed = append(removed, v)
+		return nil
+	}
+
+	err := exclusive.Sync(ctx, []string{"a"}, []string{"b"}, func(context.Context, string) error { return errTest }, remove)
+	if !errors.Is(err, errTest) {
+		t.Fatalf("expected %q, got %v", errTest, err)
+	}
+	if len(removed) != 0 {
+		t.Errorf("removals applied after failed add: %v", removed)
+	}
+}
+
+func TestReconciler_equal(t *testing.T) {
+	t.Parallel()
+
+	type member struct {
+		name, value string
+	}
+
+	var added, removed []member
+	r := exclusive.Reconciler[member]{
+		Equal: func(v1, v2 member) bool { return v1.name == v2.name },
+		Add: func(_ context.Context, v member) error {
+			added = append(added, v)
+			return nil
+		},
+		Remove: func(_ context.Context, v member) error {
+			removed = append(removed, v)
+			return nil
+		},
+	}
+
+	have := []member{{"a", "1"}, {"b", "2"}}
+	want := []member{{"a", "changed"}, {"c", "3"}}
+
+	if err := r.Sync(context.Background(), have, want); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(added, []member{{"c", "3"}}, cmp.AllowUnexported(member{})); diff != "" {
+		t.Errorf("unexpected added diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(removed, []member{{"b", "2"}}, cmp.AllowUnexported(member{})); diff != "" {
+		t.Errorf("unexpected removed diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAddNotSupported(t *testing.T) {
+	t.Parallel()
+
+	err := exclusive.AddNotSupported("Lambda permission")(context.Background(), "sid-1")
+
+	var nme *exclusive.NotManagedError
+	if !errors.As(err, &nme) {
+		t.Fatalf("expected NotManagedError, got %v", err)
+	}
+	if nme.ID != "sid-1" {
+		t.Errorf("unexpected ID %q", nme.ID)
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type writeOnlyAttrGetter interface {
+	GetAttribute(context.Context, path.Path, any) diag.Diagnostics
+}
+
+// GetWriteOnlyStringValue returns the string value of the write-only attribute from the config.
+// Write-only values are never persisted to plan or state, so config must be the request's tfsdk.Config.
+// A null or unknown value is returned as the empty string.
+func GetWriteOnlyStringValue(ctx context.Context, config writeOnlyAttrGetter, path path.Path) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var valueWO types.String
+	diags.Append(config.GetAttribute(ctx, path, &valueWO)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	return valueWO.ValueString(), diags
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+func TestGetWriteOnlyStringValue(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password_wo": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+			},
+		},
+	}
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"password_wo": tftypes.String,
+		},
+	}
+
+	type testCase struct {
+		input         tftypes.Value
+		path          path.Path
+		expected      string
+		expectedError bool
+	}
+	tests := map[string]testCase{
+		"value": {
+			input:    tftypes.NewValue(tftypes.String, "secret"),
+			path:     path.Root("password_wo"),
+			expected: "secret",
+		},
+		"null value": {
+			input:    tftypes.NewValue(tftypes.String, nil),
+			path:     path.Root("password_wo"),
+			expected: "",
+		},
+		"unknown value": {
+			input:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			path:     path.Root("password_wo"),
+			expected: "",
+		},
+		"invalid path": {
+			input:         tftypes.NewValue(tftypes.String, "secret"),
+			path:          path.Root("missing"),
+			expectedError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			config := tfsdk.Config{
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"password_wo": test.input,
+				}),
+				Schema: testSchema,
+			}
+
+			got, diags := flex.GetWriteOnlyStringValue(ctx, config, test.path)
+
+			if got, want := diags.HasError(), test.expectedError; got != want {
+				t.Fatalf("unexpected error: got %t, want %t: %v", got, want, diags)
+			}
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
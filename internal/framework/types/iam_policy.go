@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 )
 
 var (
@@ -170,5 +171,18 @@ func (v IAMPolicy) ValidateAttribute(ctx context.Context, req xattr.ValidateAttr
 				"Path: "+req.Path.String()+"\n"+
 				"Value: "+v.ValueString(),
 		)
+
+		return
+	}
+
+	// Actions and condition keys are checked against the IAM catalog. As the catalog may lag behind AWS, problems are warnings.
+	findings, err := iampolicy.Lint(v.ValueString())
+
+	if err != nil {
+		return
+	}
+
+	for _, finding := range findings {
+		resp.Diagnostics.AddAttributeWarning(req.Path, finding.Summary, finding.String())
 	}
 }
@@ -0,0 +1,533 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = eventPatternMatchesFunction{}
+
+func NewEventPatternMatchesFunction() function.Function {
+	return &eventPatternMatchesFunction{}
+}
+
+type eventPatternMatchesFunction struct{}
+
+func (f eventPatternMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "event_pattern_matches"
+}
+
+func (f eventPatternMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "event_pattern_matches Function",
+		MarkdownDescription: "Evaluates whether an Amazon EventBridge event pattern matches an event. This " +
+			"function can be used to test rule routing without making any AWS API calls.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "Event pattern in JSON format",
+			},
+			function.StringParameter{
+				Name:                "event",
+				MarkdownDescription: "Event in JSON format",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f eventPatternMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, event string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &event))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := eventPatternMatches(pattern, event)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// Event pattern reference:
+// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns.html
+// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-create-pattern-operators.html
+
+const (
+	eventPatternOperatorAnythingBut      = "anything-but"
+	eventPatternOperatorCIDR             = "cidr"
+	eventPatternOperatorEqualsIgnoreCase = "equals-ignore-case"
+	eventPatternOperatorExists           = "exists"
+	eventPatternOperatorNumeric          = "numeric"
+	eventPatternOperatorOr               = "$or"
+	eventPatternOperatorPrefix           = "prefix"
+	eventPatternOperatorSuffix           = "suffix"
+	eventPatternOperatorWildcard         = "wildcard"
+)
+
+var eventPatternNumericOperators = []string{"<", "<=", "=", ">", ">="}
+
+// eventPatternMatches reports whether the event pattern matches the event.
+func eventPatternMatches(pattern, event string) (bool, error) {
+	var p map[string]any
+
+	if err := json.Unmarshal([]byte(pattern), &p); err != nil {
+		return false, fmt.Errorf("pattern must be a JSON object: %w", err)
+	}
+
+	if err := validateEventPattern(p); err != nil {
+		return false, fmt.Errorf("invalid pattern: %w", err)
+	}
+
+	var e map[string]any
+
+	if err := json.Unmarshal([]byte(event), &e); err != nil {
+		return false, fmt.Errorf("event must be a JSON object: %w", err)
+	}
+
+	return matchEventPatternObject(p, e), nil
+}
+
+func validateEventPattern(p map[string]any) error {
+	if len(p) == 0 {
+		return errors.New("pattern must not be empty")
+	}
+
+	for k, v := range p {
+		if k == eventPatternOperatorOr {
+			alternatives, ok := v.([]any)
+
+			if !ok || len(alternatives) < 2 {
+				return fmt.Errorf("%s: must be an array of at least 2 patterns", k)
+			}
+
+			for _, v := range alternatives {
+				v, ok := v.(map[string]any)
+
+				if !ok {
+					return fmt.Errorf("%s: must be an array of at least 2 patterns", k)
+				}
+
+				if err := validateEventPattern(v); err != nil {
+					return fmt.Errorf("%s: %w", k, err)
+				}
+			}
+
+			continue
+		}
+
+		switch v := v.(type) {
+		case map[string]any:
+			if err := validateEventPattern(v); err != nil {
+				return fmt.Errorf("%s.%w", k, err)
+			}
+		case []any:
+			if len(v) == 0 {
+				return fmt.Errorf("%s: must not be empty", k)
+			}
+
+			for _, v := range v {
+				if err := validateEventPatternMatcher(v); err != nil {
+					return fmt.Errorf("%s: %w", k, err)
+				}
+			}
+		default:
+			return fmt.Errorf("%s: must be an object or an array", k)
+		}
+	}
+
+	return nil
+}
+
+func validateEventPatternMatcher(v any) error {
+	m, ok := v.(map[string]any)
+
+	if !ok {
+		// Literal value.
+		return nil
+	}
+
+	if len(m) != 1 {
+		return errors.New("matcher must contain exactly one operator")
+	}
+
+	for op, operand := range m {
+		switch op {
+		case eventPatternOperatorAnythingBut:
+			return validateEventPatternAnythingBut(operand)
+		case eventPatternOperatorCIDR:
+			s, ok := operand.(string)
+			if !ok {
+				return fmt.Errorf("%s: must be a string", op)
+			}
+
+			if _, _, err := net.ParseCIDR(s); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		case eventPatternOperatorEqualsIgnoreCase, eventPatternOperatorWildcard:
+			if _, ok := operand.(string); !ok {
+				return fmt.Errorf("%s: must be a string", op)
+			}
+		case eventPatternOperatorExists:
+			if _, ok := operand.(bool); !ok {
+				return fmt.Errorf("%s: must be a boolean", op)
+			}
+		case eventPatternOperatorNumeric:
+			return validateEventPatternNumeric(operand)
+		case eventPatternOperatorPrefix, eventPatternOperatorSuffix:
+			if _, ok := operand.(string); ok {
+				return nil
+			}
+
+			if m, ok := operand.(map[string]any); ok && len(m) == 1 {
+				if _, ok := m[eventPatternOperatorEqualsIgnoreCase].(string); ok {
+					return nil
+				}
+			}
+
+			return fmt.Errorf("%s: must be a string or an %s object", op, eventPatternOperatorEqualsIgnoreCase)
+		default:
+			return fmt.Errorf("unsupported operator %q", op)
+		}
+	}
+
+	return nil
+}
+
+func validateEventPatternAnythingBut(operand any) error {
+	switch operand := operand.(type) {
+	case string, float64:
+	case []any:
+		if len(operand) == 0 {
+			return fmt.Errorf("%s: must not be empty", eventPatternOperatorAnythingBut)
+		}
+
+		for _, v := range operand {
+			switch v.(type) {
+			case string, float64:
+			default:
+				return fmt.Errorf("%s: values must be strings or numbers", eventPatternOperatorAnythingBut)
+			}
+		}
+	case map[string]any:
+		if len(operand) != 1 {
+			return fmt.Errorf("%s: must contain exactly one operator", eventPatternOperatorAnythingBut)
+		}
+
+		for op, v := range operand {
+			switch op {
+			case eventPatternOperatorPrefix, eventPatternOperatorSuffix:
+				if _, ok := v.(string); !ok {
+					return fmt.Errorf("%s: %s: must be a string", eventPatternOperatorAnythingBut, op)
+				}
+			case eventPatternOperatorEqualsIgnoreCase, eventPatternOperatorWildcard:
+				if _, err := eventPatternStrings(v); err != nil {
+					return fmt.Errorf("%s: %s: %w", eventPatternOperatorAnythingBut, op, err)
+				}
+			default:
+				return fmt.Errorf("%s: unsupported operator %q", eventPatternOperatorAnythingBut, op)
+			}
+		}
+	default:
+		return fmt.Errorf("%s: must be a string, a number, an array or an object", eventPatternOperatorAnythingBut)
+	}
+
+	return nil
+}
+
+func validateEventPatternNumeric(operand any) error {
+	conditions, ok := operand.([]any)
+
+	if !ok || len(conditions) == 0 || len(conditions)%2 != 0 || len(conditions) > 4 {
+		return fmt.Errorf("%s: must be an array of one or two operator and value pairs", eventPatternOperatorNumeric)
+	}
+
+	for i := 0; i < len(conditions); i += 2 {
+		if op, ok := conditions[i].(string); !ok || !slices.Contains(eventPatternNumericOperators, op) {
+			return fmt.Errorf("%s: operator must be one of %s", eventPatternOperatorNumeric, strings.Join(eventPatternNumericOperators, ", "))
+		}
+
+		if _, ok := conditions[i+1].(float64); !ok {
+			return fmt.Errorf("%s: value must be a number", eventPatternOperatorNumeric)
+		}
+	}
+
+	return nil
+}
+
+// eventPatternStrings returns a string or array of strings operand as a slice.
+func eventPatternStrings(v any) ([]string, error) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, nil
+	case []any:
+		var ss []string
+
+		for _, v := range v {
+			s, ok := v.(string)
+			if !ok {
+				return nil, errors.New("must be a string or an array of strings")
+			}
+
+			ss = append(ss, s)
+		}
+
+		return ss, nil
+	}
+
+	return nil, errors.New("must be a string or an array of strings")
+}
+
+func matchEventPatternObject(p map[string]any, e map[string]any) bool {
+	for k, pv := range p {
+		if k == eventPatternOperatorOr {
+			if !slices.ContainsFunc(pv.([]any), func(v any) bool {
+				return matchEventPatternObject(v.(map[string]any), e)
+			}) {
+				return false
+			}
+
+			continue
+		}
+
+		ev, present := e[k]
+
+		switch pv := pv.(type) {
+		case map[string]any:
+			if !present || !matchEventPatternNested(pv, ev) {
+				return false
+			}
+		case []any:
+			if !matchEventPatternField(pv, ev, present) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// matchEventPatternNested matches a nested pattern against an event value, any element of which matches if it is an array.
+func matchEventPatternNested(p map[string]any, ev any) bool {
+	switch ev := ev.(type) {
+	case map[string]any:
+		return matchEventPatternObject(p, ev)
+	case []any:
+		return slices.ContainsFunc(ev, func(v any) bool {
+			return matchEventPatternNested(p, v)
+		})
+	}
+
+	return false
+}
+
+// matchEventPatternField matches the matchers of a field against an event value, any element of which matches if it is an array.
+func matchEventPatternField(matchers []any, ev any, present bool) bool {
+	var values []any
+
+	if present {
+		if v, ok := ev.([]any); ok {
+			values = v
+		} else {
+			values = []any{ev}
+		}
+	}
+
+	for _, matcher := range matchers {
+		if m, ok := matcher.(map[string]any); ok {
+			if exists, ok := m[eventPatternOperatorExists].(bool); ok {
+				// Only leaf values exist.
+				leaf := present && !slices.ContainsFunc(values, func(v any) bool {
+					_, ok := v.(map[string]any)
+					return ok
+				})
+
+				if exists == leaf {
+					return true
+				}
+
+				continue
+			}
+		}
+
+		if slices.ContainsFunc(values, func(v any) bool {
+			return matchEventPatternValue(matcher, v)
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchEventPatternValue(matcher, v any) bool {
+	m, ok := matcher.(map[string]any)
+
+	if !ok {
+		return matcher == v
+	}
+
+	s, isString := v.(string)
+
+	for op, operand := range m {
+		switch op {
+		case eventPatternOperatorAnythingBut:
+			return matchEventPatternAnythingBut(operand, v)
+		case eventPatternOperatorCIDR:
+			if !isString {
+				return false
+			}
+
+			_, network, _ := net.ParseCIDR(operand.(string))
+			ip := net.ParseIP(s)
+
+			return ip != nil && network.Contains(ip)
+		case eventPatternOperatorEqualsIgnoreCase:
+			return isString && strings.EqualFold(s, operand.(string))
+		case eventPatternOperatorNumeric:
+			n, ok := v.(float64)
+
+			return ok && matchEventPatternNumeric(operand.([]any), n)
+		case eventPatternOperatorPrefix:
+			return isString && matchEventPatternAffix(operand, s, strings.HasPrefix)
+		case eventPatternOperatorSuffix:
+			return isString && matchEventPatternAffix(operand, s, strings.HasSuffix)
+		case eventPatternOperatorWildcard:
+			return isString && matchEventPatternWildcard(operand.(string), s)
+		}
+	}
+
+	return false
+}
+
+func matchEventPatternAnythingBut(operand, v any) bool {
+	switch operand := operand.(type) {
+	case []any:
+		return !slices.Contains(operand, v)
+	case map[string]any:
+		s, ok := v.(string)
+		if !ok {
+			return false
+		}
+
+		for op, v := range operand {
+			switch op {
+			case eventPatternOperatorPrefix:
+				return !strings.HasPrefix(s, v.(string))
+			case eventPatternOperatorSuffix:
+				return !strings.HasSuffix(s, v.(string))
+			case eventPatternOperatorEqualsIgnoreCase:
+				ss, _ := eventPatternStrings(v)
+
+				return !slices.ContainsFunc(ss, func(v string) bool {
+					return strings.EqualFold(s, v)
+				})
+			case eventPatternOperatorWildcard:
+				ss, _ := eventPatternStrings(v)
+
+				return !slices.ContainsFunc(ss, func(v string) bool {
+					return matchEventPatternWildcard(v, s)
+				})
+			}
+		}
+
+		return false
+	default:
+		return operand != v
+	}
+}
+
+func matchEventPatternAffix(operand any, s string, f func(string, string) bool) bool {
+	if affix, ok := operand.(string); ok {
+		return f(s, affix)
+	}
+
+	affix := operand.(map[string]any)[eventPatternOperatorEqualsIgnoreCase].(string)
+
+	return f(strings.ToLower(s), strings.ToLower(affix))
+}
+
+func matchEventPatternNumeric(conditions []any, n float64) bool {
+	for i := 0; i < len(conditions); i += 2 {
+		v := conditions[i+1].(float64)
+		var ok bool
+
+		switch conditions[i].(string) {
+		case "<":
+			ok = n < v
+		case "<=":
+			ok = n <= v
+		case "=":
+			ok = n == v
+		case ">":
+			ok = n > v
+		case ">=":
+			ok = n >= v
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchEventPatternWildcard matches a wildcard pattern, in which "*" matches any sequence of characters and "\" escapes the following character.
+func matchEventPatternWildcard(pattern, s string) bool {
+	// Split the pattern into the literal segments between unescaped wildcards.
+	var segments []string
+	var sb strings.Builder
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			sb.WriteByte(pattern[i])
+		case c == '*':
+			segments = append(segments, sb.String())
+			sb.Reset()
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	segments = append(segments, sb.String())
+
+	if len(segments) == 1 {
+		return s == segments[0]
+	}
+
+	first, last := segments[0], segments[len(segments)-1]
+
+	if !strings.HasPrefix(s, first) {
+		return false
+	}
+
+	s = s[len(first):]
+
+	for _, segment := range segments[1 : len(segments)-1] {
+		i := strings.Index(s, segment)
+		if i < 0 {
+			return false
+		}
+
+		s = s[i+len(segment):]
+	}
+
+	return strings.HasSuffix(s, last)
+}
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+const testEventPatternEvent = `{
+  "source": "aws.ec2",
+  "detail-type": "EC2 Instance State-change Notification",
+  "resources": ["arn:aws:ec2:us-east-1:123456789012:instance/i-1234567890abcdef0"],
+  "detail": {
+    "instance-id": "i-1234567890abcdef0",
+    "state": "running",
+    "source-ip": "10.0.0.42",
+    "cpu": 72.5,
+    "tags": [{"key": "Environment", "value": "Production"}],
+    "file": "images/photo.png",
+    "spot": false,
+    "reason": null
+  }
+}`
+
+func TestEventPatternMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern string
+		want    bool
+		wantErr bool
+	}{
+		"exact match":                {pattern: `{"source": ["aws.ec2"], "detail": {"state": ["pending", "running"]}}`, want: true},
+		"exact no match":             {pattern: `{"source": ["aws.s3"]}`},
+		"missing field":              {pattern: `{"detail": {"missing": ["x"]}}`},
+		"array value":                {pattern: `{"resources": [{"prefix": "arn:aws:ec2:"}]}`, want: true},
+		"nested array of objects":    {pattern: `{"detail": {"tags": {"key": ["Environment"], "value": [{"equals-ignore-case": "production"}]}}}`, want: true},
+		"boolean literal":            {pattern: `{"detail": {"spot": [false]}}`, want: true},
+		"null literal":               {pattern: `{"detail": {"reason": [null]}}`, want: true},
+		"number literal":             {pattern: `{"detail": {"cpu": [72.5]}}`, want: true},
+		"prefix":                     {pattern: `{"detail-type": [{"prefix": "EC2 Instance"}]}`, want: true},
+		"prefix ignore case":         {pattern: `{"detail-type": [{"prefix": {"equals-ignore-case": "ec2 instance"}}]}`, want: true},
+		"prefix no match":            {pattern: `{"detail-type": [{"prefix": "ec2 instance"}]}`},
+		"suffix":                     {pattern: `{"detail": {"file": [{"suffix": ".png"}]}}`, want: true},
+		"suffix ignore case":         {pattern: `{"detail": {"file": [{"suffix": {"equals-ignore-case": ".PNG"}}]}}`, want: true},
+		"equals ignore case":         {pattern: `{"detail": {"state": [{"equals-ignore-case": "RUNNING"}]}}`, want: true},
+		"anything-but":               {pattern: `{"detail": {"state": [{"anything-but": ["stopped", "terminated"]}]}}`, want: true},
+		"anything-but no match":      {pattern: `{"detail": {"state": [{"anything-but": "running"}]}}`},
+		"anything-but prefix":        {pattern: `{"detail": {"state": [{"anything-but": {"prefix": "run"}}]}}`},
+		"anything-but suffix":        {pattern: `{"detail": {"file": [{"anything-but": {"suffix": ".jpg"}}]}}`, want: true},
+		"anything-but wildcard":      {pattern: `{"detail": {"file": [{"anything-but": {"wildcard": ["images/*"]}}]}}`},
+		"anything-but missing field": {pattern: `{"detail": {"missing": [{"anything-but": "x"}]}}`},
+		"numeric range":              {pattern: `{"detail": {"cpu": [{"numeric": [">", 50, "<=", 80]}]}}`, want: true},
+		"numeric no match":           {pattern: `{"detail": {"cpu": [{"numeric": ["<", 50]}]}}`},
+		"numeric string value":       {pattern: `{"detail": {"state": [{"numeric": [">", 0]}]}}`},
+		"exists":                     {pattern: `{"detail": {"state": [{"exists": true}]}}`, want: true},
+		"exists object":              {pattern: `{"detail": [{"exists": true}]}`},
+		"not exists":                 {pattern: `{"detail": {"missing": [{"exists": false}]}}`, want: true},
+		"not exists present":         {pattern: `{"detail": {"state": [{"exists": false}]}}`},
+		"cidr":                       {pattern: `{"detail": {"source-ip": [{"cidr": "10.0.0.0/24"}]}}`, want: true},
+		"cidr no match":              {pattern: `{"detail": {"source-ip": [{"cidr": "10.0.1.0/24"}]}}`},
+		"wildcard":                   {pattern: `{"detail": {"file": [{"wildcard": "images/*.png"}]}}`, want: true},
+		"wildcard multiple":          {pattern: `{"detail": {"file": [{"wildcard": "*/ph*o.*"}]}}`, want: true},
+		"wildcard no match":          {pattern: `{"detail": {"file": [{"wildcard": "docs/*"}]}}`},
+		"wildcard escaped":           {pattern: `{"detail": {"file": [{"wildcard": "images/\\*.png"}]}}`},
+		"or":                         {pattern: `{"$or": [{"source": ["aws.s3"]}, {"detail": {"state": ["running"]}}]}`, want: true},
+		"or no match":                {pattern: `{"$or": [{"source": ["aws.s3"]}, {"detail": {"state": ["stopped"]}}]}`},
+		"nested or":                  {pattern: `{"detail": {"$or": [{"cpu": [{"numeric": [">", 90]}]}, {"state": ["running"]}]}}`, want: true},
+		"all fields must match":      {pattern: `{"source": ["aws.ec2"], "detail": {"state": ["stopped"]}}`},
+		"invalid JSON":               {pattern: `{`, wantErr: true},
+		"empty pattern":              {pattern: `{}`, wantErr: true},
+		"empty matchers":             {pattern: `{"source": []}`, wantErr: true},
+		"scalar field":               {pattern: `{"source": "aws.ec2"}`, wantErr: true},
+		"unsupported operator":       {pattern: `{"source": [{"contains": "ec2"}]}`, wantErr: true},
+		"invalid numeric":            {pattern: `{"detail": {"cpu": [{"numeric": ["!=", 1]}]}}`, wantErr: true},
+		"invalid cidr":               {pattern: `{"detail": {"source-ip": [{"cidr": "10.0.0.0/33"}]}}`, wantErr: true},
+		"invalid or":                 {pattern: `{"$or": [{"source": ["aws.ec2"]}]}`, wantErr: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tffunction.EventPatternMatches(testCase.pattern, testEventPatternEvent)
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestEventPatternMatchesFunction_match(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEventPatternMatchesFunctionConfig(`{"source": ["aws.ec2"], "detail": {"state": [{"anything-but": "terminated"}]}}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestEventPatternMatchesFunction_noMatch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEventPatternMatchesFunctionConfig(`{"source": ["aws.s3"]}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestEventPatternMatchesFunction_invalidPattern(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEventPatternMatchesFunctionConfig(`{"source": "aws.ec2"}`),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*pattern`),
+			},
+		},
+	})
+}
+
+func testEventPatternMatchesFunctionConfig(pattern string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::event_pattern_matches(%[1]q, %[2]q)
+}`, pattern, testEventPatternEvent)
+}
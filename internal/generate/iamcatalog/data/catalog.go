@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package data
+
+import (
+	_ "embed"
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+// Catalog is the set of IAM actions and condition keys of each AWS service,
+// generated from the IAM service authorization reference.
+type Catalog struct {
+	Services []*Service `json:"services"`
+
+	services map[string]*Service
+}
+
+type Service struct {
+	Prefix        string    `json:"prefix"`
+	Actions       []*Action `json:"actions"`
+	ConditionKeys []string  `json:"condition_keys"`
+
+	actions map[string]*Action
+}
+
+type Action struct {
+	Name          string   `json:"name"`
+	ConditionKeys []string `json:"condition_keys"`
+}
+
+//go:embed catalog_gen.json
+var catalogJSON []byte
+
+// ReadCatalog returns the embedded IAM action and condition key catalog.
+var ReadCatalog = sync.OnceValues(func() (*Catalog, error) {
+	catalog := &Catalog{}
+
+	if err := json.Unmarshal(catalogJSON, catalog); err != nil {
+		return nil, err
+	}
+
+	catalog.index()
+
+	return catalog, nil
+})
+
+// NewCatalog returns a catalog containing the specified services.
+func NewCatalog(services ...*Service) *Catalog {
+	catalog := &Catalog{
+		Services: services,
+	}
+
+	catalog.index()
+
+	return catalog
+}
+
+func (c *Catalog) index() {
+	c.services = make(map[string]*Service, len(c.Services))
+	for _, service := range c.Services {
+		service.actions = make(map[string]*Action, len(service.Actions))
+		for _, action := range service.Actions {
+			service.actions[strings.ToLower(action.Name)] = action
+		}
+		c.services[strings.ToLower(service.Prefix)] = service
+	}
+}
+
+// IsEmpty returns whether the catalog contains no services.
+func (c *Catalog) IsEmpty() bool {
+	return len(c.Services) == 0
+}
+
+// Service returns the service with the specified prefix, e.g. "s3". Service prefixes are case-insensitive.
+func (c *Catalog) Service(prefix string) (*Service, bool) {
+	service, ok := c.services[strings.ToLower(prefix)]
+	return service, ok
+}
+
+// Action returns the action with the specified name, e.g. "GetObject". Action names are case-insensitive.
+func (s *Service) Action(name string) (*Action, bool) {
+	action, ok := s.actions[strings.ToLower(name)]
+	return action, ok
+}
+
+// MatchActions returns the actions whose name matches the specified pattern.
+// The pattern may contain the `*` and `?` wildcards and is case-insensitive.
+func (s *Service) MatchActions(pattern string) []*Action {
+	var actions []*Action
+
+	pattern = strings.ToLower(pattern)
+	for _, action := range s.Actions {
+		if wildcardMatch(pattern, strings.ToLower(action.Name)) {
+			actions = append(actions, action)
+		}
+	}
+
+	return actions
+}
+
+// HasConditionKey returns whether the service defines the specified condition key.
+func (s *Service) HasConditionKey(key string) bool {
+	return conditionKeyIn(key, s.ConditionKeys)
+}
+
+// SupportsConditionKey returns whether the specified condition key can be used with the action.
+func (a *Action) SupportsConditionKey(key string) bool {
+	return conditionKeyIn(key, a.ConditionKeys)
+}
+
+// conditionKeyIn returns whether key matches any of the catalog condition keys.
+// Catalog condition keys may end in a template, e.g. `aws:RequestTag/${TagKey}`, which matches any non-empty suffix.
+func conditionKeyIn(key string, keys []string) bool {
+	key = strings.ToLower(key)
+	for _, v := range keys {
+		v = strings.ToLower(v)
+		if i := strings.IndexAny(v, "$<"); i >= 0 {
+			if prefix := v[:i]; strings.HasPrefix(key, prefix) && len(key) > len(prefix) {
+				return true
+			}
+			continue
+		}
+		if key == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// wildcardMatch reports whether s matches pattern, where `*` matches any sequence of characters and `?` matches any single character.
+func wildcardMatch(pattern, s string) bool {
+	p, i := 0, 0
+	star, match := -1, 0
+
+	for i < len(s) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == s[i]):
+			p++
+			i++
+		case p < len(pattern) && pattern[p] == '*':
+			star, match = p, i
+			p++
+		case star >= 0:
+			p = star + 1
+			match++
+			i = match
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
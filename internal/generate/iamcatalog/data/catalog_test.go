@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package data
+
+import (
+	"testing"
+)
+
+func TestReadCatalog(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ReadCatalog(); err != nil {
+		t.Fatalf("ReadCatalog() unexpected error: %s", err)
+	}
+}
+
+func TestWildcardMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern string
+		s       string
+		want    bool
+	}{
+		{"*", "", true},
+		{"*", "getobject", true},
+		{"get*", "getobject", true},
+		{"get*", "putobject", false},
+		{"*object", "getobject", true},
+		{"get*tag*", "getobjecttagging", true},
+		{"get?bject", "getobject", true},
+		{"get?bject", "getobjects", false},
+		{"getobject", "getobject", true},
+		{"", "getobject", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := wildcardMatch(testCase.pattern, testCase.s); got != testCase.want {
+			t.Errorf("wildcardMatch(%q, %q) = %t, want %t", testCase.pattern, testCase.s, got, testCase.want)
+		}
+	}
+}
+
+func TestConditionKeyIn(t *testing.T) {
+	t.Parallel()
+
+	keys := []string{"aws:RequestTag/${TagKey}", "s3:prefix", "s3:ExistingObjectTag/<key>"}
+
+	testCases := []struct {
+		key  string
+		want bool
+	}{
+		{"s3:prefix", true},
+		{"S3:Prefix", true},
+		{"s3:prefixes", false},
+		{"aws:RequestTag/Owner", true},
+		{"aws:RequestTag/", false},
+		{"s3:ExistingObjectTag/env", true},
+		{"s3:delimiter", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := conditionKeyIn(testCase.key, keys); got != testCase.want {
+			t.Errorf("conditionKeyIn(%q) = %t, want %t", testCase.key, got, testCase.want)
+		}
+	}
+}
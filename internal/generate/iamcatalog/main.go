@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/iamcatalog/data"
+)
+
+// The IAM service authorization reference.
+// See https://docs.aws.amazon.com/service-authorization/latest/reference/service-reference.html.
+const serviceReferenceURL = "https://servicereference.us-east-1.amazonaws.com/"
+
+type serviceReferenceListEntry struct {
+	Service string `json:"service"`
+	URL     string `json:"url"`
+}
+
+type serviceReference struct {
+	Name    string `json:"Name"`
+	Actions []struct {
+		Name                string   `json:"Name"`
+		ActionConditionKeys []string `json:"ActionConditionKeys"`
+		Resources           []struct {
+			Name string `json:"Name"`
+		} `json:"Resources"`
+	} `json:"Actions"`
+	ConditionKeys []struct {
+		Name string `json:"Name"`
+	} `json:"ConditionKeys"`
+	Resources []struct {
+		Name          string   `json:"Name"`
+		ConditionKeys []string `json:"ConditionKeys"`
+	} `json:"Resources"`
+}
+
+func main() {
+	const (
+		filename = `data/catalog_gen.json`
+	)
+	g := common.NewGenerator()
+	ctx := context.Background()
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	g.Infof("Generating internal/generate/iamcatalog/%s", filename)
+
+	var entries []serviceReferenceListEntry
+	if err := getJSON(ctx, client, serviceReferenceURL, &entries); err != nil {
+		g.Fatalf("reading IAM service reference list: %s", err)
+	}
+
+	catalog := data.Catalog{}
+
+	for _, entry := range entries {
+		var ref serviceReference
+		if err := getJSON(ctx, client, entry.URL, &ref); err != nil {
+			g.Fatalf("reading IAM service reference (%s): %s", entry.Service, err)
+		}
+
+		catalog.Services = append(catalog.Services, newService(entry.Service, &ref))
+	}
+
+	slices.SortFunc(catalog.Services, func(a, b *data.Service) int {
+		return cmp.Compare(a.Prefix, b.Prefix)
+	})
+
+	// An empty catalog silently disables linting, so never write one.
+	if catalog.IsEmpty() {
+		g.Fatalf("generating file (%s): IAM service reference list is empty", filename)
+	}
+	for _, service := range catalog.Services {
+		if len(service.Actions) == 0 {
+			g.Fatalf("generating file (%s): IAM service reference (%s) has no actions", filename, service.Prefix)
+		}
+	}
+
+	body, err := json.MarshalIndent(catalog, "", " ")
+
+	if err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	d := g.NewUnformattedFileDestination(filename)
+
+	if err := d.BufferBytes(append(body, '\n')); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+// newService flattens an IAM service reference into catalog form.
+// The condition keys supported by an action are the action's own condition keys plus those of the resource types it acts on.
+func newService(prefix string, ref *serviceReference) *data.Service {
+	resourceConditionKeys := make(map[string][]string)
+	for _, v := range ref.Resources {
+		resourceConditionKeys[v.Name] = v.ConditionKeys
+	}
+
+	service := &data.Service{
+		Prefix: strings.ToLower(prefix),
+	}
+
+	for _, v := range ref.ConditionKeys {
+		service.ConditionKeys = append(service.ConditionKeys, v.Name)
+	}
+	slices.Sort(service.ConditionKeys)
+
+	for _, v := range ref.Actions {
+		action := &data.Action{
+			Name:          v.Name,
+			ConditionKeys: slices.Clone(v.ActionConditionKeys),
+		}
+		for _, resource := range v.Resources {
+			action.ConditionKeys = append(action.ConditionKeys, resourceConditionKeys[resource.Name]...)
+		}
+		slices.Sort(action.ConditionKeys)
+		action.ConditionKeys = slices.Compact(action.ConditionKeys)
+
+		service.Actions = append(service.Actions, action)
+	}
+	slices.SortFunc(service.Actions, func(a, b *data.Action) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return service
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+	if err != nil {
+		return err
+	}
+
+	response, err := client.Do(request)
+
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, response.Status)
+	}
+
+	return json.NewDecoder(response.Body).Decode(v)
+}
@@ -14,6 +14,7 @@ import (
 	"go/parser"
 	"go/token"
 	"os"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -120,6 +121,10 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	TagsTaggingAPI          bool
+	ARNLookupService        string
+	ARNLookupResourceType   string
+	ARNLookupFinder         string
 }
 
 type ServiceDatum struct {
@@ -223,6 +228,45 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			if attr, ok := args.Keyword["resourceType"]; ok {
 				d.TagsResourceType = attr
 			}
+
+			if attr, ok := args.Keyword["taggingAPI"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid taggingAPI value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.TagsTaggingAPI = b
+				}
+			}
+		}
+	}
+
+	// Look for lookup-by-ARN annotations.
+	for _, line := range funcDecl.Doc.List {
+		line := line.Text
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "ARNLookup" {
+			args := common.ParseArgs(m[3])
+
+			if d.ARNLookupFinder != "" {
+				v.errs = append(v.errs, fmt.Errorf("multiple ARNLookup annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			attr, ok := args.Keyword["service"]
+			if !ok {
+				v.errs = append(v.errs, fmt.Errorf("no service in ARNLookup annotation: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+			d.ARNLookupService = attr
+
+			if attr, ok := args.Keyword["finder"]; ok {
+				d.ARNLookupFinder = attr
+			} else {
+				v.errs = append(v.errs, fmt.Errorf("no finder in ARNLookup annotation: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			if attr, ok := args.Keyword["resourceType"]; ok {
+				d.ARNLookupResourceType = attr
+			}
 		}
 	}
 
@@ -354,7 +398,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "ARNLookup", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
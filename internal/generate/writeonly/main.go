@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+// Checks that every Sensitive string argument declared in internal/service has a
+// write-only ("<name>_wo") variant in the same file.
+// Existing offenders are recorded in exclusions.txt; run with -update to rewrite it.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	servicesDir     = "../../service"
+	attrConstsFile  = "../../../names/attr_consts_gen.go"
+	exclusionsFile  = "exclusions.txt"
+	writeOnlySuffix = "_wo"
+)
+
+var update = flag.Bool("update", false, "rewrite the exclusions file with the current findings")
+
+// finding is a Sensitive string argument without a write-only variant.
+// Its key is "<service>/<file>:<attribute>" so that the exclusions file is stable across line changes.
+type finding struct {
+	file string
+	attr string
+	pos  token.Position
+}
+
+func (f finding) key() string {
+	return f.file + ":" + f.attr
+}
+
+func main() {
+	flag.Parse()
+
+	fmt.Println("Checking Sensitive arguments for write-only variants")
+
+	attrConsts, err := readAttrConsts(attrConstsFile)
+	if err != nil {
+		log.Fatalf("error reading %s: %s", attrConstsFile, err)
+	}
+
+	var findings []finding
+	fset := token.NewFileSet()
+
+	err = filepath.WalkDir(servicesDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		// Ephemeral resources are never persisted to state.
+		if strings.HasSuffix(path, "_ephemeral.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", path, err)
+		}
+
+		rel, err := filepath.Rel(servicesDir, path)
+		if err != nil {
+			return err
+		}
+
+		findings = append(findings, checkFile(fset, file, filepath.ToSlash(rel), attrConsts)...)
+
+		return nil
+	})
+
+	if err != nil {
+		log.Fatalf("error walking %s: %s", servicesDir, err)
+	}
+
+	if *update {
+		if err := writeExclusions(exclusionsFile, findings); err != nil {
+			log.Fatalf("error writing %s: %s", exclusionsFile, err)
+		}
+
+		return
+	}
+
+	exclusions, err := readExclusions(exclusionsFile)
+	if err != nil {
+		log.Fatalf("error reading %s: %s", exclusionsFile, err)
+	}
+
+	var failed bool
+	for _, f := range findings {
+		if _, ok := exclusions[f.key()]; ok {
+			continue
+		}
+
+		failed = true
+		fmt.Fprintf(os.Stderr, "%s: Sensitive argument %q has no write-only variant; add %q or an entry to %s\n", f.pos, f.attr, f.attr+writeOnlySuffix, exclusionsFile)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// checkFile returns the Sensitive string arguments in file that have no write-only variant.
+// An attribute declared anywhere in the same file with the "_wo" suffix counts as the variant,
+// as some resources declare it outside of the block that holds the original argument.
+func checkFile(fset *token.FileSet, file *ast.File, rel string, attrConsts map[string]string) []finding {
+	var candidates []finding
+	keys := make(map[string]struct{})
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+
+		name, ok := attributeName(kv.Key, attrConsts)
+		if !ok {
+			return true
+		}
+
+		keys[name] = struct{}{}
+
+		if isSensitiveStringArgument(kv.Value) && !strings.HasSuffix(name, writeOnlySuffix) {
+			candidates = append(candidates, finding{
+				file: rel,
+				attr: name,
+				pos:  fset.Position(kv.Pos()),
+			})
+		}
+
+		return true
+	})
+
+	var findings []finding
+	for _, c := range candidates {
+		if _, ok := keys[c.attr+writeOnlySuffix]; !ok {
+			findings = append(findings, c)
+		}
+	}
+
+	return findings
+}
+
+// attributeName resolves a map key to an attribute name.
+// Keys may be string literals or names.AttrXxx constants.
+func attributeName(expr ast.Expr, attrConsts map[string]string) (string, bool) {
+	switch v := expr.(type) {
+	case *ast.BasicLit:
+		if v.Kind != token.STRING {
+			return "", false
+		}
+
+		s, err := strconv.Unquote(v.Value)
+		if err != nil {
+			return "", false
+		}
+
+		return s, true
+
+	case *ast.SelectorExpr:
+		if x, ok := v.X.(*ast.Ident); ok && x.Name == "names" {
+			s, ok := attrConsts[v.Sel.Name]
+			return s, ok
+		}
+	}
+
+	return "", false
+}
+
+// isSensitiveStringArgument returns whether expr is an SDKv2 schema.Schema or a Framework schema.StringAttribute
+// literal for a configurable, Sensitive string attribute.
+func isSensitiveStringArgument(expr ast.Expr) bool {
+	if u, ok := expr.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		expr = u.X
+	}
+
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return false
+	}
+
+	fields := make(map[string]ast.Expr)
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		if ident, ok := kv.Key.(*ast.Ident); ok {
+			fields[ident.Name] = kv.Value
+		}
+	}
+
+	if !isTrue(fields["Sensitive"]) || isTrue(fields["WriteOnly"]) {
+		return false
+	}
+
+	if !isTrue(fields["Optional"]) && !isTrue(fields["Required"]) {
+		return false
+	}
+
+	switch typeName(lit.Type) {
+	case "schema.StringAttribute":
+		return true
+
+	case "schema.Schema", "":
+		// Elided types only occur inside map[string]*schema.Schema literals.
+		return typeName(fields["Type"]) == "schema.TypeString"
+	}
+
+	return false
+}
+
+func isTrue(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == "true"
+}
+
+func typeName(expr ast.Expr) string {
+	switch v := expr.(type) {
+	case *ast.SelectorExpr:
+		if x, ok := v.X.(*ast.Ident); ok {
+			return x.Name + "." + v.Sel.Name
+		}
+	case *ast.Ident:
+		return v.Name
+	}
+
+	return ""
+}
+
+// readAttrConsts returns the names.AttrXxx constant values keyed by constant name.
+func readAttrConsts(path string) (map[string]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	consts := make(map[string]string)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok || len(vs.Names) != 1 || len(vs.Values) != 1 {
+				continue
+			}
+
+			if lit, ok := vs.Values[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				if s, err := strconv.Unquote(lit.Value); err == nil {
+					consts[vs.Names[0].Name] = s
+				}
+			}
+		}
+	}
+
+	return consts, nil
+}
+
+func readExclusions(path string) (map[string]struct{}, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	exclusions := make(map[string]struct{})
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		exclusions[line] = struct{}{}
+	}
+
+	return exclusions, scanner.Err()
+}
+
+func writeExclusions(path string, findings []finding) error {
+	var keys []string
+	for _, f := range findings {
+		keys = append(keys, f.key())
+	}
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+
+	var sb strings.Builder
+	sb.WriteString("# Sensitive arguments without a write-only variant that predate the writeonly check.\n")
+	sb.WriteString("# Do not add new entries; add a \"<name>_wo\" argument instead.\n")
+	for _, k := range keys {
+		sb.WriteString(k)
+		sb.WriteString("\n")
+	}
+
+	return os.WriteFile(path, []byte(sb.String()), 0644)
+}
@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	iamcatalog "github.com/hashicorp/terraform-provider-aws/internal/generate/iamcatalog/data"
+)
+
+// Finding is a problem found by linting an IAM policy document.
+type Finding struct {
+	// Statement is the statement's Sid or, if not set, its index.
+	Statement string
+	Summary   string
+	Detail    string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("statement %s: %s", f.Statement, f.Detail)
+}
+
+// Lint checks the actions and condition keys of an IAM policy document against the embedded IAM catalog.
+func Lint(policy string) ([]Finding, error) {
+	catalog, err := iamcatalog.ReadCatalog()
+
+	if err != nil {
+		return nil, fmt.Errorf("reading IAM catalog: %w", err)
+	}
+
+	return LintWithCatalog(catalog, policy)
+}
+
+// LintWithCatalog checks the actions and condition keys of an IAM policy document against the specified IAM catalog.
+// The following are reported:
+//   - Actions for unknown services
+//   - Unknown actions
+//   - Action wildcards that match no actions
+//   - Condition keys not defined by the service
+//   - Condition keys not supported by any of the statement's actions of the same service
+//
+// Global (`aws:`) condition keys and services not in the catalog are not checked.
+func LintWithCatalog(catalog *iamcatalog.Catalog, policy string) ([]Finding, error) {
+	if catalog.IsEmpty() {
+		return nil, nil
+	}
+
+	var doc lintPolicyDocument
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+	for i, stmt := range doc.Statements {
+		name := stmt.Sid
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+
+		findings = append(findings, lintStatement(catalog, name, stmt)...)
+	}
+
+	return findings, nil
+}
+
+func lintStatement(catalog *iamcatalog.Catalog, name string, stmt lintPolicyStatement) []Finding {
+	var findings []Finding
+
+	// Known actions in the statement, by service prefix.
+	actions := make(map[string][]*iamcatalog.Action)
+	// Whether all of a service's actions are in scope, e.g. via NotAction or `s3:*`.
+	all := make(map[string]bool)
+
+	for _, v := range slices.Concat(stmt.Actions, stmt.NotActions) {
+		if v == "*" {
+			continue
+		}
+
+		prefix, pattern, ok := strings.Cut(v, ":")
+		if !ok || prefix == "" || pattern == "" {
+			findings = append(findings, Finding{
+				Statement: name,
+				Summary:   "Invalid IAM action",
+				Detail:    fmt.Sprintf("action %q is not of the form <service>:<action>", v),
+			})
+			continue
+		}
+
+		service, ok := catalog.Service(prefix)
+		if !ok {
+			findings = append(findings, Finding{
+				Statement: name,
+				Summary:   "Unknown IAM service",
+				Detail:    fmt.Sprintf("action %q: service prefix %q is not known", v, prefix),
+			})
+			continue
+		}
+		prefix = strings.ToLower(prefix)
+
+		if strings.ContainsAny(pattern, "*?") {
+			matches := service.MatchActions(pattern)
+			if len(matches) == 0 {
+				findings = append(findings, Finding{
+					Statement: name,
+					Summary:   "IAM action wildcard matches no actions",
+					Detail:    fmt.Sprintf("action %q does not match any %s actions", v, prefix),
+				})
+				continue
+			}
+			if pattern == "*" {
+				all[prefix] = true
+			}
+			actions[prefix] = append(actions[prefix], matches...)
+			continue
+		}
+
+		action, ok := service.Action(pattern)
+		if !ok {
+			findings = append(findings, Finding{
+				Statement: name,
+				Summary:   "Unknown IAM action",
+				Detail:    fmt.Sprintf("action %q is not a known %s action", v, prefix),
+			})
+			continue
+		}
+		actions[prefix] = append(actions[prefix], action)
+	}
+
+	if len(stmt.NotActions) > 0 {
+		for prefix := range actions {
+			all[prefix] = true
+		}
+	}
+
+	var keys []string
+	for _, condition := range stmt.Conditions {
+		for key := range condition {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+
+	for _, key := range keys {
+		prefix, _, ok := strings.Cut(key, ":")
+		if !ok {
+			findings = append(findings, Finding{
+				Statement: name,
+				Summary:   "Invalid IAM condition key",
+				Detail:    fmt.Sprintf("condition key %q is not of the form <service>:<key>", key),
+			})
+			continue
+		}
+		prefix = strings.ToLower(prefix)
+
+		if prefix == "aws" {
+			continue
+		}
+
+		service, ok := catalog.Service(prefix)
+		if !ok {
+			continue
+		}
+
+		if !service.HasConditionKey(key) {
+			findings = append(findings, Finding{
+				Statement: name,
+				Summary:   "Unknown IAM condition key",
+				Detail:    fmt.Sprintf("condition key %q is not a known %s condition key", key, prefix),
+			})
+			continue
+		}
+
+		// Condition keys of services without actions in the statement may be valid, e.g. `ec2:SourceInstanceARN`.
+		if all[prefix] || len(actions[prefix]) == 0 {
+			continue
+		}
+
+		if !slices.ContainsFunc(actions[prefix], func(action *iamcatalog.Action) bool {
+			return action.SupportsConditionKey(key)
+		}) {
+			findings = append(findings, Finding{
+				Statement: name,
+				Summary:   "IAM condition key not supported by actions",
+				Detail:    fmt.Sprintf("condition key %q is not supported by any of the statement's %s actions", key, prefix),
+			})
+		}
+	}
+
+	return findings
+}
+
+type lintPolicyDocument struct {
+	Statements lintPolicyStatements `json:"Statement"`
+}
+
+type lintPolicyStatement struct {
+	Sid        string                                `json:"Sid"`
+	Actions    lintStringOrSlice                     `json:"Action"`
+	NotActions lintStringOrSlice                     `json:"NotAction"`
+	Conditions map[string]map[string]json.RawMessage `json:"Condition"`
+}
+
+// lintPolicyStatements is a single statement or a list of statements.
+type lintPolicyStatements []lintPolicyStatement
+
+func (s *lintPolicyStatements) UnmarshalJSON(b []byte) error {
+	var stmt lintPolicyStatement
+	if err := json.Unmarshal(b, &stmt); err == nil {
+		*s = lintPolicyStatements{stmt}
+		return nil
+	}
+
+	var stmts []lintPolicyStatement
+	if err := json.Unmarshal(b, &stmts); err != nil {
+		return err
+	}
+	*s = stmts
+
+	return nil
+}
+
+// lintStringOrSlice is a single string or a list of strings.
+type lintStringOrSlice []string
+
+func (s *lintStringOrSlice) UnmarshalJSON(b []byte) error {
+	var v string
+	if err := json.Unmarshal(b, &v); err == nil {
+		*s = lintStringOrSlice{v}
+		return nil
+	}
+
+	var vs []string
+	if err := json.Unmarshal(b, &vs); err != nil {
+		return err
+	}
+	*s = vs
+
+	return nil
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	iamcatalog "github.com/hashicorp/terraform-provider-aws/internal/generate/iamcatalog/data"
+)
+
+func TestLintWithCatalog(t *testing.T) {
+	t.Parallel()
+
+	catalog := iamcatalog.NewCatalog(
+		&iamcatalog.Service{
+			Prefix: "s3",
+			Actions: []*iamcatalog.Action{
+				{
+					Name:          "GetObject",
+					ConditionKeys: []string{"s3:ExistingObjectTag/${TagKey}", "s3:TlsVersion"},
+				},
+				{
+					Name:          "ListBucket",
+					ConditionKeys: []string{"s3:prefix", "s3:TlsVersion"},
+				},
+				{
+					Name:          "PutObject",
+					ConditionKeys: []string{"s3:RequestObjectTag/${TagKey}", "s3:TlsVersion"},
+				},
+			},
+			ConditionKeys: []string{"s3:ExistingObjectTag/${TagKey}", "s3:RequestObjectTag/${TagKey}", "s3:TlsVersion", "s3:prefix"},
+		},
+		&iamcatalog.Service{
+			Prefix: "sts",
+			Actions: []*iamcatalog.Action{
+				{
+					Name: "GetCallerIdentity",
+				},
+			},
+		},
+	)
+
+	testCases := map[string]struct {
+		policy  string
+		want    []Finding
+		wantErr bool
+	}{
+		"invalid JSON": {
+			policy:  `not ok`,
+			wantErr: true,
+		},
+		"valid": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:GetObject", "s3:listbucket", "sts:*", "*"],
+      "Resource": "*",
+      "Condition": {
+        "StringLike": {"s3:prefix": "home/", "aws:PrincipalTag/team": "a"},
+        "StringEquals": {"s3:ExistingObjectTag/env": "prod", "ec2:SourceInstanceARN": "arn"}
+      }
+    }
+  ]
+}`,
+		},
+		"single statement": {
+			policy: `{"Statement": {"Sid": "S1", "Action": "s3:GetObjects", "Resource": "*"}}`,
+			want: []Finding{
+				{Statement: "S1", Summary: "Unknown IAM action", Detail: `action "s3:GetObjects" is not a known s3 action`},
+			},
+		},
+		"actions": {
+			policy: `{"Statement": [{"Action": ["s3", "nope:GetObject", "s3:Delete*", "s3:Get*", "s3:Put?bject"], "Resource": "*"}]}`,
+			want: []Finding{
+				{Statement: "0", Summary: "Invalid IAM action", Detail: `action "s3" is not of the form <service>:<action>`},
+				{Statement: "0", Summary: "Unknown IAM service", Detail: `action "nope:GetObject": service prefix "nope" is not known`},
+				{Statement: "0", Summary: "IAM action wildcard matches no actions", Detail: `action "s3:Delete*" does not match any s3 actions`},
+			},
+		},
+		"not actions": {
+			policy: `{"Statement": [{"NotAction": ["s3:GetObjectz"], "Resource": "*", "Condition": {"StringEquals": {"s3:prefix": "a"}}}]}`,
+			want: []Finding{
+				{Statement: "0", Summary: "Unknown IAM action", Detail: `action "s3:GetObjectz" is not a known s3 action`},
+			},
+		},
+		"condition keys": {
+			policy: `{"Statement": [{"Sid": "S1", "Action": ["s3:GetObject"], "Resource": "*", "Condition": {"StringEquals": {"s3:prefix": "a", "s3:Bogus": "b", "bogus": "c", "s3:ExistingObjectTag": "d"}}}]}`,
+			want: []Finding{
+				{Statement: "S1", Summary: "Invalid IAM condition key", Detail: `condition key "bogus" is not of the form <service>:<key>`},
+				{Statement: "S1", Summary: "Unknown IAM condition key", Detail: `condition key "s3:Bogus" is not a known s3 condition key`},
+				{Statement: "S1", Summary: "Unknown IAM condition key", Detail: `condition key "s3:ExistingObjectTag" is not a known s3 condition key`},
+				{Statement: "S1", Summary: "IAM condition key not supported by actions", Detail: `condition key "s3:prefix" is not supported by any of the statement's s3 actions`},
+			},
+		},
+		"service wildcard": {
+			policy: `{"Statement": [{"Action": "s3:*", "Resource": "*", "Condition": {"StringEquals": {"s3:prefix": "a"}}}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := LintWithCatalog(catalog, testCase.policy)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("LintWithCatalog() err %t, want %t", got, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLintWithCatalogEmpty(t *testing.T) {
+	t.Parallel()
+
+	got, err := LintWithCatalog(iamcatalog.NewCatalog(), `{"Statement": [{"Action": "s3:GetObjects"}]}`)
+
+	if err != nil {
+		t.Fatalf("LintWithCatalog() unexpected error: %s", err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("LintWithCatalog() = %v, want none", got)
+	}
+}
+
+// TestLint runs real actions and condition keys through the embedded IAM catalog.
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := iamcatalog.ReadCatalog()
+
+	if err != nil {
+		t.Fatalf("ReadCatalog() unexpected error: %s", err)
+	}
+
+	if catalog.IsEmpty() {
+		t.Fatal("embedded IAM catalog is empty, run `go generate ./internal/generate/iamcatalog`")
+	}
+
+	testCases := map[string]struct {
+		policy string
+		want   []Finding
+	}{
+		"valid": {
+			policy: `{"Statement": [{"Action": ["s3:GetObject", "s3:ListBucket"], "Resource": "*", "Condition": {"StringEquals": {"s3:ExistingObjectTag/env": "prod", "s3:prefix": "home/"}}}]}`,
+		},
+		"unknown action": {
+			policy: `{"Statement": [{"Sid": "S1", "Action": "s3:GetObjects", "Resource": "*"}]}`,
+			want: []Finding{
+				{Statement: "S1", Summary: "Unknown IAM action", Detail: `action "s3:GetObjects" is not a known s3 action`},
+			},
+		},
+		"condition key not supported by actions": {
+			policy: `{"Statement": [{"Sid": "S1", "Action": "s3:GetObject", "Resource": "*", "Condition": {"StringLike": {"s3:prefix": "home/"}}}]}`,
+			want: []Finding{
+				{Statement: "S1", Summary: "IAM condition key not supported by actions", Detail: `condition key "s3:prefix" is not supported by any of the statement's s3 actions`},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Lint(testCase.policy)
+
+			if err != nil {
+				t.Fatalf("Lint() unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// deletionGuardInterceptor prevents the deletion of resources selected by the provider's deletion_guard configuration.
+type deletionGuardInterceptor struct {
+	typeName string
+}
+
+func newDeletionGuardInterceptor(typeName string) interceptor {
+	return &deletionGuardInterceptor{
+		typeName: typeName,
+	}
+}
+
+func (r deletionGuardInterceptor) run(ctx context.Context, opts interceptorOptions) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	if c.DeletionGuardConfig(ctx) == nil {
+		return diags
+	}
+
+	switch d, when, why := opts.d, opts.when, opts.why; when {
+	case Before:
+		switch why {
+		case Delete:
+			var tags tftags.KeyValueTags
+			if state := d.GetRawState(); !state.IsNull() && state.Type().HasAttribute(names.AttrTagsAll) {
+				if v, ok := d.Get(names.AttrTagsAll).(map[string]interface{}); ok {
+					tags = tftags.New(ctx, v)
+				}
+			}
+
+			if err := c.CheckDeletionAllowed(ctx, r.typeName, d.Id(), tags); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return diags
+}
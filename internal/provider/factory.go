@@ -24,7 +24,9 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 	}
 
 	servers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider,
+		func() tfprotov5.ProviderServer {
+			return newPlanWarningsProviderServer(primary.GRPCProvider())
+		},
 		providerserver.NewProtocol5(fwprovider.New(primary)),
 	}
 
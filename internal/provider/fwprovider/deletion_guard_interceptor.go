@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// deletionGuardInterceptor prevents the deletion of resources selected by the provider's deletion_guard configuration.
+type deletionGuardInterceptor struct {
+	typeName string
+}
+
+func newDeletionGuardInterceptor(typeName string) resourceInterceptor {
+	return &deletionGuardInterceptor{
+		typeName: typeName,
+	}
+}
+
+func (r deletionGuardInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
+
+func (r deletionGuardInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
+
+func (r deletionGuardInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
+
+func (r deletionGuardInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) diag.Diagnostics {
+	c := opts.c
+	var diags diag.Diagnostics
+
+	if c.DeletionGuardConfig(ctx) == nil {
+		return diags
+	}
+
+	switch request, when := opts.request, opts.when; when {
+	case Before:
+		attributes := request.State.Schema.GetAttributes()
+
+		var id types.String
+		if _, ok := attributes[names.AttrID]; ok {
+			diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrID), &id)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		var tags tftags.KeyValueTags
+		if _, ok := attributes[names.AttrTagsAll]; ok {
+			var stateTagsAll tftags.Map
+			diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			tags = tftags.New(ctx, stateTagsAll)
+		}
+
+		if err := c.CheckDeletionAllowed(ctx, r.typeName, id.ValueString(), tags); err != nil {
+			diags.AddError("Deletion prevented", err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -265,7 +266,30 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 							ElementType: types.StringType,
 							Optional:    true,
 							Description: "Resource tags to default across all resources. " +
-								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
+								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`. " +
+								"Values can reference the `${resource_type}`, `${account_id}`, `${region}` and `${partition}` variables, resolved for each resource.",
+						},
+					},
+				},
+			},
+			"deletion_guard": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to prevent the deletion of selected resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, e.g. `aws_db_instance`, whose resources cannot be deleted. " +
+								"Deletion can be allowed by setting the " + conns.DeletionGuardOverrideEnvVar + " environment variable to `true`.",
+						},
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resources with any of these tags cannot be deleted. " +
+								"Deletion can be allowed by setting the " + conns.DeletionGuardOverrideEnvVar + " environment variable to `true`.",
 						},
 					},
 				},
@@ -284,6 +308,11 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 							Description: "Resource tag key prefixes to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 						},
+						"key_regexes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -293,6 +322,26 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 					},
 				},
 			},
+			"tag_policy_compliance": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to check resource tags against the effective AWS Organizations tag policy during plan.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"policy_file": schema.StringAttribute{
+							Optional: true,
+							Description: "File containing the effective tag policy. " +
+								"If not set, the effective tag policy is read using the Organizations DescribeEffectivePolicy API.",
+						},
+						"severity": schema.StringAttribute{
+							Optional: true,
+							Description: "Severity with which tag policy violations are reported. Valid values are `error` and `warning`. " +
+								"Defaults to `warning`. Can also be configured with the " + tftags.TagPolicyComplianceEnvVar + " environment variable.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -359,7 +408,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 					if c != nil {
-						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+						ctx = tftags.NewContext(ctx, c.ResourceDefaultTagsConfig(ctx, typeName, false), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
 						ctx = flex.RegisterLogger(ctx)
 					}
@@ -405,6 +454,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			typeName := v.TypeName
 			interceptors := resourceInterceptors{}
+			var hasSkipDefaultTags bool
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -429,18 +479,27 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", names.AttrTagsAll, typeName))
 					continue
 				}
+				// The resource can opt out of provider default_tags by declaring the `skip_default_tags` attribute.
+				_, hasSkipDefaultTags = schemaResponse.Schema.Attributes[tftags.SkipDefaultTagsAttributeName]
 
 				interceptors = append(interceptors, newTagsResourceInterceptor(v.Tags))
 			}
+			interceptors = append(interceptors, newDeletionGuardInterceptor(typeName))
 
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
+					var skipDefaultTags types.Bool
+					if getAttribute != nil && hasSkipDefaultTags {
+						// A null plan or state, e.g. on destroy, leaves the value null.
+						getAttribute(ctx, path.Root(tftags.SkipDefaultTagsAttributeName), &skipDefaultTags)
+					}
+
 					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 					if c != nil {
-						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
+						ctx = tftags.NewContext(ctx, c.ResourceDefaultTagsConfig(ctx, typeName, skipDefaultTags.ValueBool()), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
 						ctx = flex.RegisterLogger(ctx)
 					}
@@ -532,6 +591,7 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewEventPatternMatchesFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
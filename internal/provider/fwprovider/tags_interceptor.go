@@ -103,7 +103,7 @@ func (r tagsResourceInterceptor) create(ctx context.Context, opts interceptorOpt
 		return diags
 	}
 
-	sp, _, _, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
+	sp, serviceName, resourceName, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
 	if !ok {
 		return diags
 	}
@@ -122,6 +122,16 @@ func (r tagsResourceInterceptor) create(ctx context.Context, opts interceptorOpt
 		tags = tags.IgnoreSystem(sp.ServicePackageName())
 		tagsInContext.TagsIn = option.Some(tags)
 	case After:
+		if r.UsesTaggingAPI() {
+			if identifier := r.getIdentifier(ctx, response.State); identifier != "" {
+				if err := r.UpdateTags(ctx, sp, c, identifier, nil, tagsInContext.TagsIn.UnwrapOrDefault()); err != nil {
+					diags.AddError(fmt.Sprintf("setting tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
+
+					return diags
+				}
+			}
+		}
+
 		// Set values for unknowns.
 		// Remove any provider configured ignore_tags and system tags from those passed to the service API.
 		// Computed tags_all include any provider configured default_tags.
@@ -383,6 +383,11 @@ func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.Modif
 		if response.Diagnostics.HasError() {
 			return
 		}
+
+		w.checkTagPolicyCompliance(ctx, request, response)
+		if response.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
@@ -476,3 +481,56 @@ func (w *wrappedResource) setTagsAll(ctx context.Context, request resource.Modif
 		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
 	}
 }
+
+// checkTagPolicyCompliance is a plan modifier that checks the planned tags against the effective tag policy.
+// Only new resources and resources whose tags change are checked.
+func (w *wrappedResource) checkTagPolicyCompliance(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	config := w.meta.TagPolicyConfig(ctx)
+	if config == nil {
+		return
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planTags tftags.Map
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !planTags.IsWhollyKnown() {
+		return
+	}
+
+	allTags := w.meta.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags))
+
+	if !request.State.Raw.IsNull() {
+		var stateTagsAll tftags.Map
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if tftags.New(ctx, stateTagsAll).Equal(allTags.IgnoreConfig(w.meta.IgnoreTagsConfig(ctx))) {
+			return
+		}
+	}
+
+	addDiagnostic := response.Diagnostics.AddAttributeWarning
+	if config.Severity == tftags.TagPolicySeverityError {
+		addDiagnostic = response.Diagnostics.AddAttributeError
+	}
+
+	policy, err := w.meta.TagPolicy(ctx)
+	if err != nil {
+		addDiagnostic(path.Root(names.AttrTags), "Checking tag policy compliance", err.Error())
+		return
+	}
+
+	for _, v := range policy.Check(allTags) {
+		addDiagnostic(path.Root(names.AttrTags), "Tags do not comply with tag policy", v)
+	}
+}
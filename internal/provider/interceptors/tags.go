@@ -26,11 +26,19 @@ func (w WithTaggingMethods) HasServicePackageResourceTags() bool {
 	return w.ServicePackageResourceTags != nil
 }
 
+// UsesTaggingAPI returns whether tags are listed and updated using the Resource Groups Tagging API.
+// Such resources cannot be tagged on creation, so tags are applied after the resource is created.
+func (w WithTaggingMethods) UsesTaggingAPI() bool {
+	return w.HasServicePackageResourceTags() && w.ServicePackageResourceTags.TaggingAPI
+}
+
 // If the service package has a generic resource list tags methods, call it.
 func (w WithTaggingMethods) ListTags(ctx context.Context, sp conns.ServicePackage, c *conns.AWSClient, identifier string) error {
 	var err error
 
-	if v, ok := sp.(tftags.ServiceTagLister); ok {
+	if w.ServicePackageResourceTags.TaggingAPI {
+		err = tftags.ResourceGroupsTaggingAPI{}.ListTags(ctx, c, identifier) // Sets tags in Context
+	} else if v, ok := sp.(tftags.ServiceTagLister); ok {
 		err = v.ListTags(ctx, c, identifier) // Sets tags in Context
 	} else if v, ok := sp.(tftags.ResourceTypeTagLister); ok {
 		if w.ServicePackageResourceTags.ResourceType == "" {
@@ -65,7 +73,9 @@ func (w WithTaggingMethods) ListTags(ctx context.Context, sp conns.ServicePackag
 func (w WithTaggingMethods) UpdateTags(ctx context.Context, sp conns.ServicePackage, c *conns.AWSClient, identifier string, oldTags, newTags any) error {
 	var err error
 
-	if v, ok := sp.(tftags.ServiceTagUpdater); ok {
+	if w.ServicePackageResourceTags.TaggingAPI {
+		err = tftags.ResourceGroupsTaggingAPI{}.UpdateTags(ctx, c, identifier, oldTags, newTags)
+	} else if v, ok := sp.(tftags.ServiceTagUpdater); ok {
 		err = v.UpdateTags(ctx, c, identifier, oldTags, newTags)
 	} else if v, ok := sp.(tftags.ResourceTypeTagUpdater); ok {
 		if w.ServicePackageResourceTags.ResourceType == "" {
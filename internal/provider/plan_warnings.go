@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+)
+
+// planWarningsProviderServer wraps the Plugin SDK provider server, adding plan warnings to PlanResourceChange responses.
+// CustomizeDiff functions raise plan warnings with sdkv2.AddPlanWarning.
+type planWarningsProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func newPlanWarningsProviderServer(server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	return planWarningsProviderServer{
+		ProviderServer: server,
+	}
+}
+
+func (s planWarningsProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	ctx, warnings := sdkv2.NewPlanWarningsContext(ctx)
+
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	response.Diagnostics = append(response.Diagnostics, warnings.Diagnostics()...)
+
+	return response, nil
+}
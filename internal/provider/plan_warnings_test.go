@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+)
+
+type mockPlanProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s mockPlanProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	sdkv2.AddPlanWarning(ctx, "tags", "Tags do not comply with tag policy", request.TypeName)
+
+	return &tfprotov5.PlanResourceChangeResponse{}, nil
+}
+
+func TestPlanWarningsProviderServer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := newPlanWarningsProviderServer(mockPlanProviderServer{})
+
+	for _, typeName := range []string{"aws_test_one", "aws_test_two"} {
+		response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{TypeName: typeName})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		// Warnings must not leak between requests.
+		if got, want := len(response.Diagnostics), 1; got != want {
+			t.Fatalf("got %d diagnostics, want %d", got, want)
+		}
+
+		diagnostic := response.Diagnostics[0]
+		if got, want := diagnostic.Severity, tfprotov5.DiagnosticSeverityWarning; got != want {
+			t.Errorf("got severity %s, want %s", got, want)
+		}
+		if got, want := diagnostic.Detail, typeName; got != want {
+			t.Errorf("got detail %q, want %q", got, want)
+		}
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -22,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -73,7 +76,32 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 							Description: "Resource tags to default across all resources. " +
-								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
+								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`. " +
+								"Values can reference the `${resource_type}`, `${account_id}`, `${region}` and `${partition}` variables, resolved for each resource.",
+						},
+					},
+				},
+			},
+			"deletion_guard": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to prevent the deletion of selected resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, e.g. `aws_db_instance`, whose resources cannot be deleted. " +
+								"Deletion can be allowed by setting the " + conns.DeletionGuardOverrideEnvVar + " environment variable to `true`.",
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Description: "Resources with any of these tags cannot be deleted. " +
+								"Deletion can be allowed by setting the " + conns.DeletionGuardOverrideEnvVar + " environment variable to `true`.",
 						},
 					},
 				},
@@ -130,6 +158,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Description: "Resource tag key prefixes to ignore across all resources. " +
 								"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 						},
+						"key_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
 					},
 				},
 			},
@@ -234,6 +271,29 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy_compliance": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to check resource tags against the effective AWS Organizations tag policy during plan.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_file": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Description: "File containing the effective tag policy. " +
+								"If not set, the effective tag policy is read using the Organizations DescribeEffectivePolicy API.",
+						},
+						"severity": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[tftags.TagPolicySeverity](),
+							Description: "Severity with which tag policy violations are reported. Valid values are `error` and `warning`. " +
+								"Defaults to `warning`. Can also be configured with the " + tftags.TagPolicyComplianceEnvVar + " environment variable.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -320,7 +380,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 					ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 					if v, ok := meta.(*conns.AWSClient); ok {
-						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
+						ctx = tftags.NewContext(ctx, v.ResourceDefaultTagsConfig(ctx, typeName, false), v.IgnoreTagsConfig(ctx))
 						ctx = v.RegisterLogger(ctx)
 					}
 
@@ -393,14 +453,27 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			interceptors = append(interceptors, interceptorItem{
+				when:        Before,
+				why:         Delete,
+				interceptor: newDeletionGuardInterceptor(typeName),
+			})
+
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
+				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
 					var diags diag.Diagnostics
 
+					var skipDefaultTags bool
+					if getAttribute != nil {
+						if v, ok := getAttribute(tftags.SkipDefaultTagsAttributeName); ok {
+							skipDefaultTags, _ = v.(bool)
+						}
+					}
+
 					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 					if v, ok := meta.(*conns.AWSClient); ok {
-						ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
+						ctx = tftags.NewContext(ctx, v.ResourceDefaultTagsConfig(ctx, typeName, skipDefaultTags), v.IgnoreTagsConfig(ctx))
 						ctx = v.RegisterLogger(ctx)
 					}
 
@@ -531,6 +604,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("deletion_guard"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.DeletionGuardConfig = expandDeletionGuard(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -568,6 +645,17 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	var tagPolicyCompliance []interface{}
+	if v, ok := d.GetOk("tag_policy_compliance"); ok {
+		tagPolicyCompliance = v.([]interface{})
+	}
+	tagPolicyConfig, dx := expandTagPolicyCompliance(tagPolicyCompliance)
+	diags = append(diags, dx...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	config.TagPolicyConfig = tagPolicyConfig
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -860,8 +948,27 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return nil
 }
 
+func expandDeletionGuard(ctx context.Context, tfMap map[string]interface{}) *conns.DeletionGuardConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	deletionGuardConfig := &conns.DeletionGuardConfig{}
+
+	if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		deletionGuardConfig.ResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+		deletionGuardConfig.Tags = tftags.New(ctx, v)
+	}
+
+	return deletionGuardConfig
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []interface{}
+	var keyRegexes []*regexp.Regexp
 
 	if tfMap != nil {
 		if v, ok := tfMap["keys"].(*schema.Set); ok {
@@ -870,6 +977,14 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 		if v, ok := tfMap["key_prefixes"].(*schema.Set); ok {
 			keyPrefixes = v.List()
 		}
+		if v, ok := tfMap["key_regexes"].(*schema.Set); ok {
+			for _, v := range flex.ExpandStringValueSet(v) {
+				// Regular expressions are validated in the schema.
+				if re, err := regexp.Compile(v); err == nil {
+					keyRegexes = append(keyRegexes, re)
+				}
+			}
+		}
 	}
 
 	if v := os.Getenv(tftags.IgnoreTagsKeysEnvVar); v != "" {
@@ -893,7 +1008,7 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	// - Return nil when no keys or prefixes are set
 	// - For a non-nil return, `keys` or `key_prefixes` should be
 	//   nil if empty (versus a zero-value `KeyValueTags` struct)
-	if len(keys) == 0 && len(keyPrefixes) == 0 {
+	if len(keys) == 0 && len(keyPrefixes) == 0 && len(keyRegexes) == 0 {
 		return nil
 	}
 
@@ -904,10 +1019,49 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	if len(keyPrefixes) > 0 {
 		ignoreConfig.KeyPrefixes = tftags.New(ctx, keyPrefixes)
 	}
+	if len(keyRegexes) > 0 {
+		ignoreConfig.KeyRegexes = keyRegexes
+	}
 
 	return ignoreConfig
 }
 
+// expandTagPolicyCompliance returns the tag policy compliance configuration.
+// Tag policy compliance checking is enabled by the presence of the `tag_policy_compliance` block or the environment variable.
+func expandTagPolicyCompliance(tfList []interface{}) (*tftags.TagPolicyConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
+	if envSeverity != "" && !slices.Contains(tftags.TagPolicySeverity("").Values(), tftags.TagPolicySeverity(envSeverity)) {
+		return nil, sdkdiag.AppendErrorf(diags, "invalid value for environment variable %s: %q", tftags.TagPolicyComplianceEnvVar, envSeverity)
+	}
+
+	if len(tfList) == 0 && envSeverity == "" {
+		return nil, diags
+	}
+
+	tagPolicyConfig := &tftags.TagPolicyConfig{
+		Severity: tftags.TagPolicySeverity(envSeverity),
+	}
+
+	if len(tfList) > 0 {
+		if tfMap, ok := tfList[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["policy_file"].(string); ok && v != "" {
+				tagPolicyConfig.PolicyFile = v
+			}
+			if v, ok := tfMap["severity"].(string); ok && v != "" {
+				tagPolicyConfig.Severity = tftags.TagPolicySeverity(v)
+			}
+		}
+	}
+
+	if tagPolicyConfig.Severity == "" {
+		tagPolicyConfig.Severity = tftags.TagPolicySeverityWarning
+	}
+
+	return tagPolicyConfig, diags
+}
+
 func DeprecatedEnvVarDiag(envvar, replacement string) diag.Diagnostic {
 	return errs.NewWarningDiagnostic(
 		"Deprecated Environment Variable",
@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"os"
+	"slices"
 	"strings"
 	"testing"
 
@@ -424,6 +425,116 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandIgnoreTagsKeyRegexes(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	results := expandIgnoreTags(ctx, map[string]interface{}{
+		"key_regexes": schema.NewSet(schema.HashString, []interface{}{`^kubernetes\.io/`, `(?i)^owner$`}),
+	})
+
+	if results == nil {
+		t.Fatal("Expected ignore tags config, got nil")
+	}
+
+	if results.Keys != nil || results.KeyPrefixes != nil {
+		t.Errorf("Expected no keys or key prefixes, got %v, %v", results.Keys, results.KeyPrefixes)
+	}
+
+	var got []string
+	for _, re := range results.KeyRegexes {
+		got = append(got, re.String())
+	}
+	slices.Sort(got)
+
+	if diff := cmp.Diff([]string{`(?i)^owner$`, `^kubernetes\.io/`}, got); diff != "" {
+		t.Errorf("Unexpected key_regexes diff: %s", diff)
+	}
+}
+
+func TestExpandTagPolicyCompliance(t *testing.T) { //nolint:paralleltest
+	testcases := map[string]struct {
+		tfList                  []interface{}
+		envvars                 map[string]string
+		expectedTagPolicyConfig *tftags.TagPolicyConfig
+		expectError             bool
+	}{
+		"nil": {
+			envvars:                 map[string]string{},
+			expectedTagPolicyConfig: nil,
+		},
+		"empty block": {
+			tfList: []interface{}{nil},
+			expectedTagPolicyConfig: &tftags.TagPolicyConfig{
+				Severity: tftags.TagPolicySeverityWarning,
+			},
+		},
+		"config": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"policy_file": "policy.json",
+					"severity":    "error",
+				},
+			},
+			expectedTagPolicyConfig: &tftags.TagPolicyConfig{
+				PolicyFile: "policy.json",
+				Severity:   tftags.TagPolicySeverityError,
+			},
+		},
+		"envvar": {
+			envvars: map[string]string{
+				tftags.TagPolicyComplianceEnvVar: "error",
+			},
+			expectedTagPolicyConfig: &tftags.TagPolicyConfig{
+				Severity: tftags.TagPolicySeverityError,
+			},
+		},
+		"envvar and config": {
+			tfList: []interface{}{
+				map[string]interface{}{
+					"policy_file": "",
+					"severity":    "warning",
+				},
+			},
+			envvars: map[string]string{
+				tftags.TagPolicyComplianceEnvVar: "error",
+			},
+			expectedTagPolicyConfig: &tftags.TagPolicyConfig{
+				Severity: tftags.TagPolicySeverityWarning,
+			},
+		},
+		"envvar invalid": {
+			envvars: map[string]string{
+				tftags.TagPolicyComplianceEnvVar: "fatal",
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testcase := range testcases { //nolint:paralleltest
+		t.Run(name, func(t *testing.T) {
+			oldEnv := stashEnv()
+			defer popEnv(oldEnv)
+
+			for k, v := range testcase.envvars {
+				os.Setenv(k, v) //nolint:usetesting // stashEnv & popEnv require os.Setenv
+			}
+
+			results, diags := expandTagPolicyCompliance(testcase.tfList)
+
+			if got, want := diags.HasError(), testcase.expectError; got != want {
+				t.Fatalf("Expected error %t, got %t: %v", want, got, diags)
+			}
+
+			if diff := cmp.Diff(testcase.expectedTagPolicyConfig, results); diff != "" {
+				t.Errorf("Unexpected tag_policy_compliance diff: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
@@ -5,10 +5,15 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
@@ -85,6 +90,19 @@ func (r tagsResourceInterceptor) run(ctx context.Context, opts interceptorOption
 
 			fallthrough
 		case Create, Update:
+			if why == Create && r.UsesTaggingAPI() {
+				if identifier := r.getIdentifier(d); identifier != "" {
+					tags := tagsInContext.TagsIn.UnwrapOrDefault()
+					if err := r.UpdateTags(ctx, sp, c, identifier, nil, tags); err != nil {
+						return sdkdiag.AppendErrorf(diags, "setting tags for %s %s (%s): %s", serviceName, resourceName, identifier, err)
+					}
+
+					// Any tags read by the R handler predate tagging.
+					// The Resource Groups Tagging API is eventually consistent, so use the tags just applied instead of listing them.
+					tagsInContext.TagsOut = option.Some(tagsInContext.TagsOut.UnwrapOrDefault().Merge(tags))
+				}
+			}
+
 			// If the R handler didn't set tags, try and read them from the service API.
 			if tagsInContext.TagsOut.IsNone() {
 				// Some old resources may not have the required attribute set after Read:
@@ -259,3 +277,61 @@ func (r tagsInterceptor) getIdentifier(d schemaResourceData) string {
 
 	return identifier
 }
+
+// checkTagPolicyCompliance is a CustomizeDiff function that checks the planned tags against the effective tag policy.
+// Violations fail the plan when the configured severity is `error`.
+// CustomizeDiff functions cannot return warnings, so with severity `warning` violations are added to the context's plan warnings.
+func checkTagPolicyCompliance(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	c := meta.(*conns.AWSClient)
+
+	config := c.TagPolicyConfig(ctx)
+	if config == nil {
+		return nil
+	}
+
+	violations, err := tagPolicyViolations(ctx, c, d)
+	if err != nil {
+		if config.Severity == tftags.TagPolicySeverityError {
+			return fmt.Errorf("checking tag policy compliance: %w", err)
+		}
+
+		sdkv2.AddPlanWarning(ctx, names.AttrTags, "Checking tag policy compliance", err.Error())
+
+		return nil
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	if config.Severity == tftags.TagPolicySeverityError {
+		return fmt.Errorf("tags do not comply with tag policy:\n\t%s", strings.Join(violations, "\n\t"))
+	}
+
+	for _, v := range violations {
+		sdkv2.AddPlanWarning(ctx, names.AttrTags, "Tags do not comply with tag policy", v)
+	}
+
+	return nil
+}
+
+// tagPolicyViolations returns the tag policy violations for the tags that will be applied to a resource.
+// Only new resources and resources whose tags change are checked.
+func tagPolicyViolations(ctx context.Context, c *conns.AWSClient, d sdkv2.ResourceDiffer) ([]string, error) {
+	if !d.GetRawPlan().GetAttr(names.AttrTags).IsWhollyKnown() {
+		return nil, nil
+	}
+
+	if d.Id() != "" && !d.HasChanges(names.AttrTags, names.AttrTagsAll) {
+		return nil, nil
+	}
+
+	policy, err := c.TagPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any)))
+
+	return policy.Check(tags), nil
+}
@@ -129,9 +129,9 @@ func (w *wrappedResource) state(f schema.StateContextFunc) schema.StateContextFu
 func (w *wrappedResource) customizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	if w.opts.usesTransparentTagging {
 		if f == nil {
-			return w.customizeDiffWithBootstrappedContext(setTagsAll)
+			return w.customizeDiffWithBootstrappedContext(customdiff.Sequence(setTagsAll, checkTagPolicyCompliance))
 		} else {
-			return w.customizeDiffWithBootstrappedContext(customdiff.Sequence(setTagsAll, f))
+			return w.customizeDiffWithBootstrappedContext(customdiff.Sequence(setTagsAll, checkTagPolicyCompliance, f))
 		}
 	}
 
@@ -200,6 +200,14 @@ func setTagsAll(ctx context.Context, d *schema.ResourceDiff, meta interface{}) e
 			}
 		}
 	} else {
+		// Toggling skip_default_tags adds or removes the provider default tags, even when that leaves no tags.
+		if d.GetRawConfig().Type().HasAttribute(tftags.SkipDefaultTagsAttributeName) && d.HasChange(tftags.SkipDefaultTagsAttributeName) && !allTags.HasZeroValue() {
+			if err := d.SetNew(names.AttrTagsAll, allTags.Map()); err != nil {
+				return fmt.Errorf("setting new tags_all diff: %w", err)
+			}
+			return nil
+		}
+
 		if len(allTags) > 0 && !allTags.HasZeroValue() {
 			if err := d.SetNew(names.AttrTagsAll, allTags.Map()); err != nil {
 				return fmt.Errorf("setting new tags_all diff: %w", err)
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type planWarningsContextKey struct{}
+
+// PlanWarnings collects warnings raised by CustomizeDiff functions, which cannot return warnings themselves.
+type PlanWarnings struct {
+	diagnostics []*tfprotov5.Diagnostic
+}
+
+// NewPlanWarningsContext returns a context that collects the plan warnings added to it.
+func NewPlanWarningsContext(ctx context.Context) (context.Context, *PlanWarnings) {
+	v := &PlanWarnings{}
+
+	return context.WithValue(ctx, planWarningsContextKey{}, v), v
+}
+
+// Diagnostics returns the collected plan warnings.
+func (v *PlanWarnings) Diagnostics() []*tfprotov5.Diagnostic {
+	return v.diagnostics
+}
+
+// AddPlanWarning adds a warning for the specified top-level attribute to the context's plan warnings.
+// Outside of PlanResourceChange there are no plan warnings and the warning is discarded.
+func AddPlanWarning(ctx context.Context, attributeName, summary, detail string) {
+	v, ok := ctx.Value(planWarningsContextKey{}).(*PlanWarnings)
+	if !ok {
+		return
+	}
+
+	v.diagnostics = append(v.diagnostics, &tfprotov5.Diagnostic{
+		Severity:  tfprotov5.DiagnosticSeverityWarning,
+		Summary:   summary,
+		Detail:    detail,
+		Attribute: tftypes.NewAttributePath().WithAttributeName(attributeName),
+	})
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestAddPlanWarning(t *testing.T) {
+	t.Parallel()
+
+	ctx, warnings := NewPlanWarningsContext(context.Background())
+
+	AddPlanWarning(ctx, "tags", "summary", "detail")
+
+	if got, want := len(warnings.Diagnostics()), 1; got != want {
+		t.Fatalf("got %d diagnostics, want %d", got, want)
+	}
+
+	diagnostic := warnings.Diagnostics()[0]
+	if got, want := diagnostic.Severity, tfprotov5.DiagnosticSeverityWarning; got != want {
+		t.Errorf("got severity %s, want %s", got, want)
+	}
+	if got, want := diagnostic.Summary, "summary"; got != want {
+		t.Errorf("got summary %q, want %q", got, want)
+	}
+}
+
+func TestAddPlanWarningWithoutPlanWarnings(t *testing.T) {
+	t.Parallel()
+
+	// Must not panic outside of PlanResourceChange.
+	AddPlanWarning(context.Background(), "tags", "summary", "detail")
+}
@@ -0,0 +1,446 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameAnalysisTemplate = "Analysis Template"
+)
+
+// @FrameworkResource("aws_cleanrooms_analysis_template",name="Analysis Template")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newResourceAnalysisTemplate(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceAnalysisTemplate{}
+
+	return r, nil
+}
+
+type resourceAnalysisTemplate struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceAnalysisTemplate) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"collaboration_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collaboration_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			names.AttrFormat: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AnalysisFormat](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"membership_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"membership_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"referenced_tables": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"update_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"analysis_parameters": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[analysisParameterModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDefaultValue: schema.StringAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ParameterType](),
+							Required:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			names.AttrSource: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[analysisSourceModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"text": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 90000),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = s
+}
+
+func (r *resourceAnalysisTemplate) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceAnalysisTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := cleanrooms.CreateAnalysisTemplateInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateAnalysisTemplate(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNameAnalysisTemplate, data.Name.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output.AnalysisTemplate)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAnalysisTemplate) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceAnalysisTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findAnalysisTemplateByTwoPartKey(ctx, conn, data.MembershipIdentifier.ValueString(), data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNameAnalysisTemplate, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output.AnalysisTemplate)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAnalysisTemplate) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state resourceAnalysisTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		input := cleanrooms.UpdateAnalysisTemplateInput{
+			AnalysisTemplateIdentifier: plan.ID.ValueStringPointer(),
+			Description:                aws.String(plan.Description.ValueString()),
+			MembershipIdentifier:       plan.MembershipIdentifier.ValueStringPointer(),
+		}
+
+		output, err := conn.UpdateAnalysisTemplate(ctx, &input)
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CleanRooms, create.ErrActionUpdating, ResNameAnalysisTemplate, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		response.Diagnostics.Append(plan.flatten(ctx, output.AnalysisTemplate)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.UpdateTime = state.UpdateTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceAnalysisTemplate) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceAnalysisTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting CleanRooms Analysis Template", map[string]interface{}{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	input := cleanrooms.DeleteAnalysisTemplateInput{
+		AnalysisTemplateIdentifier: data.ID.ValueStringPointer(),
+		MembershipIdentifier:       data.MembershipIdentifier.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteAnalysisTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionDeleting, ResNameAnalysisTemplate, data.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+func (r *resourceAnalysisTemplate) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts := strings.Split(request.ID, ",")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		response.Diagnostics.AddError("resource import invalid ID", fmt.Sprintf(`Unexpected format for import ID (%s), use: "MembershipIdentifier,Id"`, request.ID))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("membership_identifier"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), parts[1])...)
+}
+
+type resourceAnalysisTemplateData struct {
+	AnalysisParameters   fwtypes.ListNestedObjectValueOf[analysisParameterModel] `tfsdk:"analysis_parameters"`
+	ARN                  types.String                                            `tfsdk:"arn"`
+	CollaborationARN     types.String                                            `tfsdk:"collaboration_arn"`
+	CollaborationID      types.String                                            `tfsdk:"collaboration_id"`
+	CreateTime           timetypes.RFC3339                                       `tfsdk:"create_time"`
+	Description          types.String                                            `tfsdk:"description"`
+	Format               fwtypes.StringEnum[awstypes.AnalysisFormat]             `tfsdk:"format"`
+	ID                   types.String                                            `tfsdk:"id"`
+	MembershipARN        types.String                                            `tfsdk:"membership_arn"`
+	MembershipIdentifier types.String                                            `tfsdk:"membership_identifier"`
+	Name                 types.String                                            `tfsdk:"name"`
+	ReferencedTables     fwtypes.ListValueOf[types.String]                       `tfsdk:"referenced_tables"`
+	Source               fwtypes.ListNestedObjectValueOf[analysisSourceModel]    `tfsdk:"source"`
+	Tags                 tftags.Map                                              `tfsdk:"tags"`
+	TagsAll              tftags.Map                                              `tfsdk:"tags_all"`
+	UpdateTime           timetypes.RFC3339                                       `tfsdk:"update_time"`
+}
+
+func (data *resourceAnalysisTemplateData) flatten(ctx context.Context, template *awstypes.AnalysisTemplate) (diags diag.Diagnostics) {
+	diags.Append(fwflex.Flatten(ctx, template, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if data.MembershipIdentifier.IsNull() {
+		data.MembershipIdentifier = fwflex.StringToFramework(ctx, template.MembershipId)
+	}
+
+	var referencedTables []string
+	if template.Schema != nil {
+		referencedTables = template.Schema.ReferencedTables
+	}
+	data.ReferencedTables = fwflex.FlattenFrameworkStringValueListOfString(ctx, referencedTables)
+
+	return diags
+}
+
+type analysisParameterModel struct {
+	DefaultValue types.String                               `tfsdk:"default_value"`
+	Name         types.String                               `tfsdk:"name"`
+	Type         fwtypes.StringEnum[awstypes.ParameterType] `tfsdk:"type"`
+}
+
+var (
+	_ fwflex.Expander  = analysisSourceModel{}
+	_ fwflex.Flattener = (*analysisSourceModel)(nil)
+)
+
+type analysisSourceModel struct {
+	Text types.String `tfsdk:"text"`
+}
+
+func (m analysisSourceModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Text.IsNull():
+		var r awstypes.AnalysisSourceMemberText
+		r.Value = m.Text.ValueString()
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *analysisSourceModel) Flatten(ctx context.Context, input any) (diags diag.Diagnostics) {
+	switch t := input.(type) {
+	case awstypes.AnalysisSourceMemberText:
+		m.Text = types.StringValue(t.Value)
+
+		return diags
+	}
+
+	return diags
+}
+
+func findAnalysisTemplateByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, id string) (*cleanrooms.GetAnalysisTemplateOutput, error) {
+	in := &cleanrooms.GetAnalysisTemplateInput{
+		AnalysisTemplateIdentifier: aws.String(id),
+		MembershipIdentifier:       aws.String(membershipID),
+	}
+
+	out, err := conn.GetAnalysisTemplate(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.AnalysisTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
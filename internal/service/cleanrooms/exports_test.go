@@ -5,7 +5,17 @@ package cleanrooms
 
 // Exports for use in tests only.
 var (
-	ResourceMembership = newResourceMembership
+	ResourceAnalysisTemplate            = newResourceAnalysisTemplate
+	ResourceConfiguredTableAnalysisRule = newResourceConfiguredTableAnalysisRule
+	ResourceConfiguredTableAssociation  = newResourceConfiguredTableAssociation
+	ResourceIDNamespaceAssociation      = newResourceIDNamespaceAssociation
+	ResourceMembership                  = newResourceMembership
+	ResourcePrivacyBudgetTemplate       = newResourcePrivacyBudgetTemplate
 
-	FindMembershipByID = findMembershipByID
+	FindAnalysisTemplateByTwoPartKey            = findAnalysisTemplateByTwoPartKey
+	FindConfiguredTableAnalysisRuleByTwoPartKey = findConfiguredTableAnalysisRuleByTwoPartKey
+	FindConfiguredTableAssociationByTwoPartKey  = findConfiguredTableAssociationByTwoPartKey
+	FindIDNamespaceAssociationByTwoPartKey      = findIDNamespaceAssociationByTwoPartKey
+	FindMembershipByID                          = findMembershipByID
+	FindPrivacyBudgetTemplateByTwoPartKey       = findPrivacyBudgetTemplateByTwoPartKey
 )
@@ -0,0 +1,383 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameIDNamespaceAssociation = "ID Namespace Association"
+)
+
+// @FrameworkResource("aws_cleanrooms_id_namespace_association",name="ID Namespace Association")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newResourceIDNamespaceAssociation(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceIDNamespaceAssociation{}
+
+	return r, nil
+}
+
+type resourceIDNamespaceAssociation struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceIDNamespaceAssociation) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"collaboration_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collaboration_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"membership_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"membership_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"update_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"id_mapping_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[idMappingConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"allow_use_as_dimension_column": schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"input_reference_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[idNamespaceAssociationInputReferenceConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"input_reference_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"manage_resource_policies": schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = s
+}
+
+func (r *resourceIDNamespaceAssociation) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceIDNamespaceAssociationData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := cleanrooms.CreateIdNamespaceAssociationInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateIdNamespaceAssociation(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNameIDNamespaceAssociation, data.Name.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output.IdNamespaceAssociation)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIDNamespaceAssociation) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceIDNamespaceAssociationData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findIDNamespaceAssociationByTwoPartKey(ctx, conn, data.MembershipIdentifier.ValueString(), data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNameIDNamespaceAssociation, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output.IdNamespaceAssociation)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceIDNamespaceAssociation) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state resourceIDNamespaceAssociationData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diff, d := fwflex.Diff(ctx, plan, state, fwflex.WithIgnoredField("UpdateTime"))
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		input := cleanrooms.UpdateIdNamespaceAssociationInput{
+			IdNamespaceAssociationIdentifier: plan.ID.ValueStringPointer(),
+		}
+
+		response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateIdNamespaceAssociation(ctx, &input)
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CleanRooms, create.ErrActionUpdating, ResNameIDNamespaceAssociation, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		response.Diagnostics.Append(plan.flatten(ctx, output.IdNamespaceAssociation)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.UpdateTime = state.UpdateTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceIDNamespaceAssociation) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceIDNamespaceAssociationData
+	conn := r.Meta().CleanRoomsClient(ctx)
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting CleanRooms ID Namespace Association", map[string]interface{}{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	input := cleanrooms.DeleteIdNamespaceAssociationInput{
+		IdNamespaceAssociationIdentifier: data.ID.ValueStringPointer(),
+		MembershipIdentifier:             data.MembershipIdentifier.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteIdNamespaceAssociation(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionDeleting, ResNameIDNamespaceAssociation, data.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+func (r *resourceIDNamespaceAssociation) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts := strings.Split(request.ID, ",")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		response.Diagnostics.AddError("resource import invalid ID", fmt.Sprintf(`Unexpected format for import ID (%s), use: "MembershipIdentifier,Id"`, request.ID))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("membership_identifier"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), parts[1])...)
+}
+
+type resourceIDNamespaceAssociationData struct {
+	ARN                  types.String                                                                     `tfsdk:"arn"`
+	CollaborationARN     types.String                                                                     `tfsdk:"collaboration_arn"`
+	CollaborationID      types.String                                                                     `tfsdk:"collaboration_id"`
+	CreateTime           timetypes.RFC3339                                                                `tfsdk:"create_time"`
+	Description          types.String                                                                     `tfsdk:"description"`
+	ID                   types.String                                                                     `tfsdk:"id"`
+	IDMappingConfig      fwtypes.ListNestedObjectValueOf[idMappingConfigModel]                            `tfsdk:"id_mapping_config"`
+	InputReferenceConfig fwtypes.ListNestedObjectValueOf[idNamespaceAssociationInputReferenceConfigModel] `tfsdk:"input_reference_config"`
+	MembershipARN        types.String                                                                     `tfsdk:"membership_arn"`
+	MembershipIdentifier types.String                                                                     `tfsdk:"membership_identifier"`
+	Name                 types.String                                                                     `tfsdk:"name"`
+	Tags                 tftags.Map                                                                       `tfsdk:"tags"`
+	TagsAll              tftags.Map                                                                       `tfsdk:"tags_all"`
+	UpdateTime           timetypes.RFC3339                                                                `tfsdk:"update_time"`
+}
+
+func (data *resourceIDNamespaceAssociationData) flatten(ctx context.Context, association *awstypes.IdNamespaceAssociation) (diags diag.Diagnostics) {
+	diags.Append(fwflex.Flatten(ctx, association, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if data.MembershipIdentifier.IsNull() {
+		data.MembershipIdentifier = fwflex.StringToFramework(ctx, association.MembershipId)
+	}
+
+	return diags
+}
+
+type idMappingConfigModel struct {
+	AllowUseAsDimensionColumn types.Bool `tfsdk:"allow_use_as_dimension_column"`
+}
+
+type idNamespaceAssociationInputReferenceConfigModel struct {
+	InputReferenceARN      fwtypes.ARN `tfsdk:"input_reference_arn"`
+	ManageResourcePolicies types.Bool  `tfsdk:"manage_resource_policies"`
+}
+
+func findIDNamespaceAssociationByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, id string) (*cleanrooms.GetIdNamespaceAssociationOutput, error) {
+	in := &cleanrooms.GetIdNamespaceAssociationInput{
+		IdNamespaceAssociationIdentifier: aws.String(id),
+		MembershipIdentifier:             aws.String(membershipID),
+	}
+
+	out, err := conn.GetIdNamespaceAssociation(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.IdNamespaceAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,451 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNamePrivacyBudgetTemplate = "Privacy Budget Template"
+)
+
+// @FrameworkResource("aws_cleanrooms_privacy_budget_template",name="Privacy Budget Template")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newResourcePrivacyBudgetTemplate(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourcePrivacyBudgetTemplate{}
+
+	return r, nil
+}
+
+type resourcePrivacyBudgetTemplate struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourcePrivacyBudgetTemplate) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"auto_refresh": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PrivacyBudgetTemplateAutoRefresh](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collaboration_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collaboration_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"membership_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"membership_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"privacy_budget_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PrivacyBudgetType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"update_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrParameters: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[privacyBudgetTemplateParametersModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"differential_privacy": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[differentialPrivacyTemplateParametersModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"epsilon": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.Between(1, 20),
+										},
+									},
+									"users_noise_per_query": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.Between(10, 100),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = s
+}
+
+func (r *resourcePrivacyBudgetTemplate) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourcePrivacyBudgetTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := cleanrooms.CreatePrivacyBudgetTemplateInput{
+		Tags: getTagsIn(ctx),
+	}
+
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreatePrivacyBudgetTemplate(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionCreating, ResNamePrivacyBudgetTemplate, data.MembershipIdentifier.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output.PrivacyBudgetTemplate)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePrivacyBudgetTemplate) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourcePrivacyBudgetTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findPrivacyBudgetTemplateByTwoPartKey(ctx, conn, data.MembershipIdentifier.ValueString(), data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionReading, ResNamePrivacyBudgetTemplate, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output.PrivacyBudgetTemplate)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourcePrivacyBudgetTemplate) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state resourcePrivacyBudgetTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Parameters.Equal(state.Parameters) {
+		input := cleanrooms.UpdatePrivacyBudgetTemplateInput{
+			PrivacyBudgetTemplateIdentifier: plan.ID.ValueStringPointer(),
+		}
+
+		response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdatePrivacyBudgetTemplate(ctx, &input)
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CleanRooms, create.ErrActionUpdating, ResNamePrivacyBudgetTemplate, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		response.Diagnostics.Append(plan.flatten(ctx, output.PrivacyBudgetTemplate)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.UpdateTime = state.UpdateTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourcePrivacyBudgetTemplate) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourcePrivacyBudgetTemplateData
+	conn := r.Meta().CleanRoomsClient(ctx)
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting CleanRooms Privacy Budget Template", map[string]interface{}{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	input := cleanrooms.DeletePrivacyBudgetTemplateInput{
+		MembershipIdentifier:            data.MembershipIdentifier.ValueStringPointer(),
+		PrivacyBudgetTemplateIdentifier: data.ID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeletePrivacyBudgetTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CleanRooms, create.ErrActionDeleting, ResNamePrivacyBudgetTemplate, data.ID.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+func (r *resourcePrivacyBudgetTemplate) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts := strings.Split(request.ID, ",")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		response.Diagnostics.AddError("resource import invalid ID", fmt.Sprintf(`Unexpected format for import ID (%s), use: "MembershipIdentifier,Id"`, request.ID))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("membership_identifier"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), parts[1])...)
+}
+
+type resourcePrivacyBudgetTemplateData struct {
+	ARN                  types.String                                                          `tfsdk:"arn"`
+	AutoRefresh          fwtypes.StringEnum[awstypes.PrivacyBudgetTemplateAutoRefresh]         `tfsdk:"auto_refresh"`
+	CollaborationARN     types.String                                                          `tfsdk:"collaboration_arn"`
+	CollaborationID      types.String                                                          `tfsdk:"collaboration_id"`
+	CreateTime           timetypes.RFC3339                                                     `tfsdk:"create_time"`
+	ID                   types.String                                                          `tfsdk:"id"`
+	MembershipARN        types.String                                                          `tfsdk:"membership_arn"`
+	MembershipIdentifier types.String                                                          `tfsdk:"membership_identifier"`
+	Parameters           fwtypes.ListNestedObjectValueOf[privacyBudgetTemplateParametersModel] `tfsdk:"parameters"`
+	PrivacyBudgetType    fwtypes.StringEnum[awstypes.PrivacyBudgetType]                        `tfsdk:"privacy_budget_type"`
+	Tags                 tftags.Map                                                            `tfsdk:"tags"`
+	TagsAll              tftags.Map                                                            `tfsdk:"tags_all"`
+	UpdateTime           timetypes.RFC3339                                                     `tfsdk:"update_time"`
+}
+
+func (data *resourcePrivacyBudgetTemplateData) flatten(ctx context.Context, template *awstypes.PrivacyBudgetTemplate) (diags diag.Diagnostics) {
+	diags.Append(fwflex.Flatten(ctx, template, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if data.MembershipIdentifier.IsNull() {
+		data.MembershipIdentifier = fwflex.StringToFramework(ctx, template.MembershipId)
+	}
+
+	return diags
+}
+
+var (
+	_ fwflex.TypedExpander = privacyBudgetTemplateParametersModel{}
+	_ fwflex.Flattener     = (*privacyBudgetTemplateParametersModel)(nil)
+)
+
+type privacyBudgetTemplateParametersModel struct {
+	DifferentialPrivacy fwtypes.ListNestedObjectValueOf[differentialPrivacyTemplateParametersModel] `tfsdk:"differential_privacy"`
+}
+
+func (m privacyBudgetTemplateParametersModel) ExpandTo(ctx context.Context, targetType reflect.Type) (result any, diags diag.Diagnostics) {
+	switch targetType {
+	case reflect.TypeFor[awstypes.PrivacyBudgetTemplateParametersInput]():
+		return m.expandToParametersInput(ctx)
+
+	case reflect.TypeFor[awstypes.PrivacyBudgetTemplateUpdateParameters]():
+		return m.expandToUpdateParameters(ctx)
+	}
+
+	return nil, diags
+}
+
+func (m privacyBudgetTemplateParametersModel) expandToParametersInput(ctx context.Context) (result awstypes.PrivacyBudgetTemplateParametersInput, diags diag.Diagnostics) {
+	switch {
+	case !m.DifferentialPrivacy.IsNull():
+		differentialPrivacyData, d := m.DifferentialPrivacy.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.PrivacyBudgetTemplateParametersInputMemberDifferentialPrivacy
+		diags.Append(fwflex.Expand(ctx, differentialPrivacyData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m privacyBudgetTemplateParametersModel) expandToUpdateParameters(ctx context.Context) (result awstypes.PrivacyBudgetTemplateUpdateParameters, diags diag.Diagnostics) {
+	switch {
+	case !m.DifferentialPrivacy.IsNull():
+		differentialPrivacyData, d := m.DifferentialPrivacy.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.PrivacyBudgetTemplateUpdateParametersMemberDifferentialPrivacy
+		diags.Append(fwflex.Expand(ctx, differentialPrivacyData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *privacyBudgetTemplateParametersModel) Flatten(ctx context.Context, input any) (diags diag.Diagnostics) {
+	switch t := input.(type) {
+	case awstypes.PrivacyBudgetTemplateParametersOutputMemberDifferentialPrivacy:
+		var model differentialPrivacyTemplateParametersModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.DifferentialPrivacy = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type differentialPrivacyTemplateParametersModel struct {
+	Epsilon            types.Int64 `tfsdk:"epsilon"`
+	UsersNoisePerQuery types.Int64 `tfsdk:"users_noise_per_query"`
+}
+
+func findPrivacyBudgetTemplateByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, id string) (*cleanrooms.GetPrivacyBudgetTemplateOutput, error) {
+	in := &cleanrooms.GetPrivacyBudgetTemplateInput{
+		MembershipIdentifier:            aws.String(membershipID),
+		PrivacyBudgetTemplateIdentifier: aws.String(id),
+	}
+
+	out, err := conn.GetPrivacyBudgetTemplate(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.PrivacyBudgetTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
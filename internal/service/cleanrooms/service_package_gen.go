@@ -20,6 +20,35 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newResourceAnalysisTemplate,
+			TypeName: "aws_cleanrooms_analysis_template",
+			Name:     "Analysis Template",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newResourceConfiguredTableAnalysisRule,
+			TypeName: "aws_cleanrooms_configured_table_analysis_rule",
+			Name:     "Configured Table Analysis Rule",
+		},
+		{
+			Factory:  newResourceConfiguredTableAssociation,
+			TypeName: "aws_cleanrooms_configured_table_association",
+			Name:     "Configured Table Association",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  newResourceIDNamespaceAssociation,
+			TypeName: "aws_cleanrooms_id_namespace_association",
+			Name:     "ID Namespace Association",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  newResourceMembership,
 			TypeName: "aws_cleanrooms_membership",
@@ -28,6 +57,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  newResourcePrivacyBudgetTemplate,
+			TypeName: "aws_cleanrooms_privacy_budget_template",
+			Name:     "Privacy Budget Template",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 	}
 }
 
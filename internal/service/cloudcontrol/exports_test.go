@@ -5,7 +5,8 @@ package cloudcontrol
 
 // Exports for use in tests only.
 var (
-	ResourceResource = resourceResource
+	ResourceResource = newResourceResource
 
-	FindResource = findResource
+	FindResource                            = findResource
+	WaitProgressEventOperationStatusSuccess = waitProgressEventOperationStatusSuccess
 )
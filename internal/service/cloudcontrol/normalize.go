@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+const (
+	propertyPathPrefix  = "/properties"
+	definitionRefPrefix = "#/definitions/"
+)
+
+// resourceSchema is the subset of a CloudFormation resource type schema used to normalize resource documents.
+// See https://docs.aws.amazon.com/cloudformation-cli/latest/userguide/resource-type-schema.html.
+type resourceSchema struct {
+	Definitions         map[string]*resourceSchemaProperty `json:"definitions"`
+	PrimaryIdentifier   []string                           `json:"primaryIdentifier"`
+	Properties          map[string]*resourceSchemaProperty `json:"properties"`
+	ReadOnlyProperties  []string                           `json:"readOnlyProperties"`
+	WriteOnlyProperties []string                           `json:"writeOnlyProperties"`
+
+	primaryIdentifier map[string]struct{}
+	readOnly          map[string]struct{}
+	writeOnly         map[string]struct{}
+}
+
+type resourceSchemaProperty struct {
+	Default        any                                `json:"default"`
+	InsertionOrder *bool                              `json:"insertionOrder"`
+	Items          *resourceSchemaProperty            `json:"items"`
+	Properties     map[string]*resourceSchemaProperty `json:"properties"`
+	Ref            string                             `json:"$ref"`
+}
+
+// newResourceSchema parses a CloudFormation resource type schema JSON document.
+func newResourceSchema(document string) (*resourceSchema, error) {
+	var s resourceSchema
+
+	if err := json.Unmarshal([]byte(document), &s); err != nil {
+		return nil, err
+	}
+
+	s.primaryIdentifier = propertyPathSet(s.PrimaryIdentifier)
+	s.readOnly = propertyPathSet(s.ReadOnlyProperties)
+	s.writeOnly = propertyPathSet(s.WriteOnlyProperties)
+
+	return &s, nil
+}
+
+// normalizeDocument returns the canonical form of the specified resource document.
+// Read-only properties are removed, defaults are applied and arrays with insertionOrder set to false are sorted.
+// Write-only properties are optionally removed.
+func (s *resourceSchema) normalizeDocument(document string, removeWriteOnly bool) (any, error) {
+	var v any
+
+	if err := json.Unmarshal([]byte(document), &v); err != nil {
+		return nil, err
+	}
+
+	return s.normalize(v, &resourceSchemaProperty{Properties: s.Properties}, "", removeWriteOnly), nil
+}
+
+// documentsEqual returns whether two resource documents are equivalent once normalized.
+func (s *resourceSchema) documentsEqual(a, b string) (bool, error) {
+	na, err := s.normalizeDocument(a, false)
+
+	if err != nil {
+		return false, err
+	}
+
+	nb, err := s.normalizeDocument(b, false)
+
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(na, nb), nil
+}
+
+// driftedDocument compares the desired state with the properties returned by Cloud Control API.
+// Only properties present in the desired state are considered. Write-only and primary identifier
+// properties are taken from the desired state as they cannot be meaningfully compared.
+// If the documents are equivalent the desired state is returned unchanged, otherwise a
+// desired state document reflecting the current resource properties is returned.
+func (s *resourceSchema) driftedDocument(desiredState, properties string) (string, error) {
+	var desired, current any
+
+	if err := json.Unmarshal([]byte(desiredState), &desired); err != nil {
+		return "", err
+	}
+
+	if err := json.Unmarshal([]byte(properties), &current); err != nil {
+		return "", err
+	}
+
+	root := &resourceSchemaProperty{Properties: s.Properties}
+	projected := s.project(current, desired, root, "")
+
+	if reflect.DeepEqual(s.normalize(projected, root, "", true), s.normalize(desired, root, "", true)) {
+		return desiredState, nil
+	}
+
+	b, err := json.Marshal(projected)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func (s *resourceSchema) normalize(v any, property *resourceSchemaProperty, path string, removeWriteOnly bool) any {
+	property = s.resolve(property)
+
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+
+		for k, e := range v {
+			path := path + "/" + k
+
+			if _, ok := s.readOnly[path]; ok {
+				continue
+			}
+
+			if _, ok := s.writeOnly[path]; ok && removeWriteOnly {
+				continue
+			}
+
+			m[k] = s.normalize(e, property.property(k), path, removeWriteOnly)
+		}
+
+		if property != nil {
+			for k, p := range property.Properties {
+				path := path + "/" + k
+
+				if _, ok := s.writeOnly[path]; ok && removeWriteOnly {
+					continue
+				}
+
+				if _, ok := m[k]; !ok {
+					if p := s.resolve(p); p != nil && p.Default != nil {
+						m[k] = s.normalize(p.Default, p, path, removeWriteOnly)
+					}
+				}
+			}
+		}
+
+		return m
+	case []any:
+		var items *resourceSchemaProperty
+
+		if property != nil {
+			items = property.Items
+		}
+
+		l := make([]any, 0, len(v))
+
+		for _, e := range v {
+			l = append(l, s.normalize(e, items, path+"/*", removeWriteOnly))
+		}
+
+		if property != nil && property.InsertionOrder != nil && !aws.ToBool(property.InsertionOrder) {
+			slices.SortStableFunc(l, func(a, b any) int {
+				return strings.Compare(canonicalJSON(a), canonicalJSON(b))
+			})
+		}
+
+		return l
+	default:
+		return v
+	}
+}
+
+// project returns the current value restricted to the shape of the desired value.
+func (s *resourceSchema) project(current, desired any, property *resourceSchemaProperty, path string) any {
+	property = s.resolve(property)
+
+	switch d := desired.(type) {
+	case map[string]any:
+		c, ok := current.(map[string]any)
+
+		if !ok {
+			return current
+		}
+
+		m := make(map[string]any, len(d))
+
+		for k, e := range d {
+			path := path + "/" + k
+
+			if _, ok := s.writeOnly[path]; ok {
+				m[k] = e
+				continue
+			}
+
+			if _, ok := s.primaryIdentifier[path]; ok {
+				m[k] = e
+				continue
+			}
+
+			// Some handlers do not return every property that was set.
+			// Only properties returned by Cloud Control API are checked for drift.
+			v, ok := c[k]
+
+			if !ok {
+				m[k] = e
+				continue
+			}
+
+			m[k] = s.project(v, e, property.property(k), path)
+		}
+
+		// Properties omitted from the desired state take their default value, so check those too.
+		if property != nil {
+			for k, p := range property.Properties {
+				if _, ok := d[k]; ok {
+					continue
+				}
+
+				if p := s.resolve(p); p == nil || p.Default == nil {
+					continue
+				}
+
+				path := path + "/" + k
+
+				if _, ok := s.readOnly[path]; ok {
+					continue
+				}
+
+				if v, ok := c[k]; ok {
+					m[k] = s.normalize(v, property.property(k), path, false)
+				}
+			}
+		}
+
+		return m
+	case []any:
+		c, ok := current.([]any)
+
+		if !ok {
+			return current
+		}
+
+		var items *resourceSchemaProperty
+
+		if property != nil {
+			items = property.Items
+		}
+
+		path := path + "/*"
+		l := make([]any, 0, len(c))
+
+		if property != nil && property.InsertionOrder != nil && !aws.ToBool(property.InsertionOrder) {
+			used := make([]bool, len(d))
+
+		outer:
+			for _, v := range c {
+				for i, e := range d {
+					if used[i] {
+						continue
+					}
+
+					if p := s.project(v, e, items, path); reflect.DeepEqual(s.normalize(p, items, path, true), s.normalize(e, items, path, true)) {
+						used[i] = true
+						l = append(l, p)
+						continue outer
+					}
+				}
+
+				l = append(l, s.normalize(v, items, path, false))
+			}
+
+			return l
+		}
+
+		for i, v := range c {
+			if i < len(d) {
+				l = append(l, s.project(v, d[i], items, path))
+			} else {
+				l = append(l, s.normalize(v, items, path, false))
+			}
+		}
+
+		return l
+	default:
+		return current
+	}
+}
+
+// resolve follows any $ref to a schema definition.
+func (s *resourceSchema) resolve(property *resourceSchemaProperty) *resourceSchemaProperty {
+	// Guard against self-referencing definitions.
+	for range 32 {
+		if property == nil || property.Ref == "" {
+			return property
+		}
+
+		property = s.Definitions[strings.TrimPrefix(property.Ref, definitionRefPrefix)]
+	}
+
+	return property
+}
+
+func (p *resourceSchemaProperty) property(name string) *resourceSchemaProperty {
+	if p == nil {
+		return nil
+	}
+
+	return p.Properties[name]
+}
+
+// propertyPathSet converts a list of property JSON pointers (e.g. "/properties/Tags/*/Key") into a set of document paths.
+func propertyPathSet(pointers []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(pointers))
+
+	for _, v := range pointers {
+		set[strings.TrimPrefix(v, propertyPathPrefix)] = struct{}{}
+	}
+
+	return set
+}
+
+func canonicalJSON(v any) string {
+	// Map keys are sorted by encoding/json.
+	b, _ := json.Marshal(v)
+
+	return string(b)
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"testing"
+)
+
+const testResourceSchema = `{
+  "typeName": "Example::Test::Resource",
+  "definitions": {
+    "Tag": {
+      "type": "object",
+      "properties": {
+        "Key": {"type": "string"},
+        "Value": {"type": "string"}
+      }
+    }
+  },
+  "properties": {
+    "Arn": {"type": "string"},
+    "Enabled": {"type": "boolean", "default": true},
+    "Name": {"type": "string"},
+    "Password": {"type": "string"},
+    "Subnets": {"type": "array", "insertionOrder": true, "items": {"type": "string"}},
+    "Tags": {"type": "array", "insertionOrder": false, "items": {"$ref": "#/definitions/Tag"}}
+  },
+  "primaryIdentifier": ["/properties/Name"],
+  "readOnlyProperties": ["/properties/Arn"],
+  "writeOnlyProperties": ["/properties/Password"]
+}`
+
+func TestResourceSchemaDocumentsEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b string
+		want bool
+	}{
+		"identical": {
+			a:    `{"Name":"test"}`,
+			b:    `{"Name":"test"}`,
+			want: true,
+		},
+		"key order": {
+			a:    `{"Name":"test","Enabled":false}`,
+			b:    `{"Enabled":false,"Name":"test"}`,
+			want: true,
+		},
+		"default": {
+			a:    `{"Name":"test"}`,
+			b:    `{"Name":"test","Enabled":true}`,
+			want: true,
+		},
+		"non-default": {
+			a:    `{"Name":"test"}`,
+			b:    `{"Name":"test","Enabled":false}`,
+			want: false,
+		},
+		"read-only": {
+			a:    `{"Name":"test"}`,
+			b:    `{"Name":"test","Arn":"arn:aws:example:::test"}`,
+			want: true,
+		},
+		"unordered array": {
+			a:    `{"Name":"test","Tags":[{"Key":"k1","Value":"v1"},{"Key":"k2","Value":"v2"}]}`,
+			b:    `{"Name":"test","Tags":[{"Key":"k2","Value":"v2"},{"Key":"k1","Value":"v1"}]}`,
+			want: true,
+		},
+		"ordered array": {
+			a:    `{"Name":"test","Subnets":["a","b"]}`,
+			b:    `{"Name":"test","Subnets":["b","a"]}`,
+			want: false,
+		},
+		"write-only": {
+			a:    `{"Name":"test","Password":"a"}`,
+			b:    `{"Name":"test","Password":"b"}`,
+			want: false,
+		},
+	}
+
+	s, err := newResourceSchema(testResourceSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := s.documentsEqual(testCase.a, testCase.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("documentsEqual(%s, %s) = %t, want %t", testCase.a, testCase.b, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestResourceSchemaDriftedDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		desiredState string
+		properties   string
+		want         string
+	}{
+		"no drift": {
+			desiredState: `{"Name":"test","Password":"secret","Tags":[{"Key":"k1","Value":"v1"},{"Key":"k2","Value":"v2"}]}`,
+			properties:   `{"Arn":"arn:aws:example:::test","Enabled":true,"Name":"test","Tags":[{"Key":"k2","Value":"v2"},{"Key":"k1","Value":"v1"}]}`,
+			want:         `{"Name":"test","Password":"secret","Tags":[{"Key":"k1","Value":"v1"},{"Key":"k2","Value":"v2"}]}`,
+		},
+		"default drift": {
+			desiredState: `{"Name":"test"}`,
+			properties:   `{"Enabled":false,"Name":"test"}`,
+			want:         `{"Enabled":false,"Name":"test"}`,
+		},
+		"value drift": {
+			desiredState: `{"Enabled":true,"Name":"test","Password":"secret"}`,
+			properties:   `{"Enabled":false,"Name":"test"}`,
+			want:         `{"Enabled":false,"Name":"test","Password":"secret"}`,
+		},
+		"unordered array drift": {
+			desiredState: `{"Name":"test","Tags":[{"Key":"k1","Value":"v1"},{"Key":"k2","Value":"v2"}]}`,
+			properties:   `{"Name":"test","Tags":[{"Key":"k2","Value":"v3"},{"Key":"k1","Value":"v1"}]}`,
+			want:         `{"Name":"test","Tags":[{"Key":"k2","Value":"v3"},{"Key":"k1","Value":"v1"}]}`,
+		},
+	}
+
+	s, err := newResourceSchema(testResourceSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := s.driftedDocument(testCase.desiredState, testCase.properties)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("driftedDocument(%s, %s) = %s, want %s", testCase.desiredState, testCase.properties, got, testCase.want)
+			}
+		})
+	}
+}
@@ -7,326 +7,493 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
+	"math/big"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
-	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
 	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/mattbaird/jsonpatch"
 )
 
-// @SDKResource("aws_cloudcontrolapi_resource", name="Resource")
-func resourceResource() *schema.Resource {
-	return &schema.Resource{
-		CreateWithoutTimeout: resourceResourceCreate,
-		DeleteWithoutTimeout: resourceResourceDelete,
-		ReadWithoutTimeout:   resourceResourceRead,
-		UpdateWithoutTimeout: resourceResourceUpdate,
-
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(2 * time.Hour),
-			Delete: schema.DefaultTimeout(2 * time.Hour),
-			Update: schema.DefaultTimeout(2 * time.Hour),
-		},
+// @FrameworkResource("aws_cloudcontrolapi_resource", name="Resource")
+func newResourceResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceResource{}
 
-		Schema: map[string]*schema.Schema{
-			"desired_state": {
-				Type:     schema.TypeString,
-				Required: true,
+	r.SetDefaultCreateTimeout(2 * time.Hour)
+	r.SetDefaultUpdateTimeout(2 * time.Hour)
+	r.SetDefaultDeleteTimeout(2 * time.Hour)
+
+	return r, nil
+}
+
+type resourceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"desired_state": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrProperties: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			names.AttrProperties: {
-				Type:     schema.TypeString,
+			"properties_object": schema.DynamicAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.Dynamic{
+					dynamicplanmodifier.UseStateForUnknown(),
+				},
 			},
-			names.AttrRoleARN: {
-				Type:     schema.TypeString,
+			names.AttrRoleARN: schema.StringAttribute{
 				Optional: true,
 			},
-			names.AttrSchema: {
-				Type:      schema.TypeString,
+			names.AttrSchema: schema.StringAttribute{
 				Optional:  true,
 				Computed:  true,
 				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-			"type_name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+			"type_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+				},
 			},
-			"type_version_id": {
-				Type:     schema.TypeString,
+			"type_version_id": schema.StringAttribute{
 				Optional: true,
 			},
 		},
-
-		CustomizeDiff: customdiff.Sequence(
-			resourceResourceCustomizeDiffGetSchema,
-			resourceResourceCustomizeDiffSchemaDiff,
-			customdiff.ComputedIf(names.AttrProperties, func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
-				return diff.HasChange("desired_state")
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
 			}),
-		),
+		},
 	}
 }
 
-func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+func (r *resourceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-	conn := meta.(*conns.AWSClient).CloudControlClient(ctx)
+	conn := r.Meta().CloudControlClient(ctx)
 
-	typeName := d.Get("type_name").(string)
-	input := &cloudcontrol.CreateResourceInput{
-		ClientToken:  aws.String(id.UniqueId()),
-		DesiredState: aws.String(d.Get("desired_state").(string)),
-		TypeName:     aws.String(typeName),
+	typeName := data.TypeName.ValueString()
+	input := cloudcontrol.CreateResourceInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		DesiredState:  data.DesiredState.ValueStringPointer(),
+		RoleArn:       data.RoleARN.ValueStringPointer(),
+		TypeName:      aws.String(typeName),
+		TypeVersionId: data.TypeVersionID.ValueStringPointer(),
 	}
 
-	if v, ok := d.GetOk(names.AttrRoleARN); ok {
-		input.RoleArn = aws.String(v.(string))
-	}
+	output, err := conn.CreateResource(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Cloud Control API (%s) Resource", typeName), err.Error())
 
-	if v, ok := d.GetOk("type_version_id"); ok {
-		input.TypeVersionId = aws.String(v.(string))
+		return
 	}
 
-	output, err := conn.CreateResource(ctx, input)
+	progressEvent, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), r.CreateTimeout(ctx, data.Timeouts))
+
+	// Some resources do not set the identifier until after creation.
+	identifier := aws.ToString(output.ProgressEvent.Identifier)
+	if progressEvent != nil && aws.ToString(progressEvent.Identifier) != "" {
+		identifier = aws.ToString(progressEvent.Identifier)
+	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating Cloud Control API (%s) Resource: %s", typeName, err)
+		// Always try to capture the identifier before returning errors, so that the resource is tainted.
+		if identifier != "" {
+			data.ID = types.StringValue(identifier)
+			data.Properties = types.StringNull()
+			data.PropertiesObject = types.DynamicNull()
+			response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+		}
+
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Cloud Control API (%s) Resource (%s) create", typeName, identifier), err.Error())
+
+		return
 	}
 
-	// Always try to capture the identifier before returning errors.
-	d.SetId(aws.ToString(output.ProgressEvent.Identifier))
+	data.ID = types.StringValue(identifier)
 
-	output.ProgressEvent, err = waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), d.Timeout(schema.TimeoutCreate))
+	description, err := findResource(ctx, conn, data.ID.ValueString(), typeName, data.TypeVersionID.ValueString(), data.RoleARN.ValueString())
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Cloud Control API (%s) Resource (%s) create: %s", typeName, d.Id(), err)
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", typeName, data.ID.ValueString()), err.Error())
+
+		return
 	}
 
-	// Some resources do not set the identifier until after creation.
-	if d.Id() == "" {
-		d.SetId(aws.ToString(output.ProgressEvent.Identifier))
+	response.Diagnostics.Append(data.setProperties(ctx, description.Properties)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	return append(diags, resourceResourceRead(ctx, d, meta)...)
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
-func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+func (r *resourceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-	conn := meta.(*conns.AWSClient).CloudControlClient(ctx)
+	conn := r.Meta().CloudControlClient(ctx)
 
-	typeName := d.Get("type_name").(string)
-	resourceDescription, err := findResource(ctx, conn,
-		d.Id(),
-		typeName,
-		d.Get("type_version_id").(string),
-		d.Get(names.AttrRoleARN).(string),
-	)
+	typeName := data.TypeName.ValueString()
+	description, err := findResource(ctx, conn, data.ID.ValueString(), typeName, data.TypeVersionID.ValueString(), data.RoleARN.ValueString())
 
-	if !d.IsNewResource() && tfresource.NotFound(err) {
-		log.Printf("[WARN] Cloud Control API Resource (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return diags
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
-	}
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", typeName, data.ID.ValueString()), err.Error())
 
-	d.Set(names.AttrProperties, resourceDescription.Properties)
+		return
+	}
 
-	return diags
-}
+	response.Diagnostics.Append(data.setProperties(ctx, description.Properties)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+	// Surface drift between the desired state and the current resource properties.
+	if desiredState, resourceSchema := data.DesiredState.ValueString(), data.Schema.ValueString(); desiredState != "" && resourceSchema != "" {
+		s, err := newResourceSchema(resourceSchema)
 
-	conn := meta.(*conns.AWSClient).CloudControlClient(ctx)
+		if err != nil {
+			response.Diagnostics.AddError("parsing CloudFormation Resource Schema JSON", err.Error())
 
-	if d.HasChange("desired_state") {
-		oldRaw, newRaw := d.GetChange("desired_state")
+			return
+		}
 
-		patchDocument, err := patchDocument(oldRaw.(string), newRaw.(string))
+		v, err := s.driftedDocument(desiredState, aws.ToString(description.Properties))
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "creating JSON Patch: %s", err)
-		}
+			response.Diagnostics.AddError(fmt.Sprintf("comparing Cloud Control API (%s) Resource (%s) properties", typeName, data.ID.ValueString()), err.Error())
 
-		typeName := d.Get("type_name").(string)
-		input := &cloudcontrol.UpdateResourceInput{
-			ClientToken:   aws.String(id.UniqueId()),
-			Identifier:    aws.String(d.Id()),
-			PatchDocument: aws.String(patchDocument),
-			TypeName:      aws.String(typeName),
+			return
 		}
 
-		if v, ok := d.GetOk(names.AttrRoleARN); ok {
-			input.RoleArn = aws.String(v.(string))
-		}
+		data.DesiredState = jsontypes.NewNormalizedValue(v)
+	}
 
-		if v, ok := d.GetOk("type_version_id"); ok {
-			input.TypeVersionId = aws.String(v.(string))
-		}
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
 
-		output, err := conn.UpdateResource(ctx, input)
+func (r *resourceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudControlClient(ctx)
+
+	typeName := new.TypeName.ValueString()
+
+	if !new.DesiredState.Equal(old.DesiredState) {
+		// Equivalent desired states, e.g. with unordered arrays reordered, need no update.
+		equal, err := desiredStatesEqual(new.Schema.ValueString(), old.DesiredState.ValueString(), new.DesiredState.ValueString())
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
-		}
+			response.Diagnostics.AddError("comparing desired_state", err.Error())
 
-		if _, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Cloud Control API (%s) Resource (%s) update: %s", typeName, d.Id(), err)
+			return
 		}
-	}
 
-	return append(diags, resourceResourceRead(ctx, d, meta)...)
-}
+		if !equal {
+			patchDocument, err := patchDocument(old.DesiredState.ValueString(), new.DesiredState.ValueString())
 
-func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+			if err != nil {
+				response.Diagnostics.AddError("creating JSON Patch", err.Error())
+
+				return
+			}
+
+			input := cloudcontrol.UpdateResourceInput{
+				ClientToken:   aws.String(id.UniqueId()),
+				Identifier:    new.ID.ValueStringPointer(),
+				PatchDocument: aws.String(patchDocument),
+				RoleArn:       new.RoleARN.ValueStringPointer(),
+				TypeName:      aws.String(typeName),
+				TypeVersionId: new.TypeVersionID.ValueStringPointer(),
+			}
 
-	conn := meta.(*conns.AWSClient).CloudControlClient(ctx)
+			output, err := conn.UpdateResource(ctx, &input)
 
-	typeName := d.Get("type_name").(string)
-	input := &cloudcontrol.DeleteResourceInput{
-		ClientToken: aws.String(id.UniqueId()),
-		Identifier:  aws.String(d.Id()),
-		TypeName:    aws.String(typeName),
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating Cloud Control API (%s) Resource (%s)", typeName, new.ID.ValueString()), err.Error())
+
+				return
+			}
+
+			if _, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("waiting for Cloud Control API (%s) Resource (%s) update", typeName, new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
 	}
 
-	if v, ok := d.GetOk(names.AttrRoleARN); ok {
-		input.RoleArn = aws.String(v.(string))
+	description, err := findResource(ctx, conn, new.ID.ValueString(), typeName, new.TypeVersionID.ValueString(), new.RoleARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", typeName, new.ID.ValueString()), err.Error())
+
+		return
 	}
 
-	if v, ok := d.GetOk("type_version_id"); ok {
-		input.TypeVersionId = aws.String(v.(string))
+	response.Diagnostics.Append(new.setProperties(ctx, description.Properties)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	log.Printf("[INFO] Deleting Cloud Control API (%s) Resource: %s", typeName, d.Id())
-	output, err := conn.DeleteResource(ctx, input)
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
+func (r *resourceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	progressEvent, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), d.Timeout(schema.TimeoutDelete))
+	conn := r.Meta().CloudControlClient(ctx)
 
-	if progressEvent != nil && progressEvent.ErrorCode == types.HandlerErrorCodeNotFound {
-		return diags
+	typeName := data.TypeName.ValueString()
+	input := cloudcontrol.DeleteResourceInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		Identifier:    data.ID.ValueStringPointer(),
+		RoleArn:       data.RoleARN.ValueStringPointer(),
+		TypeName:      aws.String(typeName),
+		TypeVersionId: data.TypeVersionID.ValueStringPointer(),
 	}
 
+	output, err := conn.DeleteResource(ctx, &input)
+
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Cloud Control API (%s) Resource (%s) delete: %s", typeName, d.Id(), err)
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Cloud Control API (%s) Resource (%s)", typeName, data.ID.ValueString()), err.Error())
+
+		return
 	}
 
-	return diags
-}
+	progressEvent, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), r.DeleteTimeout(ctx, data.Timeouts))
 
-func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+	if progressEvent != nil && progressEvent.ErrorCode == awstypes.HandlerErrorCodeNotFound {
+		return
+	}
 
-	resourceSchema := diff.Get(names.AttrSchema).(string)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Cloud Control API (%s) Resource (%s) delete", typeName, data.ID.ValueString()), err.Error())
 
-	if resourceSchema != "" {
-		return nil
+		return
 	}
+}
 
-	typeName := diff.Get("type_name").(string)
-
-	output, err := tfcloudformation.FindTypeByName(ctx, conn, typeName)
+// ModifyPlan reads the CloudFormation resource type schema, validates the desired state against it
+// and requires replacement when a create-only property changes.
+func (r *resourceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
 
-	if err != nil {
-		return fmt.Errorf("reading CloudFormation Type (%s): %w", typeName, err)
+	var plan, config resourceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	if err := diff.SetNew(names.AttrSchema, output.Schema); err != nil {
-		return fmt.Errorf("setting schema New: %w", err)
+	var state *resourceResourceModel
+	if !request.State.Raw.IsNull() {
+		state = &resourceResourceModel{}
+		response.Diagnostics.Append(request.State.Get(ctx, state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	return nil
-}
+	// Read the schema unless it is configured or known from the prior state of the same resource type.
+	if config.Schema.IsNull() && !plan.TypeName.IsUnknown() && (plan.Schema.IsUnknown() || state == nil || !state.TypeName.Equal(plan.TypeName)) {
+		conn := r.Meta().CloudFormationClient(ctx)
 
-func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	oldDesiredStateRaw, newDesiredStateRaw := diff.GetChange("desired_state")
-	newSchema := diff.Get(names.AttrSchema).(string)
+		typeName := plan.TypeName.ValueString()
+		output, err := tfcloudformation.FindTypeByName(ctx, conn, typeName)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading CloudFormation Type (%s)", typeName), err.Error())
 
-	newDesiredState, ok := newDesiredStateRaw.(string)
+			return
+		}
 
-	if !ok {
-		return fmt.Errorf("unexpected new desired_state value type: %T", newDesiredStateRaw)
+		plan.Schema = types.StringPointerValue(output.Schema)
 	}
 
-	// desired_state can be empty if unknown
-	if newDesiredState == "" {
-		return nil
+	if plan.DesiredState.IsUnknown() || plan.Schema.IsUnknown() {
+		plan.Properties = types.StringUnknown()
+		plan.PropertiesObject = types.DynamicUnknown()
+		response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+
+		return
 	}
 
-	newSchema, err := cfschema.Sanitize(newSchema)
+	resourceSchema, err := cfschema.Sanitize(plan.Schema.ValueString())
 
 	if err != nil {
-		return fmt.Errorf("sanitizing CloudFormation Resource Schema JSON: %w", err)
+		response.Diagnostics.AddError("sanitizing CloudFormation Resource Schema JSON", err.Error())
+
+		return
 	}
 
-	cfResourceSchema, err := cfschema.NewResourceJsonSchemaDocument(newSchema)
+	cfResourceSchema, err := cfschema.NewResourceJsonSchemaDocument(resourceSchema)
 
 	if err != nil {
-		return fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
-	}
+		response.Diagnostics.AddError("parsing CloudFormation Resource Schema JSON", err.Error())
 
-	if err := cfResourceSchema.ValidateConfigurationDocument(newDesiredState); err != nil {
-		return fmt.Errorf("validating desired_state against CloudFormation Resource Schema: %w", err)
+		return
 	}
 
-	// Do nothing further for new resources or if desired state is not changed
-	if diff.Id() == "" || !diff.HasChange("desired_state") {
-		return nil
+	if err := cfResourceSchema.ValidateConfigurationDocument(plan.DesiredState.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("desired_state"), "validating desired_state against CloudFormation Resource Schema", err.Error())
+
+		return
 	}
 
-	cfResource, err := cfResourceSchema.Resource()
+	if state != nil && !state.DesiredState.Equal(plan.DesiredState) {
+		cfResource, err := cfResourceSchema.Resource()
 
-	if err != nil {
-		return fmt.Errorf("converting CloudFormation Resource Schema JSON: %w", err)
-	}
+		if err != nil {
+			response.Diagnostics.AddError("converting CloudFormation Resource Schema JSON", err.Error())
 
-	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredStateRaw.(string)), []byte(newDesiredStateRaw.(string)))
+			return
+		}
 
-	if err != nil {
-		return fmt.Errorf("creating desired_state JSON Patch: %w", err)
-	}
+		// Compare normalized documents so that equivalent changes, e.g. reordering of unordered arrays, do not force replacement.
+		patches, err := normalizedPatch(resourceSchema, state.DesiredState.ValueString(), plan.DesiredState.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError("comparing desired_state", err.Error())
+
+			return
+		}
+
+		for _, patch := range patches {
+			if cfResource.IsCreateOnlyPropertyPath(patch.Path) {
+				response.RequiresReplace = append(response.RequiresReplace, path.Root("desired_state"))
 
-	for _, patch := range patches {
-		if cfResource.IsCreateOnlyPropertyPath(patch.Path) {
-			if err := diff.ForceNew("desired_state"); err != nil {
-				return fmt.Errorf("setting desired_state ForceNew: %w", err)
+				break
 			}
+		}
 
-			break
+		if len(patches) > 0 {
+			plan.Properties = types.StringUnknown()
+			plan.PropertiesObject = types.DynamicUnknown()
+		} else {
+			// Keep equivalent desired states out of the plan.
+			plan.DesiredState = state.DesiredState
 		}
 	}
 
-	return nil
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+}
+
+type resourceResourceModel struct {
+	DesiredState     jsontypes.Normalized `tfsdk:"desired_state"`
+	ID               types.String         `tfsdk:"id"`
+	Properties       types.String         `tfsdk:"properties"`
+	PropertiesObject types.Dynamic        `tfsdk:"properties_object"`
+	RoleARN          types.String         `tfsdk:"role_arn"`
+	Schema           types.String         `tfsdk:"schema"`
+	Timeouts         timeouts.Value       `tfsdk:"timeouts"`
+	TypeName         types.String         `tfsdk:"type_name"`
+	TypeVersionID    types.String         `tfsdk:"type_version_id"`
 }
 
-func findResource(ctx context.Context, conn *cloudcontrol.Client, resourceID, typeName, typeVersionID, roleARN string) (*types.ResourceDescription, error) {
+// setProperties sets the resource's properties from the JSON document returned by Cloud Control API.
+func (m *resourceResourceModel) setProperties(ctx context.Context, properties *string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Properties = types.StringPointerValue(properties)
+
+	if properties == nil {
+		m.PropertiesObject = types.DynamicNull()
+
+		return diags
+	}
+
+	v, err := propertiesObject(ctx, aws.ToString(properties))
+
+	if err != nil {
+		diags.AddError("decoding Cloud Control API Resource properties", err.Error())
+
+		return diags
+	}
+
+	m.PropertiesObject = v
+
+	return diags
+}
+
+func findResource(ctx context.Context, conn *cloudcontrol.Client, resourceID, typeName, typeVersionID, roleARN string) (*awstypes.ResourceDescription, error) {
 	input := &cloudcontrol.GetResourceInput{
 		Identifier: aws.String(resourceID),
 		TypeName:   aws.String(typeName),
@@ -340,7 +507,7 @@ func findResource(ctx context.Context, conn *cloudcontrol.Client, resourceID, ty
 
 	output, err := conn.GetResource(ctx, input)
 
-	if errs.IsA[*types.ResourceNotFoundException](err) {
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -367,14 +534,14 @@ func findResource(ctx context.Context, conn *cloudcontrol.Client, resourceID, ty
 	return output.ResourceDescription, nil
 }
 
-func findProgressEventByRequestToken(ctx context.Context, conn *cloudcontrol.Client, requestToken string) (*types.ProgressEvent, error) {
+func findProgressEventByRequestToken(ctx context.Context, conn *cloudcontrol.Client, requestToken string) (*awstypes.ProgressEvent, error) {
 	input := &cloudcontrol.GetResourceRequestStatusInput{
 		RequestToken: aws.String(requestToken),
 	}
 
 	output, err := conn.GetResourceRequestStatus(ctx, input)
 
-	if errs.IsA[*types.RequestTokenNotFoundException](err) {
+	if errs.IsA[*awstypes.RequestTokenNotFoundException](err) {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -408,18 +575,18 @@ func statusProgressEventOperation(ctx context.Context, conn *cloudcontrol.Client
 	}
 }
 
-func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcontrol.Client, requestToken string, timeout time.Duration) (*types.ProgressEvent, error) {
+func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcontrol.Client, requestToken string, timeout time.Duration) (*awstypes.ProgressEvent, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.OperationStatusInProgress, types.OperationStatusPending),
-		Target:  enum.Slice(types.OperationStatusSuccess),
+		Pending: enum.Slice(awstypes.OperationStatusInProgress, awstypes.OperationStatusPending),
+		Target:  enum.Slice(awstypes.OperationStatusSuccess),
 		Refresh: statusProgressEventOperation(ctx, conn, requestToken),
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*types.ProgressEvent); ok {
-		if output.OperationStatus == types.OperationStatusFailed {
+	if output, ok := outputRaw.(*awstypes.ProgressEvent); ok {
+		if output.OperationStatus == awstypes.OperationStatusFailed {
 			tfresource.SetLastError(err, fmt.Errorf("%s: %s", output.ErrorCode, aws.ToString(output.StatusMessage)))
 		}
 
@@ -445,3 +612,122 @@ func patchDocument(old, new string) (string, error) {
 
 	return string(b), nil
 }
+
+// desiredStatesEqual returns whether two desired states are equivalent according to the CloudFormation resource type schema.
+func desiredStatesEqual(resourceSchema, old, new string) (bool, error) {
+	s, err := newResourceSchema(resourceSchema)
+
+	if err != nil {
+		return false, err
+	}
+
+	return s.documentsEqual(old, new)
+}
+
+// normalizedPatch returns the JSON Patch operations between two desired states normalized according to the CloudFormation resource type schema.
+func normalizedPatch(resourceSchema, old, new string) ([]jsonpatch.JsonPatchOperation, error) {
+	s, err := newResourceSchema(resourceSchema)
+
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := s.normalizeDocument(old, false)
+
+	if err != nil {
+		return nil, fmt.Errorf("normalizing old desired_state: %w", err)
+	}
+
+	n, err := s.normalizeDocument(new, false)
+
+	if err != nil {
+		return nil, fmt.Errorf("normalizing new desired_state: %w", err)
+	}
+
+	return jsonpatch.CreatePatch([]byte(canonicalJSON(o)), []byte(canonicalJSON(n)))
+}
+
+// propertiesObject decodes a resource properties JSON document into a dynamic value.
+// As with Terraform's jsondecode function, JSON objects become objects and JSON arrays become tuples.
+func propertiesObject(ctx context.Context, properties string) (types.Dynamic, error) {
+	decoder := json.NewDecoder(strings.NewReader(properties))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return types.DynamicNull(), err
+	}
+
+	value, err := jsonValueToFramework(ctx, v)
+
+	if err != nil {
+		return types.DynamicNull(), err
+	}
+
+	return types.DynamicValue(value), nil
+}
+
+func jsonValueToFramework(ctx context.Context, v any) (attr.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case json.Number:
+		f, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NumberValue(f), nil
+	case string:
+		return types.StringValue(v), nil
+	case []any:
+		elementTypes := make([]attr.Type, 0, len(v))
+		elements := make([]attr.Value, 0, len(v))
+
+		for _, v := range v {
+			element, err := jsonValueToFramework(ctx, v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			elementTypes = append(elementTypes, element.Type(ctx))
+			elements = append(elements, element)
+		}
+
+		value, diags := types.TupleValue(elementTypes, elements)
+
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting JSON array: %s", diags.Errors()[0].Detail())
+		}
+
+		return value, nil
+	case map[string]any:
+		attributeTypes := make(map[string]attr.Type, len(v))
+		attributes := make(map[string]attr.Value, len(v))
+
+		for k, v := range v {
+			attribute, err := jsonValueToFramework(ctx, v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			attributeTypes[k] = attribute.Type(ctx)
+			attributes[k] = attribute
+		}
+
+		value, diags := types.ObjectValue(attributeTypes, attributes)
+
+		if diags.HasError() {
+			return nil, fmt.Errorf("converting JSON object: %s", diags.Errors()[0].Detail())
+		}
+
+		return value, nil
+	default:
+		return nil, fmt.Errorf("unexpected JSON value type: %T", v)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPropertiesObject(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		properties  string
+		want        types.Dynamic
+		expectError bool
+	}{
+		"empty object": {
+			properties: `{}`,
+			want:       types.DynamicValue(types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{})),
+		},
+		"scalars": {
+			properties: `{"Name":"example","Enabled":true,"RetentionInDays":7,"Ratio":0.5,"Description":null}`,
+			want: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"Description":     types.StringType,
+					"Enabled":         types.BoolType,
+					"Name":            types.StringType,
+					"Ratio":           types.NumberType,
+					"RetentionInDays": types.NumberType,
+				},
+				map[string]attr.Value{
+					"Description":     types.StringNull(),
+					"Enabled":         types.BoolValue(true),
+					"Name":            types.StringValue("example"),
+					"Ratio":           types.NumberValue(big.NewFloat(0.5)),
+					"RetentionInDays": types.NumberValue(big.NewFloat(7)),
+				},
+			)),
+		},
+		"nested": {
+			properties: `{"Tags":[{"Key":"k","Value":"v"}],"Ports":[80,"http"]}`,
+			want: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"Ports": types.TupleType{ElemTypes: []attr.Type{types.NumberType, types.StringType}},
+					"Tags": types.TupleType{ElemTypes: []attr.Type{
+						types.ObjectType{AttrTypes: map[string]attr.Type{"Key": types.StringType, "Value": types.StringType}},
+					}},
+				},
+				map[string]attr.Value{
+					"Ports": types.TupleValueMust(
+						[]attr.Type{types.NumberType, types.StringType},
+						[]attr.Value{types.NumberValue(big.NewFloat(80)), types.StringValue("http")},
+					),
+					"Tags": types.TupleValueMust(
+						[]attr.Type{types.ObjectType{AttrTypes: map[string]attr.Type{"Key": types.StringType, "Value": types.StringType}}},
+						[]attr.Value{types.ObjectValueMust(
+							map[string]attr.Type{"Key": types.StringType, "Value": types.StringType},
+							map[string]attr.Value{"Key": types.StringValue("k"), "Value": types.StringValue("v")},
+						)},
+					),
+				},
+			)),
+		},
+		"invalid JSON": {
+			properties:  `{"Name":`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := propertiesObject(ctx, testCase.properties)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("propertiesObject() err %t, want %t: %v", got, want, err)
+			}
+
+			if err == nil && !got.Equal(testCase.want) {
+				t.Errorf("propertiesObject() = %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
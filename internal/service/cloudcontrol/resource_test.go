@@ -10,9 +10,15 @@ import (
 	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfcloudcontrol "github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
@@ -47,6 +53,55 @@ func TestAccCloudControlResource_basic(t *testing.T) {
 					resource.TestMatchResourceAttr(resourceName, names.AttrProperties, regexache.MustCompile(`^\{.*\}$`)),
 					resource.TestMatchResourceAttr(resourceName, names.AttrSchema, regexache.MustCompile(`^\{.*`)),
 				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("properties_object").AtMapKey("LogGroupName"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("properties_object").AtMapKey(names.AttrARN), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCloudControlResource_DesiredState_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_desiredStateIntegerValue(rName, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceUpdate(ctx, resourceName, `[{"op":"replace","path":"/RetentionInDays","value":14}]`),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Refresh rewrites desired_state from the resource's current properties.
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "desired_state", regexache.MustCompile(`"RetentionInDays":14`)),
+					resource.TestMatchResourceAttr(resourceName, "desired_state", regexache.MustCompile(`"LogGroupName":"`+rName+`"`)),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceConfig_desiredStateIntegerValue(rName, 7),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, names.AttrProperties, regexache.MustCompile(`"RetentionInDays":7`)),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("properties_object").AtMapKey("RetentionInDays"), knownvalue.Int64Exact(7)),
+				},
 			},
 		},
 	})
@@ -66,7 +121,7 @@ func TestAccCloudControlResource_disappears(t *testing.T) {
 			{
 				Config: testAccResourceConfig_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcloudcontrol.ResourceResource(), resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcloudcontrol.ResourceResource, resourceName),
 				),
 				ExpectNonEmptyPlan: true,
 			},
@@ -468,6 +523,41 @@ func TestAccCloudControlResource_DesiredState_stringValueUpdate(t *testing.T) {
 	})
 }
 
+func TestAccCloudControlResource_DesiredState_equivalent(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_desiredStateTags2(rName, acctest.CtKey1, acctest.CtValue1, acctest.CtKey2, acctest.CtValue2),
+			},
+			{
+				// Reordering an unordered array is not a change.
+				Config: testAccResourceConfig_desiredStateTags2(rName, acctest.CtKey2, acctest.CtValue2, acctest.CtKey1, acctest.CtValue1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// Neither is reformatting the JSON document.
+				Config: testAccResourceConfig_desiredStateTags2Formatted(rName, acctest.CtKey1, acctest.CtValue1, acctest.CtKey2, acctest.CtValue2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccCloudControlResource_resourceSchema(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -519,6 +609,70 @@ func TestAccCloudControlResource_lambdaFunction(t *testing.T) {
 	})
 }
 
+// testAccCheckResourceUpdate updates the resource outside of Terraform.
+func TestAccCloudControlResource_migrateFromPluginSDK(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, names.CloudControlServiceID),
+		CheckDestroy: testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"aws": {
+						Source:            "hashicorp/aws",
+						VersionConstraint: "5.74.0",
+					},
+				},
+				Config: testAccResourceConfig_desiredStateTags2(rName, acctest.CtKey1, acctest.CtValue1, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, names.AttrProperties, regexache.MustCompile(`^\{.*\}$`)),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				Config:                   testAccResourceConfig_desiredStateTags2(rName, acctest.CtKey1, acctest.CtValue1, acctest.CtKey2, acctest.CtValue2),
+				PlanOnly:                 true,
+			},
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				Config:                   testAccResourceConfig_desiredStateTags2(rName, acctest.CtKey2, acctest.CtValue2, acctest.CtKey1, acctest.CtValue1),
+				PlanOnly:                 true,
+			},
+		},
+	})
+}
+
+func testAccCheckResourceUpdate(ctx context.Context, n, patchDocument string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudControlClient(ctx)
+
+		input := cloudcontrol.UpdateResourceInput{
+			Identifier:    aws.String(rs.Primary.ID),
+			PatchDocument: aws.String(patchDocument),
+			TypeName:      aws.String(rs.Primary.Attributes["type_name"]),
+		}
+
+		output, err := conn.UpdateResource(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfcloudcontrol.WaitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), 5*time.Minute)
+
+		return err
+	}
+}
+
 func testAccCheckResourceDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudControlClient(ctx)
@@ -758,3 +912,43 @@ resource "aws_cloudcontrolapi_resource" "test" {
 }
 `)
 }
+
+func testAccResourceConfig_desiredStateTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    LogGroupName = %[1]q
+    Tags = [
+      {
+        Key   = %[2]q
+        Value = %[3]q
+      },
+      {
+        Key   = %[4]q
+        Value = %[5]q
+      }
+    ]
+  })
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccResourceConfig_desiredStateTags2Formatted(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = <<EOT
+{
+  "Tags": [
+    {"Value": %[3]q, "Key": %[2]q},
+    {"Value": %[5]q, "Key": %[4]q}
+  ],
+  "LogGroupName": %[1]q
+}
+EOT
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
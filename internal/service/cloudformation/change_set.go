@@ -5,7 +5,11 @@ package cloudformation
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -17,6 +21,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	// planChangeSetNamePrefix is the prefix of the names of change sets created during plan.
+	planChangeSetNamePrefix = "terraform-"
+)
+
 func findChangeSetByTwoPartKey(ctx context.Context, conn *cloudformation.Client, stackID, changeSetName string) (*cloudformation.DescribeChangeSetOutput, error) {
 	input := &cloudformation.DescribeChangeSetInput{
 		ChangeSetName: aws.String(changeSetName),
@@ -82,3 +91,96 @@ func waitChangeSetCreated(ctx context.Context, conn *cloudformation.Client, stac
 
 	return nil, err
 }
+
+// findChangeSetChangesByTwoPartKey returns all of a change set's changes.
+func findChangeSetChangesByTwoPartKey(ctx context.Context, conn *cloudformation.Client, stackID, changeSetName string) ([]awstypes.Change, error) {
+	input := &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		StackName:     aws.String(stackID),
+	}
+	var output []awstypes.Change
+
+	for {
+		page, err := conn.DescribeChangeSet(ctx, input)
+
+		if errs.IsA[*awstypes.ChangeSetNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Changes...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+// isChangeSetWithoutChanges returns whether a change set failed because it contains no changes.
+func isChangeSetWithoutChanges(output *cloudformation.DescribeChangeSetOutput) bool {
+	if output == nil || output.Status != awstypes.ChangeSetStatusFailed {
+		return false
+	}
+
+	reason := aws.ToString(output.StatusReason)
+
+	return strings.Contains(reason, "didn't contain changes") || strings.Contains(reason, "No updates are to be performed")
+}
+
+// isPlanChangeSetName returns whether a change set was created by stackPreviewChanges.
+func isPlanChangeSetName(name string) bool {
+	hash, ok := strings.CutPrefix(name, planChangeSetNamePrefix)
+
+	return ok && len(hash) == hex.EncodedLen(sha256.Size) && strings.Trim(hash, "0123456789abcdef") == ""
+}
+
+// deletePlanChangeSets deletes the stack's change sets that were created during plan, except the named change set.
+// A change set created during plan is left behind if the plan is not applied.
+func deletePlanChangeSets(ctx context.Context, conn *cloudformation.Client, stackID, keepChangeSetName string) error {
+	input := &cloudformation.ListChangeSetsInput{
+		StackName: aws.String(stackID),
+	}
+
+	pages := cloudformation.NewListChangeSetsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range page.Summaries {
+			changeSetName := aws.ToString(v.ChangeSetName)
+
+			if changeSetName == keepChangeSetName || !isPlanChangeSetName(changeSetName) || v.ExecutionStatus == awstypes.ExecutionStatusExecuteInProgress {
+				continue
+			}
+
+			input := cloudformation.DeleteChangeSetInput{
+				ChangeSetName: aws.String(changeSetName),
+				StackName:     aws.String(stackID),
+			}
+
+			_, err := conn.DeleteChangeSet(ctx, &input)
+
+			if errs.IsA[*awstypes.ChangeSetNotFoundException](err) || errs.IsA[*awstypes.InvalidChangeSetStatusException](err) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("deleting change set (%s): %w", changeSetName, err)
+			}
+		}
+	}
+
+	return nil
+}
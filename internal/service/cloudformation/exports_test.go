@@ -15,6 +15,7 @@ var (
 	FindStackInstanceSummariesByFourPartKey = findStackInstanceSummariesByFourPartKey
 	FindStackSetByName                      = findStackSetByName
 	FindTypeByARN                           = findTypeByARN
+	IsPlanChangeSetName                     = isPlanChangeSetName
 	FindStackInstancesByNameCallAs          = findStackInstancesByNameCallAs
 	StackSetInstanceResourceIDPartCount     = stackSetInstanceResourceIDPartCount
 	StackInstancesResourceIDPartCount       = stackInstancesResourceIDPartCount
@@ -6,6 +6,7 @@ package cloudformation
 import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func expandParameters(params map[string]interface{}) []awstypes.Parameter {
@@ -50,3 +51,43 @@ func flattenParameters(cfParams []awstypes.Parameter,
 	}
 	return params
 }
+
+func flattenChanges(apiObjects []awstypes.Change) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		v := apiObject.ResourceChange
+		if v == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrAction:       string(v.Action),
+			"logical_resource_id":  aws.ToString(v.LogicalResourceId),
+			"physical_resource_id": aws.ToString(v.PhysicalResourceId),
+			"replacement":          string(v.Replacement),
+			names.AttrResourceType: aws.ToString(v.ResourceType),
+		})
+	}
+
+	return tfList
+}
+
+// flattenChangesReplacements returns the logical IDs of the resources that the changes replace, or may replace.
+func flattenChangesReplacements(apiObjects []awstypes.Change) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		v := apiObject.ResourceChange
+		if v == nil {
+			continue
+		}
+
+		switch v.Replacement {
+		case awstypes.ReplacementTrue, awstypes.ReplacementConditional:
+			tfList = append(tfList, aws.ToString(v.LogicalResourceId))
+		}
+	}
+
+	return tfList
+}
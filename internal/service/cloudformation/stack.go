@@ -5,9 +5,12 @@ package cloudformation
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -26,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -61,6 +65,10 @@ func resourceStack() *schema.Resource {
 					ValidateDiagFunc: enum.Validate[awstypes.Capability](),
 				},
 			},
+			"change_set_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"disable_rollback": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -97,6 +105,39 @@ func resourceStack() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"planned_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"planned_replacements": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"policy_body": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -111,6 +152,10 @@ func resourceStack() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"preview_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"template_body": {
@@ -136,6 +181,7 @@ func resourceStack() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			customdiff.ComputedIf("outputs", stackHasActualChanges),
+			stackPreviewChanges,
 		),
 	}
 }
@@ -325,6 +371,16 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.Tags = tags
 	}
 
+	if d.Get("preview_changes").(bool) {
+		return append(diags, resourceStackUpdateWithChangeSet(ctx, d, meta, requestToken, input)...)
+	}
+
+	if o, _ := d.GetChange("preview_changes"); o.(bool) {
+		if err := deletePlanChangeSets(ctx, conn, d.Id(), ""); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting CloudFormation Stack (%s) change sets: %s", d.Id(), err)
+		}
+	}
+
 	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
 		return conn.UpdateStack(ctx, input)
 	}, errCodeValidationError, "is invalid or cannot be assumed")
@@ -344,6 +400,65 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	return append(diags, resourceStackRead(ctx, d, meta)...)
 }
 
+// resourceStackUpdateWithChangeSet updates the stack by executing the change set created during plan.
+// If that change set no longer exists, a new one is created.
+func resourceStackUpdateWithChangeSet(ctx context.Context, d *schema.ResourceData, meta interface{}, requestToken string, updateInput *cloudformation.UpdateStackInput) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	changeSetInput, err := expandStackChangeSetInput(ctx, d)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if v, ok := d.GetOk("change_set_name"); ok {
+		changeSetInput.ChangeSetName = aws.String(v.(string))
+	}
+	changeSetName := aws.ToString(changeSetInput.ChangeSetName)
+
+	changeSet, err := findOrCreateStackChangeSet(ctx, conn, changeSetInput)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CloudFormation Stack (%s) change set (%s): %s", d.Id(), changeSetName, err)
+	}
+
+	// A change set without changes is not executed, but the stack policy may still have changed.
+	if !isChangeSetWithoutChanges(changeSet) {
+		input := cloudformation.ExecuteChangeSetInput{
+			ChangeSetName:      aws.String(changeSetName),
+			ClientRequestToken: aws.String(requestToken),
+			StackName:          aws.String(d.Id()),
+		}
+
+		_, err = conn.ExecuteChangeSet(ctx, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "executing CloudFormation Stack (%s) change set (%s): %s", d.Id(), changeSetName, err)
+		}
+
+		if _, err := waitStackUpdated(ctx, conn, d.Id(), requestToken, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for CloudFormation Stack (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	// Change sets do not include the stack policy.
+	if updateInput.StackPolicyBody != nil || updateInput.StackPolicyURL != nil {
+		input := cloudformation.SetStackPolicyInput{
+			StackName:       aws.String(d.Id()),
+			StackPolicyBody: updateInput.StackPolicyBody,
+			StackPolicyURL:  updateInput.StackPolicyURL,
+		}
+
+		_, err := conn.SetStackPolicy(ctx, &input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting CloudFormation Stack (%s) policy: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceStackRead(ctx, d, meta)...)
+}
+
 func resourceStackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
@@ -673,7 +788,7 @@ func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any
 	}
 
 	for k, attr := range resourceStack().Schema {
-		if attr.ForceNew {
+		if attr.ForceNew || k == "preview_changes" {
 			continue
 		}
 		if attr.Computed && !attr.Optional {
@@ -694,3 +809,155 @@ func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any
 	}
 	return false
 }
+
+// stackPreviewChanges is a CustomizeDiff function that, when `preview_changes` is set, creates a change set for the planned stack update
+// and sets `planned_changes` to the change set's resource changes.
+// The change set is executed on apply, which deletes the stack's other change sets.
+// Change sets of plans that are not applied are deleted by the next plan.
+func stackPreviewChanges(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" || !d.Get("preview_changes").(bool) || !stackHasActualChanges(ctx, d, meta) {
+		return nil
+	}
+
+	for _, key := range []string{"capabilities", names.AttrIAMRoleARN, "notification_arns", names.AttrParameters, names.AttrTagsAll, "template_body", "template_url"} {
+		if !d.NewValueKnown(key) {
+			if err := d.SetNewComputed("change_set_name"); err != nil {
+				return err
+			}
+			if err := d.SetNewComputed("planned_replacements"); err != nil {
+				return err
+			}
+
+			return d.SetNewComputed("planned_changes")
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	input, err := expandStackChangeSetInput(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	changeSetName := aws.ToString(input.ChangeSetName)
+
+	changeSet, err := findOrCreateStackChangeSet(ctx, conn, input)
+
+	if err != nil {
+		return fmt.Errorf("creating CloudFormation Stack (%s) change set (%s): %w", d.Id(), changeSetName, err)
+	}
+
+	var changes []awstypes.Change
+	if !isChangeSetWithoutChanges(changeSet) {
+		changes, err = findChangeSetChangesByTwoPartKey(ctx, conn, d.Id(), changeSetName)
+
+		if err != nil {
+			return fmt.Errorf("reading CloudFormation Stack (%s) change set (%s): %w", d.Id(), changeSetName, err)
+		}
+	}
+
+	// Change sets of earlier plans that were not applied are no longer needed.
+	keepChangeSetName := changeSetName
+	if isChangeSetWithoutChanges(changeSet) {
+		keepChangeSetName = ""
+	}
+
+	if err := deletePlanChangeSets(ctx, conn, d.Id(), keepChangeSetName); err != nil {
+		return fmt.Errorf("deleting CloudFormation Stack (%s) change sets: %w", d.Id(), err)
+	}
+
+	// CustomizeDiff functions cannot return warnings, so replacements are reported through the plan warnings.
+	for _, v := range changes {
+		if v := v.ResourceChange; v != nil && v.Replacement == awstypes.ReplacementTrue {
+			sdkv2.AddPlanWarning(ctx, "planned_replacements", "CloudFormation Stack resource replacement", fmt.Sprintf("CloudFormation Stack (%s) resource %s (%s) will be replaced", d.Id(), aws.ToString(v.LogicalResourceId), aws.ToString(v.ResourceType)))
+		}
+	}
+
+	if err := d.SetNew("change_set_name", changeSetName); err != nil {
+		return err
+	}
+
+	if err := d.SetNew("planned_replacements", flattenChangesReplacements(changes)); err != nil {
+		return err
+	}
+
+	return d.SetNew("planned_changes", flattenChanges(changes))
+}
+
+// expandStackChangeSetInput returns the input to create a change set that updates the stack to its planned configuration.
+// The change set's name is derived from the rest of the input so that planning the same update again finds the same change set.
+func expandStackChangeSetInput(ctx context.Context, d sdkv2.ResourceDiffer) (*cloudformation.CreateChangeSetInput, error) {
+	input := &cloudformation.CreateChangeSetInput{
+		ChangeSetType: awstypes.ChangeSetTypeUpdate,
+		StackName:     aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = flex.ExpandStringyValueSet[awstypes.Capability](v.(*schema.Set))
+		slices.Sort(input.Capabilities)
+	}
+	if v, ok := d.GetOk(names.AttrIAMRoleARN); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("notification_arns"); ok {
+		input.NotificationARNs = flex.ExpandStringValueSet(v.(*schema.Set))
+		slices.Sort(input.NotificationARNs)
+	}
+	if v, ok := d.GetOk(names.AttrParameters); ok {
+		input.Parameters = expandParameters(v.(map[string]interface{}))
+		slices.SortFunc(input.Parameters, func(a, b awstypes.Parameter) int {
+			return strings.Compare(aws.ToString(a.ParameterKey), aws.ToString(b.ParameterKey))
+		})
+	}
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_body"); ok && input.TemplateURL == nil {
+		template, err := verify.NormalizeJSONOrYAMLString(v)
+		if err != nil {
+			return nil, err
+		}
+		input.TemplateBody = aws.String(template)
+	}
+	if v, ok := d.GetOk(names.AttrTagsAll); ok {
+		input.Tags = Tags(tftags.New(ctx, v))
+		slices.SortFunc(input.Tags, func(a, b awstypes.Tag) int {
+			return strings.Compare(aws.ToString(a.Key), aws.ToString(b.Key))
+		})
+	}
+
+	b, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	input.ChangeSetName = aws.String(fmt.Sprintf("%s%x", planChangeSetNamePrefix, sha256.Sum256(b)))
+
+	return input, nil
+}
+
+// findOrCreateStackChangeSet returns the named change set, creating it if it does not exist.
+// The change set may have been created by an earlier plan of the same update.
+// A change set that failed because it contains no changes is not an error.
+func findOrCreateStackChangeSet(ctx context.Context, conn *cloudformation.Client, input *cloudformation.CreateChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+	stackID, changeSetName := aws.ToString(input.StackName), aws.ToString(input.ChangeSetName)
+
+	_, err := findChangeSetByTwoPartKey(ctx, conn, stackID, changeSetName)
+
+	if tfresource.NotFound(err) {
+		_, err = tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.CreateChangeSet(ctx, input)
+		}, errCodeValidationError, "is invalid or cannot be assumed")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := waitChangeSetCreated(ctx, conn, stackID, changeSetName)
+
+	if isChangeSetWithoutChanges(output) {
+		return output, nil
+	}
+
+	return output, err
+}
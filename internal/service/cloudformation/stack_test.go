@@ -9,9 +9,12 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -19,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -325,6 +329,86 @@ func TestAccCloudFormationStack_withParams(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStack_previewChanges(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack awstypes.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	vpcCidrInitial := "10.0.0.0/16"
+	vpcCidrNotApplied := "11.0.0.0/16"
+	vpcCidrUpdated := "12.0.0.0/16"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_previewChanges(rName, vpcCidrInitial),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "change_set_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "planned_replacements.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "preview_changes", acctest.CtTrue),
+				),
+			},
+			{
+				// The change set created by this plan is not executed.
+				Config:             testAccStackConfig_previewChanges(rName, vpcCidrNotApplied),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccStackConfig_previewChanges(rName, vpcCidrUpdated),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_changes"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								names.AttrAction:       knownvalue.StringExact("Modify"),
+								"logical_resource_id":  knownvalue.StringExact("MyVPC"),
+								"physical_resource_id": knownvalue.NotNull(),
+								"replacement":          knownvalue.StringExact("True"),
+								names.AttrResourceType: knownvalue.StringExact("AWS::EC2::VPC"),
+							}),
+						})),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_replacements"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("MyVPC"),
+						})),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					testAccCheckStackNoStalePlanChangeSets(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "change_set_name"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcCIDR", vpcCidrUpdated),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "planned_replacements.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "planned_replacements.0", "MyVPC"),
+				),
+			},
+			{
+				// The change set of a stack policy only change is empty, but the stack policy is still set.
+				Config: testAccStackConfig_previewChangesPolicy(rName, vpcCidrUpdated),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_changes"), knownvalue.ListExact([]knownvalue.Check{})),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					testAccCheckStackPolicy(ctx, resourceName, previewChangesPolicyBody),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcCIDR", vpcCidrUpdated),
+				),
+			},
+		},
+	})
+}
+
 // Regression for https://github.com/hashicorp/terraform/issues/4534
 func TestAccCloudFormationStack_WithURL_withParams(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -593,6 +677,66 @@ func testAccCheckStackExists(ctx context.Context, n string, v *awstypes.Stack) r
 	}
 }
 
+// testAccCheckStackNoStalePlanChangeSets checks that the only change set created during plan that remains is the executed one.
+func testAccCheckStackPolicy(ctx context.Context, n, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationClient(ctx)
+
+		input := cloudformation.GetStackPolicyInput{
+			StackName: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.GetStackPolicy(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.StackPolicyBody); !verify.JSONStringsEqual(got, want) {
+			return fmt.Errorf("CloudFormation Stack (%s) policy = %s, want %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckStackNoStalePlanChangeSets(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationClient(ctx)
+
+		input := cloudformation.ListChangeSetsInput{
+			StackName: aws.String(rs.Primary.ID),
+		}
+
+		pages := cloudformation.NewListChangeSetsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range page.Summaries {
+				if name := aws.ToString(v.ChangeSetName); tfcloudformation.IsPlanChangeSetName(name) && name != rs.Primary.Attributes["change_set_name"] {
+					return fmt.Errorf("CloudFormation Stack (%s) change set (%s) still exists", rs.Primary.ID, name)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckStackDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationClient(ctx)
@@ -924,6 +1068,91 @@ STACK
 `, rName, cidr)
 }
 
+func testAccStackConfig_previewChanges(rName, cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name            = %[1]q
+  preview_changes = true
+
+  parameters = {
+    VpcCIDR = %[2]q
+  }
+
+  template_body = <<STACK
+{
+  "Parameters" : {
+    "VpcCIDR" : {
+      "Description" : "CIDR to be used for the VPC",
+      "Type" : "String"
+    }
+  },
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : {"Ref": "VpcCIDR"}
+      }
+    }
+  }
+}
+STACK
+}
+`, rName, cidr)
+}
+
+const previewChangesPolicyBody = `{
+  "Statement" : [
+    {
+      "Effect" : "Deny",
+      "Action" : "Update:Replace",
+      "Principal" : "*",
+      "Resource" : "LogicalResourceId/MyVPC"
+    },
+    {
+      "Effect" : "Allow",
+      "Action" : "Update:*",
+      "Principal" : "*",
+      "Resource" : "*"
+    }
+  ]
+}`
+
+func testAccStackConfig_previewChangesPolicy(rName, cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name            = %[1]q
+  preview_changes = true
+
+  parameters = {
+    VpcCIDR = %[2]q
+  }
+
+  policy_body = <<POLICY
+%[3]s
+POLICY
+
+  template_body = <<STACK
+{
+  "Parameters" : {
+    "VpcCIDR" : {
+      "Description" : "CIDR to be used for the VPC",
+      "Type" : "String"
+    }
+  },
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : {"Ref": "VpcCIDR"}
+      }
+    }
+  }
+}
+STACK
+}
+`, rName, cidr, previewChangesPolicyBody)
+}
+
 func testAccStackConfig_baseTemplateURL(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+
+const (
+	dashboardGridWidth           = 24
+	dashboardWidgetDefaultHeight = 6
+	dashboardWidgetDefaultWidth  = 6
+)
+
+const (
+	dashboardWidgetTypeAlarm    = "alarm"
+	dashboardWidgetTypeExplorer = "explorer"
+	dashboardWidgetTypeLog      = "log"
+	dashboardWidgetTypeMetric   = "metric"
+	dashboardWidgetTypeText     = "text"
+)
+
+type dashboardBody struct {
+	End            string            `json:"end,omitempty"`
+	PeriodOverride string            `json:"periodOverride,omitempty"`
+	Start          string            `json:"start,omitempty"`
+	Widgets        []dashboardWidget `json:"widgets"`
+}
+
+type dashboardWidget struct {
+	Height     int    `json:"height"`
+	Properties any    `json:"properties"`
+	Type       string `json:"type"`
+	Width      int    `json:"width"`
+	X          int    `json:"x"`
+	Y          int    `json:"y"`
+}
+
+type dashboardMetricWidgetProperties struct {
+	Annotations          *dashboardAnnotations `json:"annotations,omitempty"`
+	Legend               *dashboardLegend      `json:"legend,omitempty"`
+	LiveData             *bool                 `json:"liveData,omitempty"`
+	Metrics              [][]any               `json:"metrics"`
+	Period               *int64                `json:"period,omitempty"`
+	Region               string                `json:"region"`
+	SetPeriodToTimeRange *bool                 `json:"setPeriodToTimeRange,omitempty"`
+	Sparkline            *bool                 `json:"sparkline,omitempty"`
+	Stacked              *bool                 `json:"stacked,omitempty"`
+	Stat                 string                `json:"stat,omitempty"`
+	Title                string                `json:"title,omitempty"`
+	View                 string                `json:"view,omitempty"`
+	YAxis                *dashboardYAxes       `json:"yAxis,omitempty"`
+}
+
+// dashboardMetric is a metric or metric math expression in a metric widget.
+type dashboardMetric struct {
+	Dimensions map[string]string
+	MetricName string
+	Namespace  string
+	Options    dashboardMetricOptions
+}
+
+type dashboardMetricOptions struct {
+	AccountID  string `json:"accountId,omitempty"`
+	Color      string `json:"color,omitempty"`
+	Expression string `json:"expression,omitempty"`
+	ID         string `json:"id,omitempty"`
+	Label      string `json:"label,omitempty"`
+	Period     *int64 `json:"period,omitempty"`
+	Region     string `json:"region,omitempty"`
+	Stat       string `json:"stat,omitempty"`
+	Visible    *bool  `json:"visible,omitempty"`
+	YAxis      string `json:"yAxis,omitempty"`
+}
+
+// row returns the metric's representation in a metric widget's metrics array:
+// [Namespace, MetricName, DimensionName, DimensionValue, ..., {Options}] for metrics, or [{Options}] for expressions.
+// Dimensions are sorted by name.
+func (m dashboardMetric) row() []any {
+	var row []any
+
+	if m.Options.Expression == "" {
+		row = append(row, m.Namespace, m.MetricName)
+
+		keys := tfmaps.Keys(m.Dimensions)
+		slices.Sort(keys)
+		for _, k := range keys {
+			row = append(row, k, m.Dimensions[k])
+		}
+	}
+
+	if m.Options != (dashboardMetricOptions{}) {
+		row = append(row, m.Options)
+	}
+
+	return row
+}
+
+type dashboardAnnotations struct {
+	Horizontal []dashboardHorizontalAnnotation `json:"horizontal,omitempty"`
+}
+
+type dashboardHorizontalAnnotation struct {
+	Color   string  `json:"color,omitempty"`
+	Fill    string  `json:"fill,omitempty"`
+	Label   string  `json:"label,omitempty"`
+	Value   float64 `json:"value"`
+	Visible *bool   `json:"visible,omitempty"`
+	YAxis   string  `json:"yAxis,omitempty"`
+}
+
+type dashboardLegend struct {
+	Position string `json:"position"`
+}
+
+type dashboardYAxes struct {
+	Left  *dashboardYAxis `json:"left,omitempty"`
+	Right *dashboardYAxis `json:"right,omitempty"`
+}
+
+type dashboardYAxis struct {
+	Label     string   `json:"label,omitempty"`
+	Max       *float64 `json:"max,omitempty"`
+	Min       *float64 `json:"min,omitempty"`
+	ShowUnits *bool    `json:"showUnits,omitempty"`
+}
+
+type dashboardLogWidgetProperties struct {
+	Query   string `json:"query"`
+	Region  string `json:"region"`
+	Stacked *bool  `json:"stacked,omitempty"`
+	Title   string `json:"title,omitempty"`
+	View    string `json:"view,omitempty"`
+}
+
+// logsInsightsQuery returns a CloudWatch Logs Insights query string of the form "SOURCE 'lg1' | SOURCE 'lg2' | query".
+func logsInsightsQuery(logGroupNames []string, query string) string {
+	var sb strings.Builder
+
+	for _, v := range logGroupNames {
+		fmt.Fprintf(&sb, "SOURCE '%s' | ", v)
+	}
+	sb.WriteString(query)
+
+	return sb.String()
+}
+
+type dashboardAlarmWidgetProperties struct {
+	Alarms []string `json:"alarms"`
+	SortBy string   `json:"sortBy,omitempty"`
+	States []string `json:"states,omitempty"`
+	Title  string   `json:"title,omitempty"`
+}
+
+type dashboardTextWidgetProperties struct {
+	Background string `json:"background,omitempty"`
+	Markdown   string `json:"markdown"`
+}
+
+type dashboardExplorerWidgetProperties struct {
+	Labels        []dashboardExplorerLabel        `json:"labels,omitempty"`
+	Metrics       []dashboardExplorerMetric       `json:"metrics"`
+	Period        *int64                          `json:"period,omitempty"`
+	Region        string                          `json:"region"`
+	SplitBy       string                          `json:"splitBy,omitempty"`
+	Title         string                          `json:"title,omitempty"`
+	WidgetOptions *dashboardExplorerWidgetOptions `json:"widgetOptions,omitempty"`
+}
+
+type dashboardExplorerLabel struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+type dashboardExplorerMetric struct {
+	MetricName   string `json:"metricName"`
+	ResourceType string `json:"resourceType"`
+	Stat         string `json:"stat"`
+}
+
+type dashboardExplorerWidgetOptions struct {
+	Legend        *dashboardLegend `json:"legend,omitempty"`
+	RowsPerPage   *int64           `json:"rowsPerPage,omitempty"`
+	Stacked       *bool            `json:"stacked,omitempty"`
+	View          string           `json:"view,omitempty"`
+	WidgetsPerRow *int64           `json:"widgetsPerRow,omitempty"`
+}
+
+// layoutDashboardWidgets places widgets on the dashboard grid in order, from left to right and then top to bottom.
+// A widget that doesn't fit in the remainder of a row starts a new row below the tallest widget in the previous row.
+func layoutDashboardWidgets(widgets []dashboardWidget) {
+	x, y, rowHeight := 0, 0, 0
+
+	for i, v := range widgets {
+		if x > 0 && x+v.Width > dashboardGridWidth {
+			x, y, rowHeight = 0, y+rowHeight, 0
+		}
+
+		widgets[i].X, widgets[i].Y = x, y
+		x += v.Width
+		rowHeight = max(rowHeight, v.Height)
+	}
+}
@@ -0,0 +1,776 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_cloudwatch_dashboard_document", name="Dashboard Document")
+func newDashboardDocumentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dashboardDocumentDataSource{}, nil
+}
+
+type dashboardDocumentDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dashboardDocumentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	optionalString := func(values ...string) schema.StringAttribute {
+		attr := schema.StringAttribute{
+			Optional: true,
+		}
+		if len(values) > 0 {
+			attr.Validators = []validator.String{
+				stringvalidator.OneOf(values...),
+			}
+		}
+		return attr
+	}
+	periodAttribute := schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+	legendPositionAttribute := optionalString("bottom", "hidden", "right")
+	yAxisBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentYAxisModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"label": optionalString(),
+					"max": schema.Float64Attribute{
+						Optional: true,
+					},
+					"min": schema.Float64Attribute{
+						Optional: true,
+					},
+					"show_units": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"end": optionalString(),
+			names.AttrJSON: schema.StringAttribute{
+				Computed: true,
+			},
+			"period_override": optionalString("auto", "inherit"),
+			"start":           optionalString(),
+		},
+		Blocks: map[string]schema.Block{
+			"widget": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentWidgetModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(500),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"height": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 1000),
+							},
+						},
+						"width": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, dashboardGridWidth),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						dashboardWidgetTypeAlarm: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentAlarmWidgetModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"alarms": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.List{
+											listvalidator.SizeBetween(1, 100),
+										},
+									},
+									"sort_by": optionalString("default", "stateUpdatedTimestamp", "timestamp"),
+									"states": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										Validators: []validator.List{
+											listvalidator.ValueStringsAre(stringvalidator.OneOf("ALARM", "INSUFFICIENT_DATA", "OK")),
+										},
+									},
+									"title": optionalString(),
+								},
+							},
+						},
+						dashboardWidgetTypeExplorer: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentExplorerWidgetModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"legend_position": legendPositionAttribute,
+									"period":          periodAttribute,
+									names.AttrRegion:  optionalString(),
+									"rows_per_page": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(1, 1000),
+										},
+									},
+									"split_by": optionalString(),
+									"stacked": schema.BoolAttribute{
+										Optional: true,
+									},
+									"title": optionalString(),
+									"view":  optionalString("bar", "pie", "timeSeries"),
+									"widgets_per_row": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(1, 4),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"metric": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentExplorerMetricModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrMetricName: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrResourceType: schema.StringAttribute{
+													Required: true,
+												},
+												"stat": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"tag": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentExplorerTagModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrValue: optionalString(),
+											},
+										},
+									},
+								},
+							},
+						},
+						dashboardWidgetTypeLog: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentLogWidgetModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"log_group_names": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(50),
+										},
+									},
+									"query": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrRegion: optionalString(),
+									"stacked": schema.BoolAttribute{
+										Optional: true,
+									},
+									"title": optionalString(),
+									"view":  optionalString("bar", "pie", "table", "timeSeries"),
+								},
+							},
+						},
+						dashboardWidgetTypeMetric: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentMetricWidgetModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"legend_position": legendPositionAttribute,
+									"live_data": schema.BoolAttribute{
+										Optional: true,
+									},
+									"period":         periodAttribute,
+									names.AttrRegion: optionalString(),
+									"set_period_to_time_range": schema.BoolAttribute{
+										Optional: true,
+									},
+									"sparkline": schema.BoolAttribute{
+										Optional: true,
+									},
+									"stacked": schema.BoolAttribute{
+										Optional: true,
+									},
+									"stat":  optionalString(),
+									"title": optionalString(),
+									"view":  optionalString("bar", "gauge", "pie", "singleValue", "table", "timeSeries"),
+								},
+								Blocks: map[string]schema.Block{
+									"horizontal_annotation": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentHorizontalAnnotationModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"color": optionalString(),
+												"fill":  optionalString("above", "below"),
+												"label": optionalString(),
+												names.AttrValue: schema.Float64Attribute{
+													Required: true,
+												},
+												"visible": schema.BoolAttribute{
+													Optional: true,
+												},
+												"y_axis": optionalString("left", "right"),
+											},
+										},
+									},
+									"left_y_axis": yAxisBlock(),
+									"query": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentMetricQueryModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(500),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrAccountID: optionalString(),
+												"color":             optionalString(),
+												"dimensions": schema.MapAttribute{
+													CustomType:  fwtypes.MapOfStringType,
+													ElementType: types.StringType,
+													Optional:    true,
+													Validators: []validator.Map{
+														mapvalidator.SizeAtMost(30),
+													},
+												},
+												names.AttrExpression: schema.StringAttribute{
+													Optional: true,
+													Validators: []validator.String{
+														stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName(names.AttrMetricName)),
+														stringvalidator.ConflictsWith(
+															path.MatchRelative().AtParent().AtName("dimensions"),
+															path.MatchRelative().AtParent().AtName(names.AttrNamespace),
+														),
+													},
+												},
+												names.AttrID: optionalString(),
+												"label":      optionalString(),
+												names.AttrMetricName: schema.StringAttribute{
+													Optional: true,
+													Validators: []validator.String{
+														stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName(names.AttrNamespace)),
+													},
+												},
+												names.AttrNamespace: optionalString(),
+												"period":            periodAttribute,
+												names.AttrRegion:    optionalString(),
+												"stat":              optionalString(),
+												"visible": schema.BoolAttribute{
+													Optional: true,
+												},
+												"y_axis": optionalString("left", "right"),
+											},
+										},
+									},
+									"right_y_axis": yAxisBlock(),
+								},
+							},
+						},
+						dashboardWidgetTypeText: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dashboardDocumentTextWidgetModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"background": optionalString("solid", "transparent"),
+									"markdown": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dashboardDocumentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dashboardDocumentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body := dashboardBody{
+		End:            fwflex.StringValueFromFramework(ctx, data.End),
+		PeriodOverride: fwflex.StringValueFromFramework(ctx, data.PeriodOverride),
+		Start:          fwflex.StringValueFromFramework(ctx, data.Start),
+		Widgets:        []dashboardWidget{},
+	}
+
+	widgets, diags := data.Widgets.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	region := d.Meta().Region(ctx)
+	for i, v := range widgets {
+		widget, diags := v.expand(ctx, path.Root("widget").AtListIndex(i), region)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		body.Widgets = append(body.Widgets, widget)
+	}
+
+	layoutDashboardWidgets(body.Widgets)
+
+	bytes, err := json.Marshal(body)
+
+	if err != nil {
+		response.Diagnostics.AddError("Marshalling CloudWatch Dashboard body to JSON", err.Error())
+		return
+	}
+
+	// Use the same normalized form as the aws_cloudwatch_dashboard resource's dashboard_body.
+	v, err := structure.NormalizeJsonString(string(bytes))
+
+	if err != nil {
+		response.Diagnostics.AddError("Normalizing CloudWatch Dashboard body JSON", err.Error())
+		return
+	}
+
+	data.JSON = types.StringValue(v)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dashboardDocumentDataSourceModel struct {
+	End            types.String                                                  `tfsdk:"end"`
+	JSON           types.String                                                  `tfsdk:"json"`
+	PeriodOverride types.String                                                  `tfsdk:"period_override"`
+	Start          types.String                                                  `tfsdk:"start"`
+	Widgets        fwtypes.ListNestedObjectValueOf[dashboardDocumentWidgetModel] `tfsdk:"widget"`
+}
+
+type dashboardDocumentWidgetModel struct {
+	Alarm    fwtypes.ListNestedObjectValueOf[dashboardDocumentAlarmWidgetModel]    `tfsdk:"alarm"`
+	Explorer fwtypes.ListNestedObjectValueOf[dashboardDocumentExplorerWidgetModel] `tfsdk:"explorer"`
+	Height   types.Int64                                                           `tfsdk:"height"`
+	Log      fwtypes.ListNestedObjectValueOf[dashboardDocumentLogWidgetModel]      `tfsdk:"log"`
+	Metric   fwtypes.ListNestedObjectValueOf[dashboardDocumentMetricWidgetModel]   `tfsdk:"metric"`
+	Text     fwtypes.ListNestedObjectValueOf[dashboardDocumentTextWidgetModel]     `tfsdk:"text"`
+	Width    types.Int64                                                           `tfsdk:"width"`
+}
+
+func (m *dashboardDocumentWidgetModel) expand(ctx context.Context, attrPath path.Path, region string) (dashboardWidget, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	widget := dashboardWidget{
+		Height: dashboardWidgetDefaultHeight,
+		Width:  dashboardWidgetDefaultWidth,
+	}
+
+	if v := m.Height; !v.IsNull() {
+		widget.Height = int(v.ValueInt64())
+	}
+	if v := m.Width; !v.IsNull() {
+		widget.Width = int(v.ValueInt64())
+	}
+
+	var n int
+	for _, v := range []interface{ IsNull() bool }{m.Alarm, m.Explorer, m.Log, m.Metric, m.Text} {
+		if !v.IsNull() {
+			n++
+		}
+	}
+	if n != 1 {
+		diags.AddAttributeError(attrPath, "Invalid widget", "Exactly one of alarm, explorer, log, metric or text must be specified.")
+		return widget, diags
+	}
+
+	switch {
+	case !m.Alarm.IsNull():
+		v, d := m.Alarm.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return widget, diags
+		}
+
+		widget.Type = dashboardWidgetTypeAlarm
+		widget.Properties = dashboardAlarmWidgetProperties{
+			Alarms: fwflex.ExpandFrameworkStringValueList(ctx, v.Alarms),
+			SortBy: fwflex.StringValueFromFramework(ctx, v.SortBy),
+			States: fwflex.ExpandFrameworkStringValueList(ctx, v.States),
+			Title:  fwflex.StringValueFromFramework(ctx, v.Title),
+		}
+
+	case !m.Explorer.IsNull():
+		v, d := m.Explorer.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return widget, diags
+		}
+
+		widget.Type = dashboardWidgetTypeExplorer
+		widget.Properties, d = v.expand(ctx, region)
+		diags.Append(d...)
+
+	case !m.Log.IsNull():
+		v, d := m.Log.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return widget, diags
+		}
+
+		widget.Type = dashboardWidgetTypeLog
+		widget.Properties = dashboardLogWidgetProperties{
+			Query:   logsInsightsQuery(fwflex.ExpandFrameworkStringValueList(ctx, v.LogGroupNames), v.Query.ValueString()),
+			Region:  cmp.Or(fwflex.StringValueFromFramework(ctx, v.Region), region),
+			Stacked: fwflex.BoolFromFramework(ctx, v.Stacked),
+			Title:   fwflex.StringValueFromFramework(ctx, v.Title),
+			View:    fwflex.StringValueFromFramework(ctx, v.View),
+		}
+
+	case !m.Metric.IsNull():
+		v, d := m.Metric.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return widget, diags
+		}
+
+		widget.Type = dashboardWidgetTypeMetric
+		properties, d := v.expand(ctx, region)
+		diags.Append(d...)
+		if diags.HasError() {
+			return widget, diags
+		}
+
+		var queries []metricMathQuery
+		for _, v := range properties.Metrics {
+			if options, ok := v[len(v)-1].(dashboardMetricOptions); ok {
+				queries = append(queries, metricMathQuery{Expression: options.Expression, ID: options.ID})
+			}
+		}
+		if err := validateMetricMathQueries(queries); err != nil {
+			diags.AddAttributeError(attrPath.AtName(dashboardWidgetTypeMetric), "Invalid metric math", err.Error())
+			return widget, diags
+		}
+
+		widget.Properties = properties
+
+	case !m.Text.IsNull():
+		v, d := m.Text.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return widget, diags
+		}
+
+		widget.Type = dashboardWidgetTypeText
+		widget.Properties = dashboardTextWidgetProperties{
+			Background: fwflex.StringValueFromFramework(ctx, v.Background),
+			Markdown:   v.Markdown.ValueString(),
+		}
+	}
+
+	return widget, diags
+}
+
+type dashboardDocumentAlarmWidgetModel struct {
+	Alarms fwtypes.ListOfString `tfsdk:"alarms"`
+	SortBy types.String         `tfsdk:"sort_by"`
+	States fwtypes.ListOfString `tfsdk:"states"`
+	Title  types.String         `tfsdk:"title"`
+}
+
+type dashboardDocumentExplorerWidgetModel struct {
+	LegendPosition types.String                                                          `tfsdk:"legend_position"`
+	Metrics        fwtypes.ListNestedObjectValueOf[dashboardDocumentExplorerMetricModel] `tfsdk:"metric"`
+	Period         types.Int64                                                           `tfsdk:"period"`
+	Region         types.String                                                          `tfsdk:"region"`
+	RowsPerPage    types.Int64                                                           `tfsdk:"rows_per_page"`
+	SplitBy        types.String                                                          `tfsdk:"split_by"`
+	Stacked        types.Bool                                                            `tfsdk:"stacked"`
+	Tags           fwtypes.ListNestedObjectValueOf[dashboardDocumentExplorerTagModel]    `tfsdk:"tag"`
+	Title          types.String                                                          `tfsdk:"title"`
+	View           types.String                                                          `tfsdk:"view"`
+	WidgetsPerRow  types.Int64                                                           `tfsdk:"widgets_per_row"`
+}
+
+func (m *dashboardDocumentExplorerWidgetModel) expand(ctx context.Context, region string) (dashboardExplorerWidgetProperties, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	properties := dashboardExplorerWidgetProperties{
+		Period:  fwflex.Int64FromFramework(ctx, m.Period),
+		Region:  cmp.Or(fwflex.StringValueFromFramework(ctx, m.Region), region),
+		SplitBy: fwflex.StringValueFromFramework(ctx, m.SplitBy),
+		Title:   fwflex.StringValueFromFramework(ctx, m.Title),
+	}
+
+	metrics, d := m.Metrics.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return properties, diags
+	}
+
+	for _, v := range metrics {
+		properties.Metrics = append(properties.Metrics, dashboardExplorerMetric{
+			MetricName:   v.MetricName.ValueString(),
+			ResourceType: v.ResourceType.ValueString(),
+			Stat:         v.Stat.ValueString(),
+		})
+	}
+
+	tags, d := m.Tags.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return properties, diags
+	}
+
+	for _, v := range tags {
+		properties.Labels = append(properties.Labels, dashboardExplorerLabel{
+			Key:   v.Key.ValueString(),
+			Value: fwflex.StringValueFromFramework(ctx, v.Value),
+		})
+	}
+
+	options := dashboardExplorerWidgetOptions{
+		RowsPerPage:   fwflex.Int64FromFramework(ctx, m.RowsPerPage),
+		Stacked:       fwflex.BoolFromFramework(ctx, m.Stacked),
+		View:          fwflex.StringValueFromFramework(ctx, m.View),
+		WidgetsPerRow: fwflex.Int64FromFramework(ctx, m.WidgetsPerRow),
+	}
+	if v := m.LegendPosition; !v.IsNull() {
+		options.Legend = &dashboardLegend{Position: v.ValueString()}
+	}
+	if options != (dashboardExplorerWidgetOptions{}) {
+		properties.WidgetOptions = &options
+	}
+
+	return properties, diags
+}
+
+type dashboardDocumentExplorerMetricModel struct {
+	MetricName   types.String `tfsdk:"metric_name"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Stat         types.String `tfsdk:"stat"`
+}
+
+type dashboardDocumentExplorerTagModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+type dashboardDocumentLogWidgetModel struct {
+	LogGroupNames fwtypes.ListOfString `tfsdk:"log_group_names"`
+	Query         types.String         `tfsdk:"query"`
+	Region        types.String         `tfsdk:"region"`
+	Stacked       types.Bool           `tfsdk:"stacked"`
+	Title         types.String         `tfsdk:"title"`
+	View          types.String         `tfsdk:"view"`
+}
+
+type dashboardDocumentMetricWidgetModel struct {
+	HorizontalAnnotations fwtypes.ListNestedObjectValueOf[dashboardDocumentHorizontalAnnotationModel] `tfsdk:"horizontal_annotation"`
+	LeftYAxis             fwtypes.ListNestedObjectValueOf[dashboardDocumentYAxisModel]                `tfsdk:"left_y_axis"`
+	LegendPosition        types.String                                                                `tfsdk:"legend_position"`
+	LiveData              types.Bool                                                                  `tfsdk:"live_data"`
+	Period                types.Int64                                                                 `tfsdk:"period"`
+	Queries               fwtypes.ListNestedObjectValueOf[dashboardDocumentMetricQueryModel]          `tfsdk:"query"`
+	Region                types.String                                                                `tfsdk:"region"`
+	RightYAxis            fwtypes.ListNestedObjectValueOf[dashboardDocumentYAxisModel]                `tfsdk:"right_y_axis"`
+	SetPeriodToTimeRange  types.Bool                                                                  `tfsdk:"set_period_to_time_range"`
+	Sparkline             types.Bool                                                                  `tfsdk:"sparkline"`
+	Stacked               types.Bool                                                                  `tfsdk:"stacked"`
+	Stat                  types.String                                                                `tfsdk:"stat"`
+	Title                 types.String                                                                `tfsdk:"title"`
+	View                  types.String                                                                `tfsdk:"view"`
+}
+
+func (m *dashboardDocumentMetricWidgetModel) expand(ctx context.Context, region string) (dashboardMetricWidgetProperties, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	properties := dashboardMetricWidgetProperties{
+		LiveData:             fwflex.BoolFromFramework(ctx, m.LiveData),
+		Metrics:              [][]any{},
+		Period:               fwflex.Int64FromFramework(ctx, m.Period),
+		Region:               cmp.Or(fwflex.StringValueFromFramework(ctx, m.Region), region),
+		SetPeriodToTimeRange: fwflex.BoolFromFramework(ctx, m.SetPeriodToTimeRange),
+		Sparkline:            fwflex.BoolFromFramework(ctx, m.Sparkline),
+		Stacked:              fwflex.BoolFromFramework(ctx, m.Stacked),
+		Stat:                 fwflex.StringValueFromFramework(ctx, m.Stat),
+		Title:                fwflex.StringValueFromFramework(ctx, m.Title),
+		View:                 fwflex.StringValueFromFramework(ctx, m.View),
+	}
+
+	if v := m.LegendPosition; !v.IsNull() {
+		properties.Legend = &dashboardLegend{Position: v.ValueString()}
+	}
+
+	queries, d := m.Queries.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return properties, diags
+	}
+
+	for _, v := range queries {
+		metric := dashboardMetric{
+			Dimensions: fwflex.ExpandFrameworkStringValueMap(ctx, v.Dimensions),
+			MetricName: fwflex.StringValueFromFramework(ctx, v.MetricName),
+			Namespace:  fwflex.StringValueFromFramework(ctx, v.Namespace),
+			Options: dashboardMetricOptions{
+				AccountID:  fwflex.StringValueFromFramework(ctx, v.AccountID),
+				Color:      fwflex.StringValueFromFramework(ctx, v.Color),
+				Expression: fwflex.StringValueFromFramework(ctx, v.Expression),
+				ID:         fwflex.StringValueFromFramework(ctx, v.ID),
+				Label:      fwflex.StringValueFromFramework(ctx, v.Label),
+				Period:     fwflex.Int64FromFramework(ctx, v.Period),
+				Region:     fwflex.StringValueFromFramework(ctx, v.Region),
+				Stat:       fwflex.StringValueFromFramework(ctx, v.Stat),
+				Visible:    fwflex.BoolFromFramework(ctx, v.Visible),
+				YAxis:      fwflex.StringValueFromFramework(ctx, v.YAxis),
+			},
+		}
+
+		properties.Metrics = append(properties.Metrics, metric.row())
+	}
+
+	annotations, d := m.HorizontalAnnotations.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return properties, diags
+	}
+
+	if len(annotations) > 0 {
+		properties.Annotations = &dashboardAnnotations{}
+
+		for _, v := range annotations {
+			properties.Annotations.Horizontal = append(properties.Annotations.Horizontal, dashboardHorizontalAnnotation{
+				Color:   fwflex.StringValueFromFramework(ctx, v.Color),
+				Fill:    fwflex.StringValueFromFramework(ctx, v.Fill),
+				Label:   fwflex.StringValueFromFramework(ctx, v.Label),
+				Value:   v.Value.ValueFloat64(),
+				Visible: fwflex.BoolFromFramework(ctx, v.Visible),
+				YAxis:   fwflex.StringValueFromFramework(ctx, v.YAxis),
+			})
+		}
+	}
+
+	left, d := m.LeftYAxis.ToPtr(ctx)
+	diags.Append(d...)
+	right, d := m.RightYAxis.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return properties, diags
+	}
+
+	if left != nil || right != nil {
+		properties.YAxis = &dashboardYAxes{
+			Left:  left.expand(),
+			Right: right.expand(),
+		}
+	}
+
+	return properties, diags
+}
+
+type dashboardDocumentHorizontalAnnotationModel struct {
+	Color   types.String  `tfsdk:"color"`
+	Fill    types.String  `tfsdk:"fill"`
+	Label   types.String  `tfsdk:"label"`
+	Value   types.Float64 `tfsdk:"value"`
+	Visible types.Bool    `tfsdk:"visible"`
+	YAxis   types.String  `tfsdk:"y_axis"`
+}
+
+type dashboardDocumentMetricQueryModel struct {
+	AccountID  types.String        `tfsdk:"account_id"`
+	Color      types.String        `tfsdk:"color"`
+	Dimensions fwtypes.MapOfString `tfsdk:"dimensions"`
+	Expression types.String        `tfsdk:"expression"`
+	ID         types.String        `tfsdk:"id"`
+	Label      types.String        `tfsdk:"label"`
+	MetricName types.String        `tfsdk:"metric_name"`
+	Namespace  types.String        `tfsdk:"namespace"`
+	Period     types.Int64         `tfsdk:"period"`
+	Region     types.String        `tfsdk:"region"`
+	Stat       types.String        `tfsdk:"stat"`
+	Visible    types.Bool          `tfsdk:"visible"`
+	YAxis      types.String        `tfsdk:"y_axis"`
+}
+
+type dashboardDocumentYAxisModel struct {
+	Label     types.String  `tfsdk:"label"`
+	Max       types.Float64 `tfsdk:"max"`
+	Min       types.Float64 `tfsdk:"min"`
+	ShowUnits types.Bool    `tfsdk:"show_units"`
+}
+
+func (m *dashboardDocumentYAxisModel) expand() *dashboardYAxis {
+	if m == nil {
+		return nil
+	}
+
+	return &dashboardYAxis{
+		Label:     m.Label.ValueString(),
+		Max:       m.Max.ValueFloat64Pointer(),
+		Min:       m.Min.ValueFloat64Pointer(),
+		ShowUnits: m.ShowUnits.ValueBoolPointer(),
+	}
+}
+
+type dashboardDocumentTextWidgetModel struct {
+	Background types.String `tfsdk:"background"`
+	Markdown   types.String `tfsdk:"markdown"`
+}
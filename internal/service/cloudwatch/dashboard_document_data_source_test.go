@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchDashboardDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, fmt.Sprintf(`{
+  "start": "-PT6H",
+  "periodOverride": "inherit",
+  "widgets": [
+    {
+      "type": "text", "x": 0, "y": 0, "width": 24, "height": 2,
+      "properties": {"markdown": "# Service", "background": "transparent"}
+    },
+    {
+      "type": "metric", "x": 0, "y": 2, "width": 12, "height": 6,
+      "properties": {
+        "title": "Error rate",
+        "region": %[1]q,
+        "view": "timeSeries",
+        "stat": "Sum",
+        "period": 300,
+        "metrics": [
+          ["AWS/Lambda", "Errors", "FunctionName", "example", {"id": "errors", "visible": false}],
+          ["AWS/Lambda", "Invocations", "FunctionName", "example", {"id": "invocations", "visible": false}],
+          [{"expression": "100 * errors / invocations", "id": "rate", "label": "Error rate"}]
+        ],
+        "annotations": {"horizontal": [{"value": 5, "label": "Threshold", "fill": "above"}]},
+        "yAxis": {"left": {"min": 0, "max": 100, "showUnits": false}}
+      }
+    },
+    {
+      "type": "log", "x": 12, "y": 2, "width": 12, "height": 6,
+      "properties": {
+        "title": "Recent errors",
+        "region": %[1]q,
+        "view": "table",
+        "query": "SOURCE '/aws/lambda/example' | fields @timestamp, @message | filter @message like /ERROR/ | limit 20"
+      }
+    },
+    {
+      "type": "alarm", "x": 0, "y": 8, "width": 6, "height": 6,
+      "properties": {
+        "title": "Alarms",
+        "alarms": ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:example"],
+        "sortBy": "stateUpdatedTimestamp",
+        "states": ["ALARM"]
+      }
+    }
+  ]
+}`, acctest.Region())),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_explorer(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_explorer,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, fmt.Sprintf(`{
+  "widgets": [
+    {
+      "type": "explorer", "x": 0, "y": 0, "width": 24, "height": 15,
+      "properties": {
+        "title": "EC2",
+        "region": %[1]q,
+        "period": 300,
+        "splitBy": "Environment",
+        "metrics": [{"metricName": "CPUUtilization", "resourceType": "AWS::EC2::Instance", "stat": "Average"}],
+        "labels": [{"key": "Environment", "value": "production"}],
+        "widgetOptions": {"view": "timeSeries", "legend": {"position": "bottom"}, "rowsPerPage": 50, "widgetsPerRow": 2}
+      }
+    }
+  ]
+}`, acctest.Region())),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_unknownID,
+				ExpectError: regexache.MustCompile(`ID "m2" does not exist`),
+			},
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_invalidExpression,
+				ExpectError: regexache.MustCompile(`unknown function "sum"`),
+			},
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_noWidgetType,
+				ExpectError: regexache.MustCompile(`Exactly one of alarm, explorer, log, metric or text`),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_dashboard(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard cloudwatch.GetDashboardOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+	resourceName := "aws_cloudwatch_dashboard.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_dashboard(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttrPair(resourceName, "dashboard_body", dataSourceName, names.AttrJSON),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+const testAccDashboardDocumentDataSourceConfig_basic = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  start           = "-PT6H"
+  period_override = "inherit"
+
+  widget {
+    width  = 24
+    height = 2
+
+    text {
+      markdown   = "# Service"
+      background = "transparent"
+    }
+  }
+
+  widget {
+    width = 12
+
+    metric {
+      title  = "Error rate"
+      view   = "timeSeries"
+      stat   = "Sum"
+      period = 300
+
+      query {
+        id          = "errors"
+        namespace   = "AWS/Lambda"
+        metric_name = "Errors"
+        dimensions = {
+          FunctionName = "example"
+        }
+        visible = false
+      }
+
+      query {
+        id          = "invocations"
+        namespace   = "AWS/Lambda"
+        metric_name = "Invocations"
+        dimensions = {
+          FunctionName = "example"
+        }
+        visible = false
+      }
+
+      query {
+        id         = "rate"
+        expression = "100 * errors / invocations"
+        label      = "Error rate"
+      }
+
+      horizontal_annotation {
+        value = 5
+        label = "Threshold"
+        fill  = "above"
+      }
+
+      left_y_axis {
+        min        = 0
+        max        = 100
+        show_units = false
+      }
+    }
+  }
+
+  widget {
+    width = 12
+
+    log {
+      title           = "Recent errors"
+      view            = "table"
+      log_group_names = ["/aws/lambda/example"]
+      query           = "fields @timestamp, @message | filter @message like /ERROR/ | limit 20"
+    }
+  }
+
+  widget {
+    alarm {
+      title   = "Alarms"
+      alarms  = ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:example"]
+      sort_by = "stateUpdatedTimestamp"
+      states  = ["ALARM"]
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_explorer = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    width  = 24
+    height = 15
+
+    explorer {
+      title           = "EC2"
+      period          = 300
+      split_by        = "Environment"
+      view            = "timeSeries"
+      legend_position = "bottom"
+      rows_per_page   = 50
+      widgets_per_row = 2
+
+      metric {
+        metric_name   = "CPUUtilization"
+        resource_type = "AWS::EC2::Instance"
+        stat          = "Average"
+      }
+
+      tag {
+        key   = "Environment"
+        value = "production"
+      }
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_unknownID = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      query {
+        id          = "m1"
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+      }
+
+      query {
+        expression = "m1 + m2"
+      }
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_invalidExpression = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      query {
+        id          = "m1"
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+      }
+
+      query {
+        expression = "sum(m1)"
+      }
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceConfig_noWidgetType = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    width = 12
+  }
+}
+`
+
+func testAccDashboardDocumentDataSourceConfig_dashboard(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    width = 12
+
+    metric {
+      title = "CPU"
+      stat  = "Average"
+
+      query {
+        id          = "m1"
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+        dimensions = {
+          InstanceId = "i-012345"
+        }
+      }
+
+      query {
+        expression = "m1 * 2"
+        label      = "Doubled"
+      }
+    }
+  }
+
+  widget {
+    width = 12
+
+    text {
+      markdown = "Hello world"
+    }
+  }
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_document.test.json
+}
+`, rName)
+}
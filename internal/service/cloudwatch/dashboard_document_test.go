@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestLayoutDashboardWidgets(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sizes    [][2]int // {width, height}
+		expected [][2]int // {x, y}
+	}{
+		"empty": {},
+		"single row": {
+			sizes:    [][2]int{{6, 6}, {6, 6}, {12, 3}},
+			expected: [][2]int{{0, 0}, {6, 0}, {12, 0}},
+		},
+		"wrap": {
+			sizes:    [][2]int{{24, 2}, {8, 6}, {8, 4}, {12, 6}, {12, 6}},
+			expected: [][2]int{{0, 0}, {0, 2}, {8, 2}, {0, 8}, {12, 8}},
+		},
+		"tallest widget in row": {
+			sizes:    [][2]int{{10, 3}, {10, 9}, {10, 6}},
+			expected: [][2]int{{0, 0}, {10, 0}, {0, 9}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			widgets := make([]dashboardWidget, len(testCase.sizes))
+			for i, v := range testCase.sizes {
+				widgets[i].Width, widgets[i].Height = v[0], v[1]
+			}
+
+			layoutDashboardWidgets(widgets)
+
+			got := make([][2]int, len(widgets))
+			for i, v := range widgets {
+				got[i] = [2]int{v.X, v.Y}
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDashboardMetricRow(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		metric   dashboardMetric
+		expected []any
+	}{
+		"metric": {
+			metric: dashboardMetric{
+				Dimensions: map[string]string{"InstanceId": "i-1234", "AutoScalingGroupName": "asg"},
+				MetricName: "CPUUtilization",
+				Namespace:  "AWS/EC2",
+			},
+			expected: []any{"AWS/EC2", "CPUUtilization", "AutoScalingGroupName", "asg", "InstanceId", "i-1234"},
+		},
+		"metric with options": {
+			metric: dashboardMetric{
+				MetricName: "Errors",
+				Namespace:  "AWS/Lambda",
+				Options:    dashboardMetricOptions{ID: "m1", Stat: "Sum", Visible: aws.Bool(false)},
+			},
+			expected: []any{"AWS/Lambda", "Errors", dashboardMetricOptions{ID: "m1", Stat: "Sum", Visible: aws.Bool(false)}},
+		},
+		"expression": {
+			metric: dashboardMetric{
+				Options: dashboardMetricOptions{Expression: "m1 * 100", Label: "Error rate"},
+			},
+			expected: []any{dashboardMetricOptions{Expression: "m1 * 100", Label: "Error rate"}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(testCase.metric.row(), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestLogsInsightsQuery(t *testing.T) {
+	t.Parallel()
+
+	got := logsInsightsQuery([]string{"/aws/lambda/a", "/aws/lambda/b"}, "fields @timestamp, @message | limit 20")
+	expected := "SOURCE '/aws/lambda/a' | SOURCE '/aws/lambda/b' | fields @timestamp, @message | limit 20"
+
+	if got != expected {
+		t.Errorf("got %q, expected %q", got, expected)
+	}
+}
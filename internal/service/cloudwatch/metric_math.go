@@ -0,0 +1,392 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/YakDriver/regexache"
+)
+
+var metricMathIDRegexp = regexache.MustCompile(`^[a-z][0-9A-Za-z_]*$`)
+
+// metricMathQuery is a metric or metric math expression in a graph.
+type metricMathQuery struct {
+	Expression string
+	ID         string
+}
+
+// validateMetricMathQueries validates the IDs of the metrics and expressions in a graph and the syntax of the expressions.
+// Expressions may only reference the IDs of other metrics and expressions in the graph, and may not reference themselves,
+// directly or indirectly.
+// Metrics Insights queries are not validated.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/using-metric-math.html.
+func validateMetricMathQueries(queries []metricMathQuery) error {
+	var errs []error
+
+	ids := make(map[string]struct{})
+	for _, v := range queries {
+		if v.ID == "" {
+			continue
+		}
+
+		if !metricMathIDRegexp.MatchString(v.ID) {
+			errs = append(errs, fmt.Errorf("ID %q must start with a lowercase letter and contain only letters, numbers and underscores", v.ID))
+			continue
+		}
+
+		if _, ok := ids[v.ID]; ok {
+			errs = append(errs, fmt.Errorf("ID %q is used more than once", v.ID))
+			continue
+		}
+
+		ids[v.ID] = struct{}{}
+	}
+
+	references := make(map[string][]string)
+	for _, v := range queries {
+		if v.Expression == "" || isMetricsInsightsQuery(v.Expression) {
+			continue
+		}
+
+		refs, err := parseMetricMathExpression(v.Expression)
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("expression %q: %w", v.Expression, err))
+			continue
+		}
+
+		for _, ref := range refs {
+			if _, ok := ids[ref]; !ok {
+				errs = append(errs, fmt.Errorf("expression %q: ID %q does not exist", v.Expression, ref))
+			} else if ref == v.ID {
+				errs = append(errs, fmt.Errorf("expression %q: references itself", v.Expression))
+			}
+		}
+
+		if v.ID != "" {
+			references[v.ID] = refs
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	// Detect circular references.
+	const (
+		_ = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var visit func(id string) error
+	visit = func(id string) error {
+		switch state[id] {
+		case visiting:
+			return fmt.Errorf("expression %q has a circular reference", id)
+		case visited:
+			return nil
+		}
+
+		state[id] = visiting
+		for _, ref := range references[id] {
+			if err := visit(ref); err != nil {
+				return err
+			}
+		}
+		state[id] = visited
+
+		return nil
+	}
+	for _, v := range queries {
+		if v.ID != "" {
+			if err := visit(v.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func isMetricsInsightsQuery(expression string) bool {
+	fields := strings.Fields(expression)
+
+	return len(fields) > 0 && strings.EqualFold(fields[0], "SELECT")
+}
+
+// parseMetricMathExpression parses a metric math expression and returns the metric IDs it references.
+// Functions and keywords, e.g. SUM, METRICS and REPEAT, are upper case. Metric IDs start with a lower case letter.
+func parseMetricMathExpression(expression string) ([]string, error) {
+	tokens, err := tokenizeMetricMathExpression(expression)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p := &metricMathParser{tokens: tokens}
+
+	if err := p.parseExpression(); err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind != metricMathTokenEOF {
+		return nil, fmt.Errorf("unexpected %q at position %d", t.value, t.pos)
+	}
+
+	return p.references, nil
+}
+
+type metricMathTokenKind int
+
+const (
+	metricMathTokenEOF metricMathTokenKind = iota
+	metricMathTokenIdentifier
+	metricMathTokenNumber
+	metricMathTokenOperator
+	metricMathTokenString
+)
+
+type metricMathToken struct {
+	kind  metricMathTokenKind
+	value string
+	pos   int
+}
+
+func tokenizeMetricMathExpression(s string) ([]metricMathToken, error) {
+	var tokens []metricMathToken
+
+	isDigit := func(i int) bool {
+		return i < len(s) && s[i] >= '0' && s[i] <= '9'
+	}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case isDigit(i) || (c == '.' && isDigit(i+1)):
+			start := i
+			for isDigit(i) {
+				i++
+			}
+			if i < len(s) && s[i] == '.' {
+				i++
+				for isDigit(i) {
+					i++
+				}
+			}
+			if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+				j := i + 1
+				if j < len(s) && (s[j] == '+' || s[j] == '-') {
+					j++
+				}
+				if !isDigit(j) {
+					return nil, fmt.Errorf("invalid number at position %d", start)
+				}
+				i = j
+				for isDigit(i) {
+					i++
+				}
+			}
+			tokens = append(tokens, metricMathToken{kind: metricMathTokenNumber, value: s[start:i], pos: start})
+
+		case c == '"' || c == '\'':
+			start := i
+			for i++; i < len(s) && s[i] != c; i++ {
+				if s[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(s) {
+				return nil, fmt.Errorf("unterminated string at position %d", start)
+			}
+			i++
+			tokens = append(tokens, metricMathToken{kind: metricMathTokenString, value: s[start:i], pos: start})
+
+		case c == '_' || unicode.IsLetter(rune(c)):
+			start := i
+			for i < len(s) && (s[i] == '_' || unicode.IsLetter(rune(s[i])) || unicode.IsDigit(rune(s[i]))) {
+				i++
+			}
+			tokens = append(tokens, metricMathToken{kind: metricMathTokenIdentifier, value: s[start:i], pos: start})
+
+		default:
+			if i+1 < len(s) {
+				if op := s[i : i+2]; op == "==" || op == "!=" || op == "<=" || op == ">=" || op == "&&" || op == "||" {
+					tokens = append(tokens, metricMathToken{kind: metricMathTokenOperator, value: op, pos: i})
+					i += 2
+					continue
+				}
+			}
+			if !strings.ContainsRune("+-*/^<>!()[],", rune(c)) {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+			}
+			tokens = append(tokens, metricMathToken{kind: metricMathTokenOperator, value: string(c), pos: i})
+			i++
+		}
+	}
+
+	return append(tokens, metricMathToken{kind: metricMathTokenEOF, value: "end of expression", pos: len(s)}), nil
+}
+
+// metricMathParser is a recursive descent parser for metric math expressions.
+type metricMathParser struct {
+	tokens     []metricMathToken
+	pos        int
+	references []string
+}
+
+func (p *metricMathParser) peek() metricMathToken {
+	return p.tokens[p.pos]
+}
+
+func (p *metricMathParser) next() metricMathToken {
+	t := p.tokens[p.pos]
+	if t.kind != metricMathTokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// accept consumes the next token if it is one of the specified operators or keywords.
+func (p *metricMathParser) accept(values ...string) bool {
+	t := p.peek()
+	if t.kind != metricMathTokenOperator && t.kind != metricMathTokenIdentifier {
+		return false
+	}
+
+	for _, v := range values {
+		if t.value == v {
+			p.next()
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *metricMathParser) expect(value string) error {
+	if t := p.next(); t.kind != metricMathTokenOperator || t.value != value {
+		return fmt.Errorf("expected %q at position %d, got %q", value, t.pos, t.value)
+	}
+
+	return nil
+}
+
+// parseBinary parses a left-associative sequence of operands separated by the specified operators.
+func (p *metricMathParser) parseBinary(operand func() error, operators ...string) error {
+	if err := operand(); err != nil {
+		return err
+	}
+
+	for p.accept(operators...) {
+		if err := operand(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *metricMathParser) parseExpression() error {
+	return p.parseBinary(p.parseAnd, "||", "OR")
+}
+
+func (p *metricMathParser) parseAnd() error {
+	return p.parseBinary(p.parseComparison, "&&", "AND")
+}
+
+func (p *metricMathParser) parseComparison() error {
+	return p.parseBinary(p.parseAdditive, "==", "!=", "<", "<=", ">", ">=")
+}
+
+func (p *metricMathParser) parseAdditive() error {
+	return p.parseBinary(p.parseMultiplicative, "+", "-")
+}
+
+func (p *metricMathParser) parseMultiplicative() error {
+	return p.parseBinary(p.parseExponent, "*", "/")
+}
+
+func (p *metricMathParser) parseExponent() error {
+	return p.parseBinary(p.parseUnary, "^")
+}
+
+func (p *metricMathParser) parseUnary() error {
+	if p.accept("+", "-", "!", "NOT") {
+		return p.parseUnary()
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *metricMathParser) parsePrimary() error {
+	t := p.next()
+
+	switch t.kind {
+	case metricMathTokenNumber, metricMathTokenString:
+		return nil
+
+	case metricMathTokenIdentifier:
+		isUpper := unicode.IsUpper(rune(t.value[0]))
+
+		if p.accept("(") {
+			if !isUpper {
+				return fmt.Errorf("unknown function %q at position %d", t.value, t.pos)
+			}
+
+			return p.parseArguments(")")
+		}
+
+		// Upper case identifiers are keywords, e.g. FILL(m1, REPEAT).
+		if !isUpper {
+			p.references = append(p.references, t.value)
+		}
+
+		return nil
+
+	case metricMathTokenOperator:
+		switch t.value {
+		case "(":
+			if err := p.parseExpression(); err != nil {
+				return err
+			}
+
+			return p.expect(")")
+
+		case "[":
+			return p.parseArguments("]")
+		}
+	}
+
+	return fmt.Errorf("unexpected %q at position %d", t.value, t.pos)
+}
+
+// parseArguments parses a possibly empty, comma-separated list of expressions terminated by the specified operator.
+func (p *metricMathParser) parseArguments(end string) error {
+	if p.accept(end) {
+		return nil
+	}
+
+	for {
+		if err := p.parseExpression(); err != nil {
+			return err
+		}
+
+		if p.accept(end) {
+			return nil
+		}
+
+		if t := p.next(); t.kind != metricMathTokenOperator || t.value != "," {
+			return fmt.Errorf(`expected "," or %q at position %d, got %q`, end, t.pos, t.value)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateMetricMathQueries(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		queries  []metricMathQuery
+		expected string
+	}{
+		"metrics only": {
+			queries: []metricMathQuery{{ID: "m1"}, {}},
+		},
+		"arithmetic": {
+			queries: []metricMathQuery{
+				{ID: "errors"},
+				{ID: "requests"},
+				{ID: "rate", Expression: "100 * errors / (requests + 0.5e-3)"},
+			},
+		},
+		"functions": {
+			queries: []metricMathQuery{
+				{ID: "m1"},
+				{ID: "m2"},
+				{ID: "e1", Expression: `SUM([m1, m2])`},
+				{ID: "e2", Expression: `FILL(m1, REPEAT)`},
+				{ID: "e3", Expression: `SORT(METRICS(), SUM, DESC)`},
+				{ID: "e4", Expression: `SEARCH('{AWS/EC2,InstanceId} MetricName="CPUUtilization"', 'Average', 300)`},
+				{ID: "e5", Expression: `IF(m1 > 10 AND m2 != 0 || !m2, m1, -m2 ^ 2)`},
+				{Expression: `ANOMALY_DETECTION_BAND(e1, 2)`},
+			},
+		},
+		"metrics insights": {
+			queries: []metricMathQuery{
+				{ID: "q1", Expression: `SELECT AVG(CPUUtilization) FROM SCHEMA("AWS/EC2", InstanceId) ORDER BY AVG() DESC LIMIT 10`},
+			},
+		},
+		"invalid ID": {
+			queries:  []metricMathQuery{{ID: "M1"}},
+			expected: `ID "M1" must start with a lowercase letter`,
+		},
+		"duplicate ID": {
+			queries:  []metricMathQuery{{ID: "m1"}, {ID: "m1", Expression: "1"}},
+			expected: `ID "m1" is used more than once`,
+		},
+		"unknown ID": {
+			queries:  []metricMathQuery{{ID: "m1"}, {ID: "e1", Expression: "m1 + m2"}},
+			expected: `ID "m2" does not exist`,
+		},
+		"self reference": {
+			queries:  []metricMathQuery{{ID: "e1", Expression: "e1 * 2"}},
+			expected: `references itself`,
+		},
+		"circular reference": {
+			queries:  []metricMathQuery{{ID: "e1", Expression: "e2 * 2"}, {ID: "e2", Expression: "e1 / 2"}},
+			expected: `circular reference`,
+		},
+		"unbalanced parentheses": {
+			queries:  []metricMathQuery{{ID: "m1"}, {Expression: "SUM(m1"}, {Expression: "(m1 + 1"}},
+			expected: `expected "," or ")" at position 6`,
+		},
+		"trailing operator": {
+			queries:  []metricMathQuery{{ID: "m1"}, {Expression: "m1 +"}},
+			expected: `unexpected "end of expression"`,
+		},
+		"missing operator": {
+			queries:  []metricMathQuery{{ID: "m1"}, {Expression: "m1 2"}},
+			expected: `unexpected "2" at position 3`,
+		},
+		"lowercase function": {
+			queries:  []metricMathQuery{{ID: "m1"}, {Expression: "sum(m1)"}},
+			expected: `unknown function "sum"`,
+		},
+		"unterminated string": {
+			queries:  []metricMathQuery{{Expression: `SEARCH('{AWS/EC2}, 'Average', 300)`}},
+			expected: `unterminated string`,
+		},
+		"invalid character": {
+			queries:  []metricMathQuery{{ID: "m1"}, {Expression: "m1 % 2"}},
+			expected: `unexpected character '%'`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateMetricMathQueries(testCase.queries)
+
+			if testCase.expected == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error matching %q", testCase.expected)
+			}
+
+			if !strings.Contains(err.Error(), testCase.expected) {
+				t.Errorf("expected error matching %q, got %q", testCase.expected, err)
+			}
+		})
+	}
+}
@@ -16,6 +16,11 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newDashboardDocumentDataSource,
+			TypeName: "aws_cloudwatch_dashboard_document",
+			Name:     "Dashboard Document",
+		},
 		{
 			Factory:  newDataSourceContributorManagedInsightRules,
 			TypeName: "aws_cloudwatch_contributor_managed_insight_rules",
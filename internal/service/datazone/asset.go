@@ -0,0 +1,400 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_datazone_asset", name="Asset")
+func newResourceAsset(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAsset{}, nil
+}
+
+const (
+	ResNameAsset = "Asset"
+)
+
+type resourceAsset struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceAsset) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 2048),
+				},
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external_identifier": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 600),
+				},
+			},
+			"glossary_terms": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 20),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9_-]{1,36}$`), "must conform to: ^[a-zA-Z0-9_-]{1,36}$ ")),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"owning_project_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"revision": schema.StringAttribute{
+				Computed: true,
+			},
+			"type_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type_revision": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"forms_input": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[formInputData](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrContent: schema.StringAttribute{
+							Optional: true,
+						},
+						"form_name": schema.StringAttribute{
+							Required: true,
+						},
+						"type_identifier": schema.StringAttribute{
+							Optional: true,
+						},
+						"type_revision": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"prediction_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[predictionConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"business_name_generation": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[businessNameGenerationConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrEnabled: schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceAsset) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan resourceAssetData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateAssetInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, &plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	in.ClientToken = aws.String(id.UniqueId())
+
+	out, err := conn.CreateAsset(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameAsset, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if out == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameAsset, plan.Name.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.flatten(ctx, out, out.DomainId, out.OwningProjectId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceAsset) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceAssetData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findAssetByID(ctx, conn, state.DomainIdentifier.ValueString(), state.Id.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameAsset, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.flatten(ctx, out, out.DomainId, out.OwningProjectId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceAsset) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan, state resourceAssetData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) ||
+		!plan.FormsInput.Equal(state.FormsInput) ||
+		!plan.GlossaryTerms.Equal(state.GlossaryTerms) ||
+		!plan.Name.Equal(state.Name) ||
+		!plan.PredictionConfiguration.Equal(state.PredictionConfiguration) ||
+		!plan.TypeRevision.Equal(state.TypeRevision) {
+		// Every change to an asset is recorded as a new revision.
+		in := &datazone.CreateAssetRevisionInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, &plan, in)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		in.ClientToken = aws.String(id.UniqueId())
+		in.Identifier = plan.Id.ValueStringPointer()
+
+		out, err := conn.CreateAssetRevision(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameAsset, plan.Id.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(plan.flatten(ctx, out, out.DomainId, out.OwningProjectId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.Revision = state.Revision
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceAsset) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceAssetData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.DeleteAssetInput{
+		DomainIdentifier: state.DomainIdentifier.ValueStringPointer(),
+		Identifier:       state.Id.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteAsset(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameAsset, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceAsset) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("resource import invalid ID", fmt.Sprintf(`Unexpected format for import ID (%s), use: "DomainIdentifier,Id"`, req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), parts[1])...)
+}
+
+func findAssetByID(ctx context.Context, conn *datazone.Client, domainID, id string) (*datazone.GetAssetOutput, error) {
+	in := &datazone.GetAssetInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(id),
+	}
+
+	out, err := conn.GetAsset(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceAssetData struct {
+	CreatedAt               timetypes.RFC3339                                            `tfsdk:"created_at"`
+	CreatedBy               types.String                                                 `tfsdk:"created_by"`
+	Description             types.String                                                 `tfsdk:"description"`
+	DomainIdentifier        types.String                                                 `tfsdk:"domain_identifier"`
+	ExternalIdentifier      types.String                                                 `tfsdk:"external_identifier"`
+	FormsInput              fwtypes.ListNestedObjectValueOf[formInputData]               `tfsdk:"forms_input"`
+	GlossaryTerms           fwtypes.ListValueOf[types.String]                            `tfsdk:"glossary_terms"`
+	Id                      types.String                                                 `tfsdk:"id"`
+	Name                    types.String                                                 `tfsdk:"name"`
+	OwningProjectIdentifier types.String                                                 `tfsdk:"owning_project_identifier"`
+	PredictionConfiguration fwtypes.ListNestedObjectValueOf[predictionConfigurationData] `tfsdk:"prediction_configuration"`
+	Revision                types.String                                                 `tfsdk:"revision"`
+	TypeIdentifier          types.String                                                 `tfsdk:"type_identifier"`
+	TypeRevision            types.String                                                 `tfsdk:"type_revision"`
+}
+
+// The asset type can be configured by either name or ID, so an already-known
+// type identifier is retained in state.
+func (m *resourceAssetData) flatten(ctx context.Context, out any, domainID, owningProjectID *string) (diags diag.Diagnostics) {
+	typeIdentifier := m.TypeIdentifier
+
+	diags.Append(fwflex.Flatten(ctx, out, m, fwflex.WithIgnoredFieldNamesAppend("PredictionConfiguration"))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.DomainIdentifier = fwflex.StringToFramework(ctx, domainID)
+	m.OwningProjectIdentifier = fwflex.StringToFramework(ctx, owningProjectID)
+	if !typeIdentifier.IsNull() {
+		m.TypeIdentifier = typeIdentifier
+	}
+
+	return diags
+}
+
+type predictionConfigurationData struct {
+	BusinessNameGeneration fwtypes.ListNestedObjectValueOf[businessNameGenerationConfigurationData] `tfsdk:"business_name_generation"`
+}
+
+type businessNameGenerationConfigurationData struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
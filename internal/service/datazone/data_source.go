@@ -0,0 +1,872 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_datazone_data_source", name="Data Source")
+func newResourceDataSource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceDataSource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameDataSource = "Data Source"
+
+	dataSourceTypeGlue     = "GLUE"
+	dataSourceTypeRedshift = "REDSHIFT"
+)
+
+type resourceDataSource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceDataSource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	relationalFilterConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[relationalFilterConfigurationData](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrDatabaseName: schema.StringAttribute{
+					Required: true,
+				},
+				"schema_name": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"filter_expression": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[filterExpressionData](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrExpression: schema.StringAttribute{
+								Required: true,
+							},
+							names.AttrType: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.FilterExpressionType](),
+								Required:   true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 2048),
+				},
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enable_setting": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EnableSetting](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_run_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"last_run_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataSourceRunStatus](),
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"project_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"publish_on_import": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataSourceStatus](),
+				Computed:   true,
+			},
+			names.AttrType: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dataSourceTypeGlue, dataSourceTypeRedshift),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"asset_forms_input": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[formInputData](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrContent: schema.StringAttribute{
+							Optional: true,
+						},
+						"form_name": schema.StringAttribute{
+							Required: true,
+						},
+						"type_identifier": schema.StringAttribute{
+							Optional: true,
+						},
+						"type_revision": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSourceConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"glue_run_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[glueRunConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("glue_run_configuration"),
+									path.MatchRelative().AtParent().AtName("redshift_run_configuration"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"auto_import_data_quality_result": schema.BoolAttribute{
+										Optional: true,
+									},
+									"data_access_role": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Optional:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"relational_filter_configuration": relationalFilterConfigurationBlock,
+								},
+							},
+						},
+						"redshift_run_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftRunConfigurationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"data_access_role": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Optional:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"redshift_credential_configuration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftCredentialConfigurationData](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"secret_manager_arn": schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+											},
+										},
+									},
+									"redshift_storage": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftStorageData](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"redshift_cluster_source": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftClusterStorageData](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ExactlyOneOf(
+															path.MatchRelative().AtParent().AtName("redshift_cluster_source"),
+															path.MatchRelative().AtParent().AtName("redshift_serverless_source"),
+														),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrClusterName: schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+												"redshift_serverless_source": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[redshiftServerlessStorageData](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"workgroup_name": schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"relational_filter_configuration": relationalFilterConfigurationBlock,
+								},
+							},
+						},
+					},
+				},
+			},
+			"recommendation": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[recommendationConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enable_business_name_generation": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrSchedule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[scheduleConfigurationData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSchedule: schema.StringAttribute{
+							Required: true,
+						},
+						"timezone": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Timezone](),
+							Optional:   true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceDataSource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan resourceDataSourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateDataSourceInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, &plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	in.ClientToken = aws.String(id.UniqueId())
+
+	out, err := conn.CreateDataSource(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameDataSource, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if out == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameDataSource, plan.Name.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.Id = fwflex.StringToFramework(ctx, out.Id)
+
+	// set partial state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), out.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), out.DomainId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	output, err := waitDataSourceCreated(ctx, conn, plan.DomainIdentifier.ValueString(), plan.Id.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForCreation, ResNameDataSource, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.flatten(ctx, output)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceDataSource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceDataSourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findDataSourceByID(ctx, conn, state.DomainIdentifier.ValueString(), state.Id.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameDataSource, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.flatten(ctx, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceDataSource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan, state resourceDataSourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AssetFormsInput.Equal(state.AssetFormsInput) ||
+		!plan.Configuration.Equal(state.Configuration) ||
+		!plan.Description.Equal(state.Description) ||
+		!plan.EnableSetting.Equal(state.EnableSetting) ||
+		!plan.Name.Equal(state.Name) ||
+		!plan.PublishOnImport.Equal(state.PublishOnImport) ||
+		!plan.Recommendation.Equal(state.Recommendation) ||
+		!plan.Schedule.Equal(state.Schedule) {
+		in := &datazone.UpdateDataSourceInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, &plan, in)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		in.Identifier = plan.Id.ValueStringPointer()
+
+		_, err := conn.UpdateDataSource(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameDataSource, plan.Id.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+		output, err := waitDataSourceUpdated(ctx, conn, plan.DomainIdentifier.ValueString(), plan.Id.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForUpdate, ResNameDataSource, plan.Id.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(plan.flatten(ctx, output)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.LastRunAt = state.LastRunAt
+		plan.LastRunStatus = state.LastRunStatus
+		plan.Status = state.Status
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceDataSource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceDataSourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.DeleteDataSourceInput{
+		ClientToken:      aws.String(id.UniqueId()),
+		DomainIdentifier: state.DomainIdentifier.ValueStringPointer(),
+		Identifier:       state.Id.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteDataSource(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameDataSource, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitDataSourceDeleted(ctx, conn, state.DomainIdentifier.ValueString(), state.Id.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForDeletion, ResNameDataSource, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceDataSource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("resource import invalid ID", fmt.Sprintf(`Unexpected format for import ID (%s), use: "DomainIdentifier,Id"`, req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), parts[1])...)
+}
+
+func waitDataSourceCreated(ctx context.Context, conn *datazone.Client, domainID, id string, timeout time.Duration) (*datazone.GetDataSourceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.DataSourceStatusCreating),
+		Target:                    enum.Slice(awstypes.DataSourceStatusReady, awstypes.DataSourceStatusRunning),
+		Refresh:                   statusDataSource(ctx, conn, domainID, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*datazone.GetDataSourceOutput); ok {
+		if out.Status == awstypes.DataSourceStatusFailedCreation && out.ErrorMessage != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", out.ErrorMessage.ErrorType, aws.ToString(out.ErrorMessage.ErrorDetail)))
+		}
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitDataSourceUpdated(ctx context.Context, conn *datazone.Client, domainID, id string, timeout time.Duration) (*datazone.GetDataSourceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.DataSourceStatusUpdating),
+		Target:                    enum.Slice(awstypes.DataSourceStatusReady, awstypes.DataSourceStatusRunning),
+		Refresh:                   statusDataSource(ctx, conn, domainID, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*datazone.GetDataSourceOutput); ok {
+		if out.Status == awstypes.DataSourceStatusFailedUpdate && out.ErrorMessage != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", out.ErrorMessage.ErrorType, aws.ToString(out.ErrorMessage.ErrorDetail)))
+		}
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitDataSourceDeleted(ctx context.Context, conn *datazone.Client, domainID, id string, timeout time.Duration) (*datazone.GetDataSourceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DataSourceStatusDeleting, awstypes.DataSourceStatusReady, awstypes.DataSourceStatusRunning),
+		Target:  []string{},
+		Refresh: statusDataSource(ctx, conn, domainID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*datazone.GetDataSourceOutput); ok {
+		if out.Status == awstypes.DataSourceStatusFailedDeletion && out.ErrorMessage != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", out.ErrorMessage.ErrorType, aws.ToString(out.ErrorMessage.ErrorDetail)))
+		}
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusDataSource(ctx context.Context, conn *datazone.Client, domainID, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findDataSourceByID(ctx, conn, domainID, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func findDataSourceByID(ctx context.Context, conn *datazone.Client, domainID, id string) (*datazone.GetDataSourceOutput, error) {
+	in := &datazone.GetDataSourceInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(id),
+	}
+
+	out, err := conn.GetDataSource(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceDataSourceData struct {
+	AssetFormsInput       fwtypes.ListNestedObjectValueOf[formInputData]                   `tfsdk:"asset_forms_input"`
+	Configuration         fwtypes.ListNestedObjectValueOf[dataSourceConfigurationData]     `tfsdk:"configuration"`
+	CreatedAt             timetypes.RFC3339                                                `tfsdk:"created_at"`
+	Description           types.String                                                     `tfsdk:"description"`
+	DomainIdentifier      types.String                                                     `tfsdk:"domain_identifier"`
+	EnableSetting         fwtypes.StringEnum[awstypes.EnableSetting]                       `tfsdk:"enable_setting"`
+	EnvironmentIdentifier types.String                                                     `tfsdk:"environment_identifier"`
+	Id                    types.String                                                     `tfsdk:"id"`
+	LastRunAt             timetypes.RFC3339                                                `tfsdk:"last_run_at"`
+	LastRunStatus         fwtypes.StringEnum[awstypes.DataSourceRunStatus]                 `tfsdk:"last_run_status"`
+	Name                  types.String                                                     `tfsdk:"name"`
+	ProjectIdentifier     types.String                                                     `tfsdk:"project_identifier"`
+	PublishOnImport       types.Bool                                                       `tfsdk:"publish_on_import"`
+	Recommendation        fwtypes.ListNestedObjectValueOf[recommendationConfigurationData] `tfsdk:"recommendation"`
+	Schedule              fwtypes.ListNestedObjectValueOf[scheduleConfigurationData]       `tfsdk:"schedule"`
+	Status                fwtypes.StringEnum[awstypes.DataSourceStatus]                    `tfsdk:"status"`
+	Timeouts              timeouts.Value                                                   `tfsdk:"timeouts"`
+	Type                  types.String                                                     `tfsdk:"type"`
+}
+
+func (m *resourceDataSourceData) flatten(ctx context.Context, out *datazone.GetDataSourceOutput) (diags diag.Diagnostics) {
+	diags.Append(fwflex.Flatten(ctx, out, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.DomainIdentifier = fwflex.StringToFramework(ctx, out.DomainId)
+	m.EnvironmentIdentifier = fwflex.StringToFramework(ctx, out.EnvironmentId)
+	m.ProjectIdentifier = fwflex.StringToFramework(ctx, out.ProjectId)
+
+	return diags
+}
+
+type formInputData struct {
+	Content        types.String `tfsdk:"content"`
+	FormName       types.String `tfsdk:"form_name"`
+	TypeIdentifier types.String `tfsdk:"type_identifier"`
+	TypeRevision   types.String `tfsdk:"type_revision"`
+}
+
+type dataSourceConfigurationData struct {
+	GlueRunConfiguration     fwtypes.ListNestedObjectValueOf[glueRunConfigurationData]     `tfsdk:"glue_run_configuration"`
+	RedshiftRunConfiguration fwtypes.ListNestedObjectValueOf[redshiftRunConfigurationData] `tfsdk:"redshift_run_configuration"`
+}
+
+var (
+	_ fwflex.Expander  = dataSourceConfigurationData{}
+	_ fwflex.Flattener = &dataSourceConfigurationData{}
+)
+
+func (m dataSourceConfigurationData) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.GlueRunConfiguration.IsNull():
+		glueRunConfigurationData, d := m.GlueRunConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.DataSourceConfigurationInputMemberGlueRunConfiguration
+		diags.Append(fwflex.Expand(ctx, glueRunConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.RedshiftRunConfiguration.IsNull():
+		redshiftRunConfigurationData, d := m.RedshiftRunConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.DataSourceConfigurationInputMemberRedshiftRunConfiguration
+		diags.Append(fwflex.Expand(ctx, redshiftRunConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *dataSourceConfigurationData) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.DataSourceConfigurationOutputMemberGlueRunConfiguration:
+		var model glueRunConfigurationData
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.GlueRunConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+		m.RedshiftRunConfiguration = fwtypes.NewListNestedObjectValueOfNull[redshiftRunConfigurationData](ctx)
+
+		return diags
+
+	case awstypes.DataSourceConfigurationOutputMemberRedshiftRunConfiguration:
+		var model redshiftRunConfigurationData
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.GlueRunConfiguration = fwtypes.NewListNestedObjectValueOfNull[glueRunConfigurationData](ctx)
+		m.RedshiftRunConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type glueRunConfigurationData struct {
+	AutoImportDataQualityResult   types.Bool                                                         `tfsdk:"auto_import_data_quality_result"`
+	DataAccessRole                fwtypes.ARN                                                        `tfsdk:"data_access_role"`
+	RelationalFilterConfiguration fwtypes.ListNestedObjectValueOf[relationalFilterConfigurationData] `tfsdk:"relational_filter_configuration"`
+}
+
+type redshiftRunConfigurationData struct {
+	DataAccessRole                  fwtypes.ARN                                                          `tfsdk:"data_access_role"`
+	RedshiftCredentialConfiguration fwtypes.ListNestedObjectValueOf[redshiftCredentialConfigurationData] `tfsdk:"redshift_credential_configuration"`
+	RedshiftStorage                 fwtypes.ListNestedObjectValueOf[redshiftStorageData]                 `tfsdk:"redshift_storage"`
+	RelationalFilterConfiguration   fwtypes.ListNestedObjectValueOf[relationalFilterConfigurationData]   `tfsdk:"relational_filter_configuration"`
+}
+
+type redshiftCredentialConfigurationData struct {
+	SecretManagerARN fwtypes.ARN `tfsdk:"secret_manager_arn"`
+}
+
+type redshiftStorageData struct {
+	RedshiftClusterSource    fwtypes.ListNestedObjectValueOf[redshiftClusterStorageData]    `tfsdk:"redshift_cluster_source"`
+	RedshiftServerlessSource fwtypes.ListNestedObjectValueOf[redshiftServerlessStorageData] `tfsdk:"redshift_serverless_source"`
+}
+
+var (
+	_ fwflex.Expander  = redshiftStorageData{}
+	_ fwflex.Flattener = &redshiftStorageData{}
+)
+
+func (m redshiftStorageData) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.RedshiftClusterSource.IsNull():
+		redshiftClusterStorageData, d := m.RedshiftClusterSource.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.RedshiftStorageMemberRedshiftClusterSource
+		diags.Append(fwflex.Expand(ctx, redshiftClusterStorageData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.RedshiftServerlessSource.IsNull():
+		redshiftServerlessStorageData, d := m.RedshiftServerlessSource.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.RedshiftStorageMemberRedshiftServerlessSource
+		diags.Append(fwflex.Expand(ctx, redshiftServerlessStorageData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *redshiftStorageData) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.RedshiftStorageMemberRedshiftClusterSource:
+		var model redshiftClusterStorageData
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.RedshiftClusterSource = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+		m.RedshiftServerlessSource = fwtypes.NewListNestedObjectValueOfNull[redshiftServerlessStorageData](ctx)
+
+		return diags
+
+	case awstypes.RedshiftStorageMemberRedshiftServerlessSource:
+		var model redshiftServerlessStorageData
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.RedshiftClusterSource = fwtypes.NewListNestedObjectValueOfNull[redshiftClusterStorageData](ctx)
+		m.RedshiftServerlessSource = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type redshiftClusterStorageData struct {
+	ClusterName types.String `tfsdk:"cluster_name"`
+}
+
+type redshiftServerlessStorageData struct {
+	WorkgroupName types.String `tfsdk:"workgroup_name"`
+}
+
+type relationalFilterConfigurationData struct {
+	DatabaseName     types.String                                          `tfsdk:"database_name"`
+	FilterExpression fwtypes.ListNestedObjectValueOf[filterExpressionData] `tfsdk:"filter_expression"`
+	SchemaName       types.String                                          `tfsdk:"schema_name"`
+}
+
+type filterExpressionData struct {
+	Expression types.String                                      `tfsdk:"expression"`
+	Type       fwtypes.StringEnum[awstypes.FilterExpressionType] `tfsdk:"type"`
+}
+
+type recommendationConfigurationData struct {
+	EnableBusinessNameGeneration types.Bool `tfsdk:"enable_business_name_generation"`
+}
+
+type scheduleConfigurationData struct {
+	Schedule types.String                          `tfsdk:"schedule"`
+	Timezone fwtypes.StringEnum[awstypes.Timezone] `tfsdk:"timezone"`
+}
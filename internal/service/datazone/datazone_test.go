@@ -13,11 +13,23 @@ func TestAccDataZone_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"DataSource": {
+			acctest.CtBasic:      testAccDataSource_basic,
+			acctest.CtDisappears: testAccDataSource_disappears,
+		},
 		"Environment": {
 			acctest.CtBasic:      testAccEnvironment_basic,
 			acctest.CtDisappears: testAccEnvironment_disappears,
 			"update":             testAccEnvironment_update,
 		},
+		"SubscriptionGrant": {
+			acctest.CtBasic:      testAccSubscriptionGrant_basic,
+			acctest.CtDisappears: testAccSubscriptionGrant_disappears,
+		},
+		"SubscriptionTarget": {
+			acctest.CtBasic:      testAccSubscriptionTarget_basic,
+			acctest.CtDisappears: testAccSubscriptionTarget_disappears,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -5,7 +5,9 @@ package datazone
 
 // Exports for use in tests only.
 var (
+	ResourceAsset                             = newResourceAsset
 	ResourceAssetType                         = newResourceAssetType
+	ResourceDataSource                        = newResourceDataSource
 	ResourceDomain                            = newResourceDomain
 	ResourceEnvironmentBlueprintConfiguration = newResourceEnvironmentBlueprintConfiguration
 	ResourceEnvironment                       = newResourceEnvironment
@@ -13,16 +15,28 @@ var (
 	ResourceFormType                          = newResourceFormType
 	ResourceGlossary                          = newResourceGlossary
 	ResourceGlossaryTerm                      = newResourceGlossaryTerm
+	ResourceListing                           = newResourceListing
 	ResourceProject                           = newResourceProject
+	ResourceProjectMembership                 = newResourceProjectMembership
+	ResourceSubscriptionGrant                 = newResourceSubscriptionGrant
+	ResourceSubscriptionRequest               = newResourceSubscriptionRequest
+	ResourceSubscriptionTarget                = newResourceSubscriptionTarget
 	ResourceUserProfile                       = newResourceUserProfile
 
-	FindAssetTypeByID          = findAssetTypeByID
-	FindEnvironmentByID        = findEnvironmentByID
-	FindEnvironmentProfileByID = findEnvironmentProfileByID
-	FindFormTypeByID           = findFormTypeByID
-	FindGlossaryByID           = findGlossaryByID
-	FindGlossaryTermByID       = findGlossaryTermByID
-	FindUserProfileByID        = findUserProfileByID
+	FindAssetByID                       = findAssetByID
+	FindAssetTypeByID                   = findAssetTypeByID
+	FindDataSourceByID                  = findDataSourceByID
+	FindEnvironmentByID                 = findEnvironmentByID
+	FindEnvironmentProfileByID          = findEnvironmentProfileByID
+	FindFormTypeByID                    = findFormTypeByID
+	FindGlossaryByID                    = findGlossaryByID
+	FindGlossaryTermByID                = findGlossaryTermByID
+	FindListingByID                     = findListingByID
+	FindProjectMembershipByThreePartKey = findProjectMembershipByThreePartKey
+	FindSubscriptionGrantByID           = findSubscriptionGrantByID
+	FindSubscriptionRequestByID         = findSubscriptionRequestByID
+	FindSubscriptionTargetByID          = findSubscriptionTargetByID
+	FindUserProfileByID                 = findUserProfileByID
 
 	IsResourceMissing = isResourceMissing
 )
@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_datazone_listing", name="Listing")
+func newResourceListing(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceListing{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameListing = "Listing"
+)
+
+type resourceListing struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceListing) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity_revision": schema.StringAttribute{
+				Optional: true,
+			},
+			"entity_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EntityType](),
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(awstypes.EntityTypeAsset)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"listing_revision": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ListingStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceListing) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan resourceListingData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := publishListing(ctx, conn, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameListing, plan.EntityIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.Id = fwflex.StringToFramework(ctx, out.ListingId)
+
+	// set partial state
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), out.ListingId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), plan.DomainIdentifier)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	output, err := waitListingActive(ctx, conn, plan.DomainIdentifier.ValueString(), plan.Id.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForCreation, ResNameListing, plan.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, output, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceListing) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceListingData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findListingByID(ctx, conn, state.DomainIdentifier.ValueString(), state.Id.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameListing, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if item, ok := out.Item.(*awstypes.ListingItemMemberAssetListing); ok {
+		state.EntityIdentifier = fwflex.StringToFramework(ctx, item.Value.AssetId)
+		state.EntityType = fwtypes.StringEnumValue(awstypes.EntityTypeAsset)
+		if !state.EntityRevision.IsNull() {
+			state.EntityRevision = fwflex.StringToFramework(ctx, item.Value.AssetRevision)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceListing) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan, state resourceListingData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.EntityRevision.Equal(state.EntityRevision) {
+		// Publishing a new entity revision creates a new listing revision.
+		if _, err := publishListing(ctx, conn, &plan); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameListing, plan.Id.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+		output, err := waitListingActive(ctx, conn, plan.DomainIdentifier.ValueString(), plan.Id.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForUpdate, ResNameListing, plan.Id.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(fwflex.Flatten(ctx, output, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.ListingRevision = state.ListingRevision
+		plan.Name = state.Name
+		plan.Status = state.Status
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceListing) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceListingData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateListingChangeSetInput{
+		Action:           awstypes.ChangeActionUnpublish,
+		ClientToken:      aws.String(id.UniqueId()),
+		DomainIdentifier: state.DomainIdentifier.ValueStringPointer(),
+		EntityIdentifier: state.EntityIdentifier.ValueStringPointer(),
+		EntityType:       state.EntityType.ValueEnum(),
+	}
+
+	_, err := conn.CreateListingChangeSet(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameListing, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitListingDeleted(ctx, conn, state.DomainIdentifier.ValueString(), state.Id.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForDeletion, ResNameListing, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceListing) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("resource import invalid ID", fmt.Sprintf(`Unexpected format for import ID (%s), use: "DomainIdentifier,Id"`, req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), parts[1])...)
+}
+
+func publishListing(ctx context.Context, conn *datazone.Client, data *resourceListingData) (*datazone.CreateListingChangeSetOutput, error) {
+	in := &datazone.CreateListingChangeSetInput{
+		Action:           awstypes.ChangeActionPublish,
+		ClientToken:      aws.String(id.UniqueId()),
+		DomainIdentifier: data.DomainIdentifier.ValueStringPointer(),
+		EntityIdentifier: data.EntityIdentifier.ValueStringPointer(),
+		EntityRevision:   data.EntityRevision.ValueStringPointer(),
+		EntityType:       data.EntityType.ValueEnum(),
+	}
+
+	out, err := conn.CreateListingChangeSet(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.ListingId == nil {
+		return nil, errors.New("empty output")
+	}
+
+	return out, nil
+}
+
+func waitListingActive(ctx context.Context, conn *datazone.Client, domainID, id string, timeout time.Duration) (*datazone.GetListingOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.ListingStatusCreating),
+		Target:                    enum.Slice(awstypes.ListingStatusActive),
+		Refresh:                   statusListing(ctx, conn, domainID, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*datazone.GetListingOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitListingDeleted(ctx context.Context, conn *datazone.Client, domainID, id string, timeout time.Duration) (*datazone.GetListingOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ListingStatusCreating, awstypes.ListingStatusActive),
+		Target:  []string{},
+		Refresh: statusListing(ctx, conn, domainID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*datazone.GetListingOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusListing(ctx context.Context, conn *datazone.Client, domainID, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findListingByID(ctx, conn, domainID, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func findListingByID(ctx context.Context, conn *datazone.Client, domainID, id string) (*datazone.GetListingOutput, error) {
+	in := &datazone.GetListingInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(id),
+	}
+
+	out, err := conn.GetListing(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	// An unpublished listing remains visible with an INACTIVE status.
+	if out.Status == awstypes.ListingStatusInactive {
+		return nil, &retry.NotFoundError{
+			Message:     string(out.Status),
+			LastRequest: in,
+		}
+	}
+
+	return out, nil
+}
+
+type resourceListingData struct {
+	DomainIdentifier types.String                               `tfsdk:"domain_identifier"`
+	EntityIdentifier types.String                               `tfsdk:"entity_identifier"`
+	EntityRevision   types.String                               `tfsdk:"entity_revision"`
+	EntityType       fwtypes.StringEnum[awstypes.EntityType]    `tfsdk:"entity_type"`
+	Id               types.String                               `tfsdk:"id"`
+	ListingRevision  types.String                               `tfsdk:"listing_revision"`
+	Name             types.String                               `tfsdk:"name"`
+	Status           fwtypes.StringEnum[awstypes.ListingStatus] `tfsdk:"status"`
+	Timeouts         timeouts.Value                             `tfsdk:"timeouts"`
+}
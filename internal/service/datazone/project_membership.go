@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_datazone_project_membership", name="Project Membership")
+func newResourceProjectMembership(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceProjectMembership{}, nil
+}
+
+const (
+	ResNameProjectMembership = "Project Membership"
+)
+
+type resourceProjectMembership struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+}
+
+func (r *resourceProjectMembership) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"designation": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UserDesignation](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_identifier": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("group_identifier"), path.MatchRoot("user_identifier")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_identifier": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceProjectMembership) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan resourceProjectMembershipData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateProjectMembershipInput{
+		Designation:       plan.Designation.ValueEnum(),
+		DomainIdentifier:  plan.DomainIdentifier.ValueStringPointer(),
+		Member:            plan.expandMember(),
+		ProjectIdentifier: plan.ProjectIdentifier.ValueStringPointer(),
+	}
+
+	_, err := conn.CreateProjectMembership(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameProjectMembership, plan.memberID(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceProjectMembership) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceProjectMembershipData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findProjectMembershipByThreePartKey(ctx, conn, state.DomainIdentifier.ValueString(), state.ProjectIdentifier.ValueString(), state.memberID())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameProjectMembership, state.memberID(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.Designation = fwtypes.StringEnumValue(out.Designation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceProjectMembership) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceProjectMembershipData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.DeleteProjectMembershipInput{
+		DomainIdentifier:  state.DomainIdentifier.ValueStringPointer(),
+		Member:            state.expandMember(),
+		ProjectIdentifier: state.ProjectIdentifier.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteProjectMembership(ctx, in)
+
+	if isResourceMissing(err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameProjectMembership, state.memberID(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceProjectMembership) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+
+	if len(parts) != 4 || (parts[2] != "USER" && parts[2] != "GROUP") {
+		resp.Diagnostics.AddError("resource import invalid ID", fmt.Sprintf(`Unexpected format for import ID (%s), use: "DomainIdentifier,ProjectIdentifier,USER|GROUP,MemberIdentifier"`, req.ID))
+		return
+	}
+
+	memberAttr := "user_identifier"
+	if parts[2] == "GROUP" {
+		memberAttr = "group_identifier"
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_identifier"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(memberAttr), parts[3])...)
+}
+
+func findProjectMembershipByThreePartKey(ctx context.Context, conn *datazone.Client, domainID, projectID, memberID string) (*awstypes.ProjectMember, error) {
+	in := &datazone.ListProjectMembershipsInput{
+		DomainIdentifier:  aws.String(domainID),
+		ProjectIdentifier: aws.String(projectID),
+	}
+
+	return findProjectMembership(ctx, conn, in, func(v *awstypes.ProjectMember) bool {
+		switch v := v.MemberDetails.(type) {
+		case *awstypes.MemberDetailsMemberUser:
+			return aws.ToString(v.Value.UserId) == memberID
+		case *awstypes.MemberDetailsMemberGroup:
+			return aws.ToString(v.Value.GroupId) == memberID
+		}
+
+		return false
+	})
+}
+
+func findProjectMembership(ctx context.Context, conn *datazone.Client, in *datazone.ListProjectMembershipsInput, filter tfslices.Predicate[*awstypes.ProjectMember]) (*awstypes.ProjectMember, error) {
+	out, err := findProjectMemberships(ctx, conn, in, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(out)
+}
+
+func findProjectMemberships(ctx context.Context, conn *datazone.Client, in *datazone.ListProjectMembershipsInput, filter tfslices.Predicate[*awstypes.ProjectMember]) ([]awstypes.ProjectMember, error) {
+	var out []awstypes.ProjectMember
+
+	pages := datazone.NewListProjectMembershipsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Members {
+			if filter(&v) {
+				out = append(out, v)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+type resourceProjectMembershipData struct {
+	Designation       fwtypes.StringEnum[awstypes.UserDesignation] `tfsdk:"designation"`
+	DomainIdentifier  types.String                                 `tfsdk:"domain_identifier"`
+	GroupIdentifier   types.String                                 `tfsdk:"group_identifier"`
+	ProjectIdentifier types.String                                 `tfsdk:"project_identifier"`
+	UserIdentifier    types.String                                 `tfsdk:"user_identifier"`
+}
+
+func (m *resourceProjectMembershipData) memberID() string {
+	if !m.GroupIdentifier.IsNull() {
+		return m.GroupIdentifier.ValueString()
+	}
+
+	return m.UserIdentifier.ValueString()
+}
+
+func (m *resourceProjectMembershipData) expandMember() awstypes.Member {
+	if !m.GroupIdentifier.IsNull() {
+		return &awstypes.MemberMemberGroupIdentifier{
+			Value: m.GroupIdentifier.ValueString(),
+		}
+	}
+
+	return &awstypes.MemberMemberUserIdentifier{
+		Value: m.UserIdentifier.ValueString(),
+	}
+}
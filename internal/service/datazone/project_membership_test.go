@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneProjectMembership_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var projectmember types.ProjectMember
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_project_membership.test"
+	userProfileName := "aws_datazone_user_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DataZoneEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectMembershipConfig_basic(rName, string(types.UserDesignationProjectContributor)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectMembershipExists(ctx, resourceName, &projectmember),
+					resource.TestCheckResourceAttr(resourceName, "designation", string(types.UserDesignationProjectContributor)),
+					resource.TestCheckNoResourceAttr(resourceName, "group_identifier"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_identifier", userProfileName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "user_identifier",
+				ImportStateIdFunc:                    testAccProjectMembershipImportStateIdFunc(resourceName),
+			},
+			{
+				Config: testAccProjectMembershipConfig_basic(rName, string(types.UserDesignationProjectCatalogViewer)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectMembershipExists(ctx, resourceName, &projectmember),
+					resource.TestCheckResourceAttr(resourceName, "designation", string(types.UserDesignationProjectCatalogViewer)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneProjectMembership_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var projectmember types.ProjectMember
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_project_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DataZoneEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectMembershipConfig_basic(rName, string(types.UserDesignationProjectContributor)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectMembershipExists(ctx, resourceName, &projectmember),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceProjectMembership, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProjectMembershipDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_project_membership" {
+				continue
+			}
+
+			_, err := tfdatazone.FindProjectMembershipByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["project_identifier"], testAccProjectMembershipMemberID(rs))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameProjectMembership, testAccProjectMembershipMemberID(rs), err)
+			}
+
+			return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameProjectMembership, testAccProjectMembershipMemberID(rs), errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProjectMembershipExists(ctx context.Context, name string, projectmember *types.ProjectMember) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameProjectMembership, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+		resp, err := tfdatazone.FindProjectMembershipByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["project_identifier"], testAccProjectMembershipMemberID(rs))
+
+		if err != nil {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameProjectMembership, testAccProjectMembershipMemberID(rs), err)
+		}
+
+		*projectmember = *resp
+
+		return nil
+	}
+}
+
+func testAccProjectMembershipMemberID(rs *terraform.ResourceState) string {
+	if v := rs.Primary.Attributes["group_identifier"]; v != "" {
+		return v
+	}
+
+	return rs.Primary.Attributes["user_identifier"]
+}
+
+func testAccProjectMembershipImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		memberType := "USER"
+		if rs.Primary.Attributes["group_identifier"] != "" {
+			memberType = "GROUP"
+		}
+
+		return strings.Join([]string{rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["project_identifier"], memberType, testAccProjectMembershipMemberID(rs)}, ","), nil
+	}
+}
+
+func testAccProjectMembershipConfig_basic(rName, designation string) string {
+	return acctest.ConfigCompose(testAccProjectConfig_basic(rName, rName), fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+  path = "/"
+}
+
+resource "aws_datazone_user_profile" "test" {
+  user_identifier   = aws_iam_user.test.arn
+  domain_identifier = aws_datazone_domain.test.id
+  user_type         = "IAM_USER"
+}
+
+resource "aws_datazone_project_membership" "test" {
+  designation        = %[2]q
+  domain_identifier  = aws_datazone_domain.test.id
+  project_identifier = aws_datazone_project.test.id
+  user_identifier    = aws_datazone_user_profile.test.id
+}
+`, rName, designation))
+}
@@ -26,11 +26,21 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newResourceAsset,
+			TypeName: "aws_datazone_asset",
+			Name:     "Asset",
+		},
 		{
 			Factory:  newResourceAssetType,
 			TypeName: "aws_datazone_asset_type",
 			Name:     "Asset Type",
 		},
+		{
+			Factory:  newResourceDataSource,
+			TypeName: "aws_datazone_data_source",
+			Name:     "Data Source",
+		},
 		{
 			Factory:  newResourceDomain,
 			TypeName: "aws_datazone_domain",
@@ -69,11 +79,36 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_datazone_glossary_term",
 			Name:     "Glossary Term",
 		},
+		{
+			Factory:  newResourceListing,
+			TypeName: "aws_datazone_listing",
+			Name:     "Listing",
+		},
 		{
 			Factory:  newResourceProject,
 			TypeName: "aws_datazone_project",
 			Name:     "Project",
 		},
+		{
+			Factory:  newResourceProjectMembership,
+			TypeName: "aws_datazone_project_membership",
+			Name:     "Project Membership",
+		},
+		{
+			Factory:  newResourceSubscriptionGrant,
+			TypeName: "aws_datazone_subscription_grant",
+			Name:     "Subscription Grant",
+		},
+		{
+			Factory:  newResourceSubscriptionRequest,
+			TypeName: "aws_datazone_subscription_request",
+			Name:     "Subscription Request",
+		},
+		{
+			Factory:  newResourceSubscriptionTarget,
+			TypeName: "aws_datazone_subscription_target",
+			Name:     "Subscription Target",
+		},
 		{
 			Factory:  newResourceUserProfile,
 			TypeName: "aws_datazone_user_profile",
@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_datazone_subscription_grant", name="Subscription Grant")
+func newResourceSubscriptionGrant(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceSubscriptionGrant{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameSubscriptionGrant = "Subscription Grant"
+)
+
+type resourceSubscriptionGrant struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (r *resourceSubscriptionGrant) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"listing_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"listing_revision": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SubscriptionGrantOverallStatus](),
+				Computed:   true,
+			},
+			"subscription_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscription_target_identifier": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceSubscriptionGrant) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan resourceSubscriptionGrantData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateSubscriptionGrantInput{
+		ClientToken:           aws.String(id.UniqueId()),
+		DomainIdentifier:      plan.DomainIdentifier.ValueStringPointer(),
+		EnvironmentIdentifier: plan.EnvironmentIdentifier.ValueStringPointer(),
+		GrantedEntity: &awstypes.GrantedEntityInputMemberListing{
+			Value: awstypes.ListingRevisionInput{
+				Identifier: plan.ListingIdentifier.ValueStringPointer(),
+				Revision:   plan.ListingRevision.ValueStringPointer(),
+			},
+		},
+		SubscriptionTargetIdentifier: fwflex.StringFromFramework(ctx, plan.SubscriptionTargetIdentifier),
+	}
+
+	out, err := conn.CreateSubscriptionGrant(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameSubscriptionGrant, plan.ListingIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if out == nil || out.Id == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameSubscriptionGrant, plan.ListingIdentifier.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), out.Id)...)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	grant, err := waitSubscriptionGrantCompleted(ctx, conn, aws.ToString(out.DomainId), aws.ToString(out.Id), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForCreation, ResNameSubscriptionGrant, plan.ListingIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.flatten(ctx, grant)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceSubscriptionGrant) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceSubscriptionGrantData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findSubscriptionGrantByID(ctx, conn, state.DomainIdentifier.ValueString(), state.Id.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameSubscriptionGrant, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.flatten(ctx, out)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceSubscriptionGrant) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceSubscriptionGrantData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.DeleteSubscriptionGrantInput{
+		DomainIdentifier: state.DomainIdentifier.ValueStringPointer(),
+		Identifier:       state.Id.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteSubscriptionGrant(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameSubscriptionGrant, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	if _, err := waitSubscriptionGrantDeleted(ctx, conn, state.DomainIdentifier.ValueString(), state.Id.ValueString(), deleteTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForDeletion, ResNameSubscriptionGrant, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceSubscriptionGrant) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("resource import invalid ID", fmt.Sprintf(`Unexpected format for import ID (%s), use: "DomainIdentifier,EnvironmentIdentifier,Id"`, req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_identifier"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), parts[2])...)
+}
+
+func waitSubscriptionGrantCompleted(ctx context.Context, conn *datazone.Client, domainID, id string, timeout time.Duration) (*datazone.GetSubscriptionGrantOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SubscriptionGrantOverallStatusPending, awstypes.SubscriptionGrantOverallStatusInProgress),
+		Target:  enum.Slice(awstypes.SubscriptionGrantOverallStatusCompleted),
+		Refresh: statusSubscriptionGrant(ctx, conn, domainID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*datazone.GetSubscriptionGrantOutput); ok {
+		tfresource.SetLastError(err, subscribedAssetsError(out.Assets))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriptionGrantDeleted(ctx context.Context, conn *datazone.Client, domainID, id string, timeout time.Duration) (*datazone.GetSubscriptionGrantOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.SubscriptionGrantOverallStatusPending, awstypes.SubscriptionGrantOverallStatusInProgress, awstypes.SubscriptionGrantOverallStatusCompleted),
+		Target:  []string{},
+		Refresh: statusSubscriptionGrant(ctx, conn, domainID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*datazone.GetSubscriptionGrantOutput); ok {
+		tfresource.SetLastError(err, subscribedAssetsError(out.Assets))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusSubscriptionGrant(ctx context.Context, conn *datazone.Client, domainID, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findSubscriptionGrantByID(ctx, conn, domainID, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func findSubscriptionGrantByID(ctx context.Context, conn *datazone.Client, domainID, id string) (*datazone.GetSubscriptionGrantOutput, error) {
+	in := &datazone.GetSubscriptionGrantInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(id),
+	}
+
+	out, err := conn.GetSubscriptionGrant(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func subscribedAssetsError(apiObjects []awstypes.SubscribedAsset) error {
+	var causes []error
+
+	for _, apiObject := range apiObjects {
+		if v := apiObject.FailureCause; v != nil {
+			causes = append(causes, fmt.Errorf("%s: %s", aws.ToString(apiObject.AssetId), aws.ToString(v.Message)))
+		}
+	}
+
+	return errors.Join(causes...)
+}
+
+type resourceSubscriptionGrantData struct {
+	CreatedAt                    timetypes.RFC3339                                           `tfsdk:"created_at"`
+	DomainIdentifier             types.String                                                `tfsdk:"domain_identifier"`
+	EnvironmentIdentifier        types.String                                                `tfsdk:"environment_identifier"`
+	Id                           types.String                                                `tfsdk:"id"`
+	ListingIdentifier            types.String                                                `tfsdk:"listing_identifier"`
+	ListingRevision              types.String                                                `tfsdk:"listing_revision"`
+	Status                       fwtypes.StringEnum[awstypes.SubscriptionGrantOverallStatus] `tfsdk:"status"`
+	SubscriptionId               types.String                                                `tfsdk:"subscription_id"`
+	SubscriptionTargetIdentifier types.String                                                `tfsdk:"subscription_target_identifier"`
+	Timeouts                     timeouts.Value                                              `tfsdk:"timeouts"`
+}
+
+func (m *resourceSubscriptionGrantData) flatten(ctx context.Context, out *datazone.GetSubscriptionGrantOutput) {
+	m.CreatedAt = timetypes.NewRFC3339TimePointerValue(out.CreatedAt)
+	m.DomainIdentifier = fwflex.StringToFramework(ctx, out.DomainId)
+	m.Id = fwflex.StringToFramework(ctx, out.Id)
+	m.Status = fwtypes.StringEnumValue(out.Status)
+	m.SubscriptionId = fwflex.StringToFramework(ctx, out.SubscriptionId)
+	m.SubscriptionTargetIdentifier = fwflex.StringToFramework(ctx, out.SubscriptionTargetId)
+
+	if v, ok := out.GrantedEntity.(*awstypes.GrantedEntityMemberListing); ok {
+		m.ListingIdentifier = fwflex.StringToFramework(ctx, v.Value.Id)
+		m.ListingRevision = fwflex.StringToFramework(ctx, v.Value.Revision)
+	}
+}
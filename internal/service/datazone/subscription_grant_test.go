@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	"github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccSubscriptionGrant_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var subscriptiongrant datazone.GetSubscriptionGrantOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_subscription_grant.test"
+	listingName := "aws_datazone_listing.test"
+	subscriptionTargetName := "aws_datazone_subscription_target.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DataZoneEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriptionGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionGrantConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionGrantExists(ctx, resourceName, &subscriptiongrant),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrPair(resourceName, "listing_identifier", listingName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "listing_revision", listingName, "listing_revision"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.SubscriptionGrantOverallStatusCompleted)),
+					resource.TestCheckResourceAttrPair(resourceName, "subscription_target_identifier", subscriptionTargetName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccSubscriptionGrantImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccSubscriptionGrant_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var subscriptiongrant datazone.GetSubscriptionGrantOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_subscription_grant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DataZoneEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriptionGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionGrantConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionGrantExists(ctx, resourceName, &subscriptiongrant),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceSubscriptionGrant, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSubscriptionGrantDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_subscription_grant" {
+				continue
+			}
+
+			_, err := tfdatazone.FindSubscriptionGrantByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameSubscriptionGrant, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameSubscriptionGrant, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSubscriptionGrantExists(ctx context.Context, name string, subscriptiongrant *datazone.GetSubscriptionGrantOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameSubscriptionGrant, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameSubscriptionGrant, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+		resp, err := tfdatazone.FindSubscriptionGrantByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameSubscriptionGrant, rs.Primary.ID, err)
+		}
+
+		*subscriptiongrant = *resp
+
+		return nil
+	}
+}
+
+func testAccSubscriptionGrantImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return strings.Join([]string{rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["environment_identifier"], rs.Primary.ID}, ","), nil
+	}
+}
+
+func testAccSubscriptionGrantConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSubscriptionTargetConfig_basic(rName, rName), fmt.Sprintf(`
+resource "aws_datazone_asset_type" "test" {
+  domain_identifier         = aws_datazone_domain.test.id
+  name                      = replace(%[1]q, "-", "_")
+  owning_project_identifier = aws_datazone_project.test.id
+}
+
+resource "aws_datazone_asset" "test" {
+  domain_identifier         = aws_datazone_domain.test.id
+  name                      = %[1]q
+  owning_project_identifier = aws_datazone_project.test.id
+  type_identifier           = aws_datazone_asset_type.test.name
+}
+
+resource "aws_datazone_listing" "test" {
+  domain_identifier = aws_datazone_domain.test.id
+  entity_identifier = aws_datazone_asset.test.id
+}
+
+resource "aws_datazone_subscription_grant" "test" {
+  domain_identifier              = aws_datazone_domain.test.id
+  environment_identifier         = aws_datazone_environment.test.id
+  listing_identifier             = aws_datazone_listing.test.id
+  listing_revision               = aws_datazone_listing.test.listing_revision
+  subscription_target_identifier = aws_datazone_subscription_target.test.id
+}
+`, rName))
+}
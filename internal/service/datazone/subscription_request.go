@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_datazone_subscription_request", name="Subscription Request")
+func newResourceSubscriptionRequest(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSubscriptionRequest{}, nil
+}
+
+const (
+	ResNameSubscriptionRequest = "Subscription Request"
+)
+
+type resourceSubscriptionRequest struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+}
+
+func (r *resourceSubscriptionRequest) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"project_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"request_reason": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 4096),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SubscriptionRequestStatus](),
+				Computed:   true,
+			},
+			"subscribed_listings": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSubscriptionRequest) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan resourceSubscriptionRequestData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateSubscriptionRequestInput{
+		ClientToken:      aws.String(id.UniqueId()),
+		DomainIdentifier: plan.DomainIdentifier.ValueStringPointer(),
+		RequestReason:    plan.RequestReason.ValueStringPointer(),
+		SubscribedPrincipals: []awstypes.SubscribedPrincipalInput{
+			&awstypes.SubscribedPrincipalInputMemberProject{
+				Value: awstypes.SubscribedProjectInput{
+					Identifier: plan.ProjectIdentifier.ValueStringPointer(),
+				},
+			},
+		},
+	}
+
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, plan.SubscribedListings) {
+		in.SubscribedListings = append(in.SubscribedListings, awstypes.SubscribedListingInput{
+			Identifier: aws.String(v),
+		})
+	}
+
+	out, err := conn.CreateSubscriptionRequest(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameSubscriptionRequest, plan.ProjectIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if out == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameSubscriptionRequest, plan.ProjectIdentifier.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.CreatedAt = timetypes.NewRFC3339TimePointerValue(out.CreatedAt)
+	plan.Id = fwflex.StringToFramework(ctx, out.Id)
+	plan.Status = fwtypes.StringEnumValue(out.Status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceSubscriptionRequest) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceSubscriptionRequestData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findSubscriptionRequestByID(ctx, conn, state.DomainIdentifier.ValueString(), state.Id.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameSubscriptionRequest, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.CreatedAt = timetypes.NewRFC3339TimePointerValue(out.CreatedAt)
+	state.DomainIdentifier = fwflex.StringToFramework(ctx, out.DomainId)
+	state.RequestReason = fwflex.StringToFramework(ctx, out.RequestReason)
+	state.Status = fwtypes.StringEnumValue(out.Status)
+	state.SubscribedListings = fwflex.FlattenFrameworkStringValueListOfString(ctx, tfslices.ApplyToAll(out.SubscribedListings, func(v awstypes.SubscribedListing) string {
+		return aws.ToString(v.Id)
+	}))
+
+	for _, v := range out.SubscribedPrincipals {
+		if v, ok := v.(*awstypes.SubscribedPrincipalMemberProject); ok {
+			state.ProjectIdentifier = fwflex.StringToFramework(ctx, v.Value.Id)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceSubscriptionRequest) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceSubscriptionRequestData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.DeleteSubscriptionRequestInput{
+		DomainIdentifier: state.DomainIdentifier.ValueStringPointer(),
+		Identifier:       state.Id.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteSubscriptionRequest(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameSubscriptionRequest, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceSubscriptionRequest) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("resource import invalid ID", fmt.Sprintf(`Unexpected format for import ID (%s), use: "DomainIdentifier,Id"`, req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), parts[1])...)
+}
+
+func findSubscriptionRequestByID(ctx context.Context, conn *datazone.Client, domainID, id string) (*datazone.GetSubscriptionRequestDetailsOutput, error) {
+	in := &datazone.GetSubscriptionRequestDetailsInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(id),
+	}
+
+	out, err := conn.GetSubscriptionRequestDetails(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceSubscriptionRequestData struct {
+	CreatedAt          timetypes.RFC3339                                      `tfsdk:"created_at"`
+	DomainIdentifier   types.String                                           `tfsdk:"domain_identifier"`
+	Id                 types.String                                           `tfsdk:"id"`
+	ProjectIdentifier  types.String                                           `tfsdk:"project_identifier"`
+	RequestReason      types.String                                           `tfsdk:"request_reason"`
+	Status             fwtypes.StringEnum[awstypes.SubscriptionRequestStatus] `tfsdk:"status"`
+	SubscribedListings fwtypes.ListValueOf[types.String]                      `tfsdk:"subscribed_listings"`
+}
@@ -0,0 +1,361 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_datazone_subscription_target", name="Subscription Target")
+func newResourceSubscriptionTarget(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceSubscriptionTarget{}, nil
+}
+
+const (
+	ResNameSubscriptionTarget = "Subscription Target"
+)
+
+type resourceSubscriptionTarget struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceSubscriptionTarget) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"applicable_asset_types": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"authorized_principals": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 20),
+				},
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"manage_access_role": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// "provider" is a reserved Terraform argument name.
+			"provider_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrType: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"subscription_target_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subscriptionTargetFormData](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrContent: schema.StringAttribute{
+							Required: true,
+						},
+						"form_name": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSubscriptionTarget) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan resourceSubscriptionTargetData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateSubscriptionTargetInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, &plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	in.ClientToken = aws.String(id.UniqueId())
+	in.Provider = plan.ProviderName.ValueStringPointer()
+
+	out, err := conn.CreateSubscriptionTarget(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameSubscriptionTarget, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if out == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameSubscriptionTarget, plan.Name.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.flatten(ctx, out, out.DomainId, out.EnvironmentId, out.Provider)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceSubscriptionTarget) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceSubscriptionTargetData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findSubscriptionTargetByID(ctx, conn, state.DomainIdentifier.ValueString(), state.EnvironmentIdentifier.ValueString(), state.Id.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameSubscriptionTarget, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.flatten(ctx, out, out.DomainId, out.EnvironmentId, out.Provider)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceSubscriptionTarget) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan, state resourceSubscriptionTargetData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ApplicableAssetTypes.Equal(state.ApplicableAssetTypes) ||
+		!plan.AuthorizedPrincipals.Equal(state.AuthorizedPrincipals) ||
+		!plan.ManageAccessRole.Equal(state.ManageAccessRole) ||
+		!plan.Name.Equal(state.Name) ||
+		!plan.ProviderName.Equal(state.ProviderName) ||
+		!plan.SubscriptionTargetConfig.Equal(state.SubscriptionTargetConfig) {
+		in := &datazone.UpdateSubscriptionTargetInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, &plan, in)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		in.Identifier = plan.Id.ValueStringPointer()
+		in.Provider = plan.ProviderName.ValueStringPointer()
+
+		out, err := conn.UpdateSubscriptionTarget(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameSubscriptionTarget, plan.Id.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(plan.flatten(ctx, out, out.DomainId, out.EnvironmentId, out.Provider)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceSubscriptionTarget) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state resourceSubscriptionTargetData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.DeleteSubscriptionTargetInput{
+		DomainIdentifier:      state.DomainIdentifier.ValueStringPointer(),
+		EnvironmentIdentifier: state.EnvironmentIdentifier.ValueStringPointer(),
+		Identifier:            state.Id.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteSubscriptionTarget(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameSubscriptionTarget, state.Id.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceSubscriptionTarget) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("resource import invalid ID", fmt.Sprintf(`Unexpected format for import ID (%s), use: "DomainIdentifier,EnvironmentIdentifier,Id"`, req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_identifier"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), parts[2])...)
+}
+
+func findSubscriptionTargetByID(ctx context.Context, conn *datazone.Client, domainID, environmentID, id string) (*datazone.GetSubscriptionTargetOutput, error) {
+	in := &datazone.GetSubscriptionTargetInput{
+		DomainIdentifier:      aws.String(domainID),
+		EnvironmentIdentifier: aws.String(environmentID),
+		Identifier:            aws.String(id),
+	}
+
+	out, err := conn.GetSubscriptionTarget(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceSubscriptionTargetData struct {
+	ApplicableAssetTypes     fwtypes.ListValueOf[types.String]                           `tfsdk:"applicable_asset_types"`
+	AuthorizedPrincipals     fwtypes.ListValueOf[types.String]                           `tfsdk:"authorized_principals"`
+	CreatedAt                timetypes.RFC3339                                           `tfsdk:"created_at"`
+	CreatedBy                types.String                                                `tfsdk:"created_by"`
+	DomainIdentifier         types.String                                                `tfsdk:"domain_identifier"`
+	EnvironmentIdentifier    types.String                                                `tfsdk:"environment_identifier"`
+	Id                       types.String                                                `tfsdk:"id"`
+	ManageAccessRole         fwtypes.ARN                                                 `tfsdk:"manage_access_role"`
+	Name                     types.String                                                `tfsdk:"name"`
+	ProjectId                types.String                                                `tfsdk:"project_id"`
+	ProviderName             types.String                                                `tfsdk:"provider_name"`
+	SubscriptionTargetConfig fwtypes.ListNestedObjectValueOf[subscriptionTargetFormData] `tfsdk:"subscription_target_config"`
+	Type                     types.String                                                `tfsdk:"type"`
+}
+
+func (m *resourceSubscriptionTargetData) flatten(ctx context.Context, out any, domainID, environmentID, provider *string) (diags diag.Diagnostics) {
+	diags.Append(fwflex.Flatten(ctx, out, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.DomainIdentifier = fwflex.StringToFramework(ctx, domainID)
+	m.EnvironmentIdentifier = fwflex.StringToFramework(ctx, environmentID)
+	m.ProviderName = fwflex.StringToFramework(ctx, provider)
+
+	return diags
+}
+
+type subscriptionTargetFormData struct {
+	Content  types.String `tfsdk:"content"`
+	FormName types.String `tfsdk:"form_name"`
+}
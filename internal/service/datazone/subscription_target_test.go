@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccSubscriptionTarget_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var subscriptiontarget datazone.GetSubscriptionTargetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := fmt.Sprintf("%s-updated", rName)
+	resourceName := "aws_datazone_subscription_target.test"
+	environmentName := "aws_datazone_environment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DataZoneEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriptionTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionTargetConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionTargetExists(ctx, resourceName, &subscriptiontarget),
+					resource.TestCheckResourceAttr(resourceName, "applicable_asset_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "applicable_asset_types.0", "GlueTableAssetType"),
+					resource.TestCheckResourceAttr(resourceName, "authorized_principals.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_identifier", environmentName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "aws_datazone_project.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "provider_name", "Amazon DataZone"),
+					resource.TestCheckResourceAttr(resourceName, "subscription_target_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "subscription_target_config.0.form_name", "GlueSubscriptionTargetConfigForm"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "GlueSubscriptionTargetType"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccSubscriptionTargetImportStateIdFunc(resourceName),
+			},
+			{
+				Config: testAccSubscriptionTargetConfig_basic(rName, rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionTargetExists(ctx, resourceName, &subscriptiontarget),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubscriptionTarget_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var subscriptiontarget datazone.GetSubscriptionTargetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_subscription_target.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.DataZoneEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriptionTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionTargetConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionTargetExists(ctx, resourceName, &subscriptiontarget),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceSubscriptionTarget, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSubscriptionTargetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_subscription_target" {
+				continue
+			}
+
+			_, err := tfdatazone.FindSubscriptionTargetByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["environment_identifier"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameSubscriptionTarget, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.DataZone, create.ErrActionCheckingDestroyed, tfdatazone.ResNameSubscriptionTarget, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSubscriptionTargetExists(ctx context.Context, name string, subscriptiontarget *datazone.GetSubscriptionTargetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameSubscriptionTarget, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameSubscriptionTarget, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+		resp, err := tfdatazone.FindSubscriptionTargetByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["environment_identifier"], rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.DataZone, create.ErrActionCheckingExistence, tfdatazone.ResNameSubscriptionTarget, rs.Primary.ID, err)
+		}
+
+		*subscriptiontarget = *resp
+
+		return nil
+	}
+}
+
+func testAccSubscriptionTargetImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return strings.Join([]string{rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["environment_identifier"], rs.Primary.ID}, ","), nil
+	}
+}
+
+func testAccSubscriptionTargetConfig_basic(rName, targetName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_basic(rName), fmt.Sprintf(`
+resource "aws_datazone_subscription_target" "test" {
+  applicable_asset_types = ["GlueTableAssetType"]
+  authorized_principals  = [aws_iam_role.test.arn]
+  domain_identifier      = aws_datazone_domain.test.id
+  environment_identifier = aws_datazone_environment.test.id
+  manage_access_role     = aws_iam_role.test.arn
+  name                   = %[2]q
+  provider_name          = "Amazon DataZone"
+  type                   = "GlueSubscriptionTargetType"
+
+  subscription_target_config {
+    form_name = "GlueSubscriptionTargetConfigForm"
+    content = jsonencode({
+      databaseName = "%[1]s-consumer"
+    })
+  }
+}
+`, rName, targetName))
+}
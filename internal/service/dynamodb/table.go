@@ -47,6 +47,7 @@ const (
 
 // @SDKResource("aws_dynamodb_table", name="Table")
 // @Tags(identifierAttribute="arn")
+// @ARNLookup(service="dynamodb", resourceType="table", finder="lookupTableByARN")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/dynamodb/types;types.TableDescription")
 func resourceTable() *schema.Resource {
 	//lintignore:R011
@@ -2649,3 +2650,15 @@ func ttlPlantimeValidate(ttlPath cty.Path, ttl cty.Value, diags *diag.Diagnostic
 	// AWS *requires* attribute_name to be set when disabling TTL but does not return it, causing a diff.
 	// The diff is handled by DiffSuppressFunc of attribute_name.
 }
+
+// lookupTableByARN finds the DynamoDB table with the specified ARN for lookup-by-ARN.
+func lookupTableByARN(ctx context.Context, meta any, arn string) error {
+	name, err := tableNameFromARN(arn)
+	if err != nil {
+		return err
+	}
+
+	_, err = findTableByName(ctx, meta.(*conns.AWSClient).DynamoDBClient(ctx), name)
+
+	return err
+}
@@ -5,6 +5,7 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -29,6 +30,7 @@ func resourceTableExport() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceTableExportCreate,
 		ReadWithoutTimeout:   resourceTableExportRead,
+		UpdateWithoutTimeout: resourceTableExportUpdate,
 		DeleteWithoutTimeout: schema.NoopContext,
 
 		Importer: &schema.ResourceImporter{
@@ -37,9 +39,12 @@ func resourceTableExport() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		CustomizeDiff: resourceTableExportCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -109,6 +114,14 @@ func resourceTableExport() *schema.Resource {
 					},
 				},
 			},
+			"failure_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"item_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -159,6 +172,11 @@ func resourceTableExport() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 		},
 	}
 }
@@ -215,8 +233,10 @@ func resourceTableExportCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(aws.ToString(output.ExportDescription.ExportArn))
 
-	if _, err := waitTableExportCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for DynamoDB Table Export (%s) create: %s", d.Id(), err)
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waitTableExportCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for DynamoDB Table Export (%s) create: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceTableExportRead(ctx, d, meta)...)
@@ -249,6 +269,8 @@ func resourceTableExportRead(ctx context.Context, d *schema.ResourceData, meta i
 		d.Set("export_time", aws.ToTime(desc.ExportTime).Format(time.RFC3339))
 	}
 	d.Set("export_type", desc.ExportType)
+	d.Set("failure_code", desc.FailureCode)
+	d.Set("failure_message", desc.FailureMessage)
 	d.Set("incremental_export_specification", flattenIncrementalExportSpecification(desc.IncrementalExportSpecification))
 	d.Set("item_count", desc.ItemCount)
 	d.Set("manifest_files_s3_key", desc.ExportManifest)
@@ -265,6 +287,49 @@ func resourceTableExportRead(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+func resourceTableExportUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	// Only wait_for_completion can be updated. Waiting for an export that has already completed returns immediately.
+	if d.HasChange("wait_for_completion") && d.Get("wait_for_completion").(bool) {
+		if _, err := waitTableExportCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for DynamoDB Table Export (%s) create: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTableExportRead(ctx, d, meta)...)
+}
+
+func resourceTableExportCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" {
+		// Waiting for an export in progress changes the attributes describing its progress.
+		if diff.HasChange("wait_for_completion") && diff.Get("wait_for_completion").(bool) && diff.Get("export_status").(string) == string(awstypes.ExportStatusInProgress) {
+			for _, key := range []string{"billed_size_in_bytes", "end_time", "export_status", "failure_code", "failure_message", "item_count", "manifest_files_s3_key"} {
+				if err := diff.SetNewComputed(key); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	exportType := awstypes.ExportType(diff.Get("export_type").(string))
+	incrementalExportSpecification := len(diff.Get("incremental_export_specification").([]interface{})) > 0
+
+	switch {
+	case exportType == awstypes.ExportTypeIncrementalExport && !incrementalExportSpecification:
+		return fmt.Errorf(`"incremental_export_specification" is required when "export_type" is %q`, exportType)
+	case exportType != awstypes.ExportTypeIncrementalExport && incrementalExportSpecification:
+		return fmt.Errorf(`"incremental_export_specification" requires "export_type" to be %q`, awstypes.ExportTypeIncrementalExport)
+	case exportType == awstypes.ExportTypeIncrementalExport && diff.Get("export_time").(string) != "":
+		return fmt.Errorf(`"export_time" cannot be specified when "export_type" is %q`, exportType)
+	}
+
+	return nil
+}
+
 func expandIncrementalExportSpecification(d interface{}) *awstypes.IncrementalExportSpecification {
 	if d.([]interface{}) == nil || len(d.([]interface{})) == 0 {
 		return nil
@@ -320,13 +385,17 @@ func findTableExportByARN(ctx context.Context, conn *dynamodb.Client, arn string
 
 	output, err := conn.DescribeExport(ctx, input)
 
-	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+	if errs.IsA[*awstypes.ExportNotFoundException](err) || errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
 		}
 	}
 
+	if err != nil {
+		return nil, err
+	}
+
 	if output == nil || output.ExportDescription == nil {
 		return nil, tfresource.NewEmptyResultError(input)
 	}
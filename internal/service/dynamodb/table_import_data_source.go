@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_dynamodb_table_import", name="Table Import")
+func dataSourceTableImport() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTableImportRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCloudWatchLogGroupARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"failure_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"failure_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"import_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"import_arn", "table_arn"},
+			},
+			"import_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"imported_item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"input_compression_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"input_format": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"processed_item_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"processed_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"s3_bucket_source": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucket: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bucket_owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrStartTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTableImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	importARN := d.Get("import_arn").(string)
+
+	if v, ok := d.GetOk("table_arn"); ok && importARN == "" {
+		tableARN := v.(string)
+		summary, err := findLatestImportSummaryByTableARN(ctx, conn, tableARN)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("DynamoDB Table Import", err))
+		}
+
+		importARN = aws.ToString(summary.ImportArn)
+	}
+
+	desc, err := findImportByARN(ctx, conn, importARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table Import (%s): %s", importARN, err)
+	}
+
+	d.SetId(aws.ToString(desc.ImportArn))
+	d.Set(names.AttrCloudWatchLogGroupARN, desc.CloudWatchLogGroupArn)
+	if desc.EndTime != nil {
+		d.Set("end_time", aws.ToTime(desc.EndTime).Format(time.RFC3339))
+	}
+	d.Set("error_count", desc.ErrorCount)
+	d.Set("failure_code", desc.FailureCode)
+	d.Set("failure_message", desc.FailureMessage)
+	d.Set("import_arn", desc.ImportArn)
+	d.Set("import_status", desc.ImportStatus)
+	d.Set("imported_item_count", desc.ImportedItemCount)
+	d.Set("input_compression_type", desc.InputCompressionType)
+	d.Set("input_format", desc.InputFormat)
+	d.Set("processed_item_count", desc.ProcessedItemCount)
+	d.Set("processed_size_in_bytes", desc.ProcessedSizeBytes)
+	if err := d.Set("s3_bucket_source", flattenS3BucketSource(desc.S3BucketSource)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting s3_bucket_source: %s", err)
+	}
+	if desc.StartTime != nil {
+		d.Set(names.AttrStartTime, aws.ToTime(desc.StartTime).Format(time.RFC3339))
+	}
+	d.Set("table_arn", desc.TableArn)
+	d.Set("table_id", desc.TableId)
+
+	return diags
+}
+
+// findLatestImportSummaryByTableARN returns the most recently started import into the specified table.
+func findLatestImportSummaryByTableARN(ctx context.Context, conn *dynamodb.Client, tableARN string) (*awstypes.ImportSummary, error) {
+	input := &dynamodb.ListImportsInput{
+		TableArn: aws.String(tableARN),
+	}
+	var output *awstypes.ImportSummary
+
+	for {
+		page, err := conn.ListImports(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ImportSummaryList {
+			if output == nil || aws.ToTime(v.StartTime).After(aws.ToTime(output.StartTime)) {
+				output = &v
+			}
+		}
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenS3BucketSource(apiObject *awstypes.S3BucketSource) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrBucket: aws.ToString(apiObject.S3Bucket),
+		"bucket_owner":   aws.ToString(apiObject.S3BucketOwner),
+		"key_prefix":     aws.ToString(apiObject.S3KeyPrefix),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDynamoDBTableImportDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_dynamodb_table_import.test"
+	dataSourceByARNName := "data.aws_dynamodb_table_import.by_arn"
+	tableResourceName := "aws_dynamodb_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableImportDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "import_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "import_status", "COMPLETED"),
+					resource.TestCheckResourceAttr(dataSourceName, "imported_item_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "input_compression_type", "NONE"),
+					resource.TestCheckResourceAttr(dataSourceName, "input_format", "DYNAMODB_JSON"),
+					resource.TestCheckResourceAttr(dataSourceName, "s3_bucket_source.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "s3_bucket_source.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(dataSourceName, "s3_bucket_source.0.key_prefix", "data"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStartTime),
+					resource.TestCheckResourceAttrPair(dataSourceName, "table_arn", tableResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceByARNName, "import_arn", dataSourceName, "import_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceByARNName, "import_status", dataSourceName, "import_status"),
+					resource.TestCheckResourceAttrPair(dataSourceByARNName, "table_arn", dataSourceName, "table_arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTableImportDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTableConfig_import(rName), `
+data "aws_dynamodb_table_import" "test" {
+  table_arn = aws_dynamodb_table.test.arn
+}
+
+data "aws_dynamodb_table_import" "by_arn" {
+  import_arn = data.aws_dynamodb_table_import.test.import_arn
+}
+`)
+}
@@ -0,0 +1,442 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// BatchGetItem accepts at most 100 keys.
+	batchGetItemMaxKeys = 100
+	// BatchWriteItem accepts at most 25 put or delete requests.
+	batchWriteItemMaxRequests = 25
+)
+
+// @SDKResource("aws_dynamodb_table_items", name="Table Items")
+func resourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableItemsCreate,
+		ReadWithoutTimeout:   resourceTableItemsRead,
+		UpdateWithoutTimeout: resourceTableItemsUpdate,
+		DeleteWithoutTimeout: resourceTableItemsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceTableItemsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"items": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+			},
+			names.AttrTableName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTableItemsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+	items, err := expandTableItems(d.Get("items").(map[string]interface{}), hashKey, rangeKey)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	var requests []awstypes.WriteRequest
+	for _, v := range items {
+		requests = append(requests, awstypes.WriteRequest{
+			PutRequest: &awstypes.PutRequest{
+				Item: v.attributes,
+			},
+		})
+	}
+
+	if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	d.SetId(tableItemsCreateResourceID(tableName, hashKey, rangeKey))
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+	items, err := expandTableItems(d.Get("items").(map[string]interface{}), hashKey, rangeKey)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	keys := make([]map[string]awstypes.AttributeValue, 0, len(items))
+	for _, v := range items {
+		keys = append(keys, expandTableItemQueryKey(v.attributes, hashKey, rangeKey))
+	}
+
+	output, err := findTableItemsByKeys(ctx, conn, tableName, keys)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table Items (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	found := make(map[string]map[string]awstypes.AttributeValue, len(output))
+	for _, v := range output {
+		found[tableItemsKey(v, hashKey, rangeKey)] = v
+	}
+
+	// Items deleted out of band are removed from state and will be recreated.
+	tfMap := make(map[string]interface{}, len(items))
+	for _, v := range items {
+		item, ok := found[v.key]
+		if !ok {
+			continue
+		}
+
+		// The record exists, now test if it differs from what is desired.
+		if reflect.DeepEqual(item, v.attributes) {
+			tfMap[v.key] = v.json
+			continue
+		}
+
+		itemAttrs, err := flattenTableItemAttributes(item)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		tfMap[v.key] = itemAttrs
+	}
+
+	if err := d.Set("items", tfMap); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting items: %s", err)
+	}
+
+	return diags
+}
+
+func resourceTableItemsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	if d.HasChange("items") {
+		tableName := d.Get(names.AttrTableName).(string)
+		hashKey := d.Get("hash_key").(string)
+		rangeKey := d.Get("range_key").(string)
+
+		o, n := d.GetChange("items")
+		oldItems, err := expandTableItems(o.(map[string]interface{}), hashKey, rangeKey)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		newItems, err := expandTableItems(n.(map[string]interface{}), hashKey, rangeKey)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		old := make(map[string]tableItem, len(oldItems))
+		for _, v := range oldItems {
+			old[v.key] = v
+		}
+
+		var requests []awstypes.WriteRequest
+		for _, v := range newItems {
+			// Put replaces the whole item, so attributes removed from the item are deleted.
+			if item, ok := old[v.key]; !ok || !reflect.DeepEqual(item.attributes, v.attributes) {
+				requests = append(requests, awstypes.WriteRequest{
+					PutRequest: &awstypes.PutRequest{
+						Item: v.attributes,
+					},
+				})
+			}
+			delete(old, v.key)
+		}
+
+		for _, v := range old {
+			requests = append(requests, awstypes.WriteRequest{
+				DeleteRequest: &awstypes.DeleteRequest{
+					Key: expandTableItemQueryKey(v.attributes, hashKey, rangeKey),
+				},
+			})
+		}
+
+		if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table Items (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+	items, err := expandTableItems(d.Get("items").(map[string]interface{}), hashKey, rangeKey)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	var requests []awstypes.WriteRequest
+	for _, v := range items {
+		requests = append(requests, awstypes.WriteRequest{
+			DeleteRequest: &awstypes.DeleteRequest{
+				Key: expandTableItemQueryKey(v.attributes, hashKey, rangeKey),
+			},
+		})
+	}
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table Items: %s", d.Id())
+	err = batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutDelete))
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table Items (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceTableItemsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("items") || !diff.NewValueKnown("hash_key") || !diff.NewValueKnown("range_key") {
+		return nil
+	}
+
+	v, ok := diff.Get("items").(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	_, err := expandTableItems(v, diff.Get("hash_key").(string), diff.Get("range_key").(string))
+
+	return err
+}
+
+func tableItemsCreateResourceID(tableName, hashKey, rangeKey string) string {
+	id := []string{tableName, hashKey}
+
+	if rangeKey != "" {
+		id = append(id, rangeKey)
+	}
+
+	return strings.Join(id, "|")
+}
+
+type tableItem struct {
+	// key uniquely identifies the item by its primary key.
+	key        string
+	json       string
+	attributes map[string]awstypes.AttributeValue
+}
+
+// expandTableItems expands and validates a map of items.
+// Each item must contain the table's primary key attributes and be keyed by its primary key.
+func expandTableItems(tfMap map[string]interface{}, hashKey, rangeKey string) ([]tableItem, error) {
+	items := make([]tableItem, 0, len(tfMap))
+
+	for _, key := range slices.Sorted(maps.Keys(tfMap)) {
+		v, ok := tfMap[key].(string)
+		if !ok {
+			continue
+		}
+
+		attributes, err := expandTableItemAttributes(v)
+		if err != nil {
+			return nil, fmt.Errorf("item %q: %w", key, err)
+		}
+
+		for _, k := range []string{hashKey, rangeKey} {
+			if k == "" {
+				continue
+			}
+			switch attributes[k].(type) {
+			case *awstypes.AttributeValueMemberB, *awstypes.AttributeValueMemberN, *awstypes.AttributeValueMemberS:
+			default:
+				return nil, fmt.Errorf("item %q: key attribute %q must be present and of type B, N or S", key, k)
+			}
+		}
+
+		if want := tableItemsKey(attributes, hashKey, rangeKey); key != want {
+			return nil, fmt.Errorf("item %q: must be keyed by its primary key (%q)", key, want)
+		}
+
+		items = append(items, tableItem{
+			key:        key,
+			json:       v,
+			attributes: attributes,
+		})
+	}
+
+	return items, nil
+}
+
+// tableItemsKey returns the key of an item in the items map.
+// This is the value of the item's hash key attribute, followed by `|` and the value of its range key attribute if the table has a range key.
+// Binary values are base64 encoded.
+func tableItemsKey(attributes map[string]awstypes.AttributeValue, hashKey, rangeKey string) string {
+	keys := []string{hashKey}
+	if rangeKey != "" {
+		keys = append(keys, rangeKey)
+	}
+
+	var values []string
+	for _, k := range keys {
+		switch v := attributes[k].(type) {
+		case *awstypes.AttributeValueMemberB:
+			values = append(values, base64.StdEncoding.EncodeToString(v.Value))
+		case *awstypes.AttributeValueMemberN:
+			values = append(values, v.Value)
+		case *awstypes.AttributeValueMemberS:
+			values = append(values, v.Value)
+		}
+	}
+
+	return strings.Join(values, "|")
+}
+
+// batchWriteTableItems writes the specified put and delete requests, retrying any unprocessed requests until the timeout.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, requests []awstypes.WriteRequest, timeout time.Duration) error {
+	for chunk := range slices.Chunk(requests, batchWriteItemMaxRequests) {
+		input := dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]awstypes.WriteRequest{
+				tableName: chunk,
+			},
+		}
+
+		err := tfresource.Retry(ctx, timeout, func() *retry.RetryError {
+			output, err := conn.BatchWriteItem(ctx, &input)
+
+			if errs.IsA[*awstypes.ProvisionedThroughputExceededException](err) {
+				return retry.RetryableError(err)
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			if unprocessed := output.UnprocessedItems[tableName]; len(unprocessed) > 0 {
+				input.RequestItems[tableName] = unprocessed
+
+				return retry.RetryableError(fmt.Errorf("%d unprocessed items", len(unprocessed)))
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findTableItemsByKeys(ctx context.Context, conn *dynamodb.Client, tableName string, keys []map[string]awstypes.AttributeValue) ([]map[string]awstypes.AttributeValue, error) {
+	var items []map[string]awstypes.AttributeValue
+
+	for chunk := range slices.Chunk(keys, batchGetItemMaxKeys) {
+		input := dynamodb.BatchGetItemInput{
+			RequestItems: map[string]awstypes.KeysAndAttributes{
+				tableName: {
+					ConsistentRead: aws.Bool(true),
+					Keys:           chunk,
+				},
+			},
+		}
+
+		err := tfresource.Retry(ctx, propagationTimeout, func() *retry.RetryError {
+			output, err := conn.BatchGetItem(ctx, &input)
+
+			if errs.IsA[*awstypes.ProvisionedThroughputExceededException](err) {
+				return retry.RetryableError(err)
+			}
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			items = append(items, output.Responses[tableName]...)
+
+			if unprocessed, ok := output.UnprocessedKeys[tableName]; ok && len(unprocessed.Keys) > 0 {
+				input.RequestItems[tableName] = unprocessed
+
+				return retry.RetryableError(fmt.Errorf("%d unprocessed keys", len(unprocessed.Keys)))
+			}
+
+			return nil
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
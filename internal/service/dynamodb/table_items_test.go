@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTableItemsKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes map[string]awstypes.AttributeValue
+		rangeKey   string
+		want       string
+	}{
+		"hash key": {
+			attributes: map[string]awstypes.AttributeValue{
+				"hashKey": &awstypes.AttributeValueMemberS{Value: "a"},
+				"value":   &awstypes.AttributeValueMemberS{Value: "one"},
+			},
+			want: "a",
+		},
+		"hash and range keys": {
+			attributes: map[string]awstypes.AttributeValue{
+				"hashKey":  &awstypes.AttributeValueMemberS{Value: "a"},
+				"rangeKey": &awstypes.AttributeValueMemberN{Value: "1"},
+			},
+			rangeKey: "rangeKey",
+			want:     "a|1",
+		},
+		"binary hash key": {
+			attributes: map[string]awstypes.AttributeValue{
+				"hashKey": &awstypes.AttributeValueMemberB{Value: []byte("blob")},
+			},
+			want: "YmxvYg==",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfdynamodb.TableItemsKey(testCase.attributes, "hashKey", testCase.rangeKey), testCase.want; got != want {
+				t.Errorf("TableItemsKey() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 30),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "hashKey"),
+					resource.TestCheckResourceAttr(resourceName, "items.%", "30"),
+					resource.TestCheckResourceAttrSet(resourceName, "items.item-0"),
+					resource.TestCheckResourceAttr(resourceName, "range_key", ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTableName, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdynamodb.ResourceTableItems(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_rangeKey(rName, `
+    "a|1" = { hashKey = { S = "a" }, rangeKey = { N = "1" }, value = { S = "one" } },
+    "a|2" = { hashKey = { S = "a" }, rangeKey = { N = "2" }, value = { S = "two" } },
+    "b|1" = { hashKey = { S = "b" }, rangeKey = { N = "1" }, value = { S = "three" } },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "items.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "range_key", "rangeKey"),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_rangeKey(rName, `
+    "a|1" = { hashKey = { S = "a" }, rangeKey = { N = "1" }, value = { S = "one" } },
+    "a|2" = { hashKey = { S = "a" }, rangeKey = { N = "2" }, value = { S = "TWO" }, extra = { BOOL = true } },
+    "c|1" = { hashKey = { S = "c" }, rangeKey = { N = "1" }, value = { S = "four" } },
+    "c|2" = { hashKey = { S = "c" }, rangeKey = { N = "2" }, value = { S = "five" } },
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("items").AtMapKey("a|1"), knownvalue.StringExact(`{"hashKey":{"S":"a"},"rangeKey":{"N":"1"},"value":{"S":"one"}}`)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, resourceName),
+					testAccCheckTableItemCount(ctx, rName, 4),
+					resource.TestCheckResourceAttr(resourceName, "items.%", "4"),
+					resource.TestCheckNoResourceAttr(resourceName, "items.b|1"),
+					resource.TestCheckResourceAttrWith(resourceName, "items.a|2", func(value string) error {
+						if !strings.Contains(value, `"TWO"`) {
+							return fmt.Errorf("unexpected item: %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_keyMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_rangeKey(rName, `
+    "a|1" = { hashKey = { S = "a" }, rangeKey = { N = "1" }, value = { S = "one" } },
+    "a|2" = { hashKey = { S = "a" }, rangeKey = { N = "1" }, value = { S = "two" } },
+`),
+				ExpectError: regexache.MustCompile(`must be keyed by its primary key \("a\|1"\)`),
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			keys, err := testAccTableItemsKeys(rs)
+			if err != nil {
+				return err
+			}
+
+			output, err := tfdynamodb.FindTableItemsByKeys(ctx, conn, rs.Primary.Attributes[names.AttrTableName], keys)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("DynamoDB Table Items %s still exist.", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		keys, err := testAccTableItemsKeys(rs)
+		if err != nil {
+			return err
+		}
+
+		output, err := tfdynamodb.FindTableItemsByKeys(ctx, conn, rs.Primary.Attributes[names.AttrTableName], keys)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(output), len(keys); got != want {
+			return fmt.Errorf("DynamoDB Table Items %s: found %d items, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccTableItemsKeys(rs *terraform.ResourceState) ([]map[string]awstypes.AttributeValue, error) {
+	var keys []map[string]awstypes.AttributeValue
+
+	for k, v := range rs.Primary.Attributes {
+		if !strings.HasPrefix(k, "items.") || k == "items.%" {
+			continue
+		}
+
+		attributes, err := tfdynamodb.ExpandTableItemAttributes(v)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, tfdynamodb.ExpandTableItemQueryKey(attributes, rs.Primary.Attributes["hash_key"], rs.Primary.Attributes["range_key"]))
+	}
+
+	return keys, nil
+}
+
+func testAccTableItemsConfig_basic(rName string, n int) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "hashKey"
+
+  attribute {
+    name = "hashKey"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = { for i in range(%[2]d) : "item-${i}" => jsonencode({
+    hashKey = { S = "item-${i}" }
+    value   = { N = tostring(i) }
+  }) }
+}
+`, rName, n)
+}
+
+func testAccTableItemsConfig_rangeKey(rName, items string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "hashKey"
+  range_key    = "rangeKey"
+
+  attribute {
+    name = "hashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "rangeKey"
+    type = "N"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  items = { for k, item in {%[2]s} : k => jsonencode(item) }
+}
+`, rName, items)
+}
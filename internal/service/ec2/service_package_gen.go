@@ -124,6 +124,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  newSecurityGroupEgressRulesExclusiveResource,
+			TypeName: "aws_vpc_security_group_egress_rules_exclusive",
+			Name:     "Security Group Egress Rules Exclusive",
+		},
 		{
 			Factory:  newSecurityGroupIngressRuleResource,
 			TypeName: "aws_vpc_security_group_ingress_rule",
@@ -132,6 +137,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  newSecurityGroupIngressRulesExclusiveResource,
+			TypeName: "aws_vpc_security_group_ingress_rules_exclusive",
+			Name:     "Security Group Ingress Rules Exclusive",
+		},
 		{
 			Factory:  newResourceSecurityGroupVPCAssociation,
 			TypeName: "aws_vpc_security_group_vpc_association",
@@ -177,8 +177,9 @@ func resourceVPC() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			names.AttrTags:    tftags.TagsSchema(),
-			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			tftags.SkipDefaultTagsAttributeName: tftags.SkipDefaultTagsSchema(),
+			names.AttrTags:                      tftags.TagsSchema(),
+			names.AttrTagsAll:                   tftags.TagsSchemaComputed(),
 		},
 	}
 }
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// @FrameworkResource("aws_vpc_security_group_egress_rules_exclusive", name="Security Group Egress Rules Exclusive")
+func newSecurityGroupEgressRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &securityGroupEgressRulesExclusiveResource{}
+	r.securityGroupRulesExclusive = r
+
+	return r, nil
+}
+
+const (
+	ResNameSecurityGroupEgressRulesExclusive = "Security Group Egress Rules Exclusive"
+)
+
+type securityGroupEgressRulesExclusiveResource struct {
+	securityGroupRulesExclusiveResource
+}
+
+func (r *securityGroupEgressRulesExclusiveResource) isEgress() bool {
+	return true
+}
+
+func (r *securityGroupEgressRulesExclusiveResource) resourceName() string {
+	return ResNameSecurityGroupEgressRulesExclusive
+}
+
+func (r *securityGroupEgressRulesExclusiveResource) revoke(ctx context.Context, groupID, ruleID string) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	input := ec2.RevokeSecurityGroupEgressInput{
+		GroupId:              aws.String(groupID),
+		SecurityGroupRuleIds: []string{ruleID},
+	}
+	_, err := conn.RevokeSecurityGroupEgress(ctx, &input)
+
+	return err
+}
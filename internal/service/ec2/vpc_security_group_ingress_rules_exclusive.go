@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_security_group_ingress_rules_exclusive", name="Security Group Ingress Rules Exclusive")
+func newSecurityGroupIngressRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &securityGroupIngressRulesExclusiveResource{}
+	r.securityGroupRulesExclusive = r
+
+	return r, nil
+}
+
+const (
+	ResNameSecurityGroupIngressRulesExclusive = "Security Group Ingress Rules Exclusive"
+)
+
+type securityGroupIngressRulesExclusiveResource struct {
+	securityGroupRulesExclusiveResource
+}
+
+func (r *securityGroupIngressRulesExclusiveResource) isEgress() bool {
+	return false
+}
+
+func (r *securityGroupIngressRulesExclusiveResource) resourceName() string {
+	return ResNameSecurityGroupIngressRulesExclusive
+}
+
+func (r *securityGroupIngressRulesExclusiveResource) revoke(ctx context.Context, groupID, ruleID string) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	input := ec2.RevokeSecurityGroupIngressInput{
+		GroupId:              aws.String(groupID),
+		SecurityGroupRuleIds: []string{ruleID},
+	}
+	_, err := conn.RevokeSecurityGroupIngress(ctx, &input)
+
+	return err
+}
+
+// Base structure and methods for exclusive VPC security group rules.
+
+type securityGroupRulesExclusive interface {
+	isEgress() bool
+	resourceName() string
+	revoke(ctx context.Context, groupID, ruleID string) error
+}
+
+type securityGroupRulesExclusiveResource struct {
+	securityGroupRulesExclusive
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *securityGroupRulesExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"rule_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *securityGroupRulesExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan securityGroupRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ruleIDs []string
+	resp.Diagnostics.Append(plan.RuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncRules(ctx, plan.SecurityGroupID.ValueString(), ruleIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, r.resourceName(), plan.SecurityGroupID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().EC2Client(ctx)
+
+	var state securityGroupRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findSecurityGroupRuleIDsByGroupID(ctx, conn, state.SecurityGroupID.ValueString(), r.isEgress())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, r.resourceName(), state.SecurityGroupID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.RuleIDs = flex.FlattenFrameworkStringValueSetLegacy(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state securityGroupRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RuleIDs.Equal(state.RuleIDs) {
+		var ruleIDs []string
+		resp.Diagnostics.Append(plan.RuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncRules(ctx, plan.SecurityGroupID.ValueString(), ruleIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EC2, create.ErrActionUpdating, r.resourceName(), plan.SecurityGroupID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncRules handles keeping the configured security group rules in sync
+// with the remote resource.
+//
+// Rules are created by the aws_vpc_security_group_ingress_rule and
+// aws_vpc_security_group_egress_rule resources, so a configured rule
+// that does not exist is an error. Rules in the security group but not
+// configured on this resource will be revoked.
+func (r *securityGroupRulesExclusiveResource) syncRules(ctx context.Context, groupID string, want []string) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	have, err := findSecurityGroupRuleIDsByGroupID(ctx, conn, groupID, r.isEgress())
+	if err != nil {
+		return err
+	}
+
+	return exclusive.Sync(ctx, have, want,
+		exclusive.AddNotSupported("security group rule"),
+		func(ctx context.Context, ruleID string) error {
+			return r.revoke(ctx, groupID, ruleID)
+		},
+	)
+}
+
+func (r *securityGroupRulesExclusiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), req, resp)
+}
+
+func findSecurityGroupRuleIDsByGroupID(ctx context.Context, conn *ec2.Client, groupID string, egress bool) ([]string, error) {
+	// Ensure the security group exists so that a missing group is reported as NotFound.
+	if _, err := findSecurityGroupByID(ctx, conn, groupID); err != nil {
+		return nil, err
+	}
+
+	input := ec2.DescribeSecurityGroupRulesInput{
+		Filters: newAttributeFilterList(map[string]string{
+			"group-id": groupID,
+		}),
+	}
+
+	rules, err := findSecurityGroupRules(ctx, conn, &input)
+	if err != nil {
+		return nil, err
+	}
+
+	var ruleIDs []string
+	for _, rule := range rules {
+		if aws.ToBool(rule.IsEgress) == egress {
+			ruleIDs = append(ruleIDs, aws.ToString(rule.SecurityGroupRuleId))
+		}
+	}
+
+	return ruleIDs, nil
+}
+
+type securityGroupRulesExclusiveResourceModel struct {
+	RuleIDs         types.Set    `tfsdk:"rule_ids"`
+	SecurityGroupID types.String `tfsdk:"security_group_id"`
+}
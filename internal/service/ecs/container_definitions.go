@@ -47,44 +47,16 @@ func containerDefinitionsAreEquivalent(def1, def2 string, isAWSVPC bool) (bool,
 
 type containerDefinitions []awstypes.ContainerDefinition
 
+// reduce normalizes the container definitions and removes empty lists so that they can be compared.
 func (cd containerDefinitions) reduce(isAWSVPC bool) {
-	// Deal with fields which may be re-ordered in the API.
-	cd.orderContainers()
-	cd.orderEnvironmentVariables()
-	cd.orderSecrets()
-
-	// Compact any sparse lists.
-	cd.compactArrays()
+	cd.normalize(isAWSVPC)
 
-	// Deal with special fields which have defaults.
-	// See https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task_definition_parameters.html#container_definitions.
 	for i, def := range cd {
-		if def.Essential == nil {
-			cd[i].Essential = aws.Bool(true)
-		}
-
-		if hc := def.HealthCheck; hc != nil {
-			if hc.Interval == nil {
-				hc.Interval = aws.Int32(30)
-			}
-			if hc.Retries == nil {
-				hc.Retries = aws.Int32(3)
-			}
-			if hc.Timeout == nil {
-				hc.Timeout = aws.Int32(5)
-			}
-		}
-
+		// A host port of 0 is equivalent to no host port, including for port mappings without a container port.
 		for j, pm := range def.PortMappings {
-			if pm.Protocol == awstypes.TransportProtocolTcp {
-				cd[i].PortMappings[j].Protocol = ""
-			}
 			if aws.ToInt32(pm.HostPort) == 0 {
 				cd[i].PortMappings[j].HostPort = nil
 			}
-			if isAWSVPC && cd[i].PortMappings[j].HostPort == nil {
-				cd[i].PortMappings[j].HostPort = cd[i].PortMappings[j].ContainerPort
-			}
 		}
 
 		// Set all empty slices to nil.
@@ -145,6 +117,67 @@ func (cd containerDefinitions) reduce(isAWSVPC bool) {
 	}
 }
 
+// normalize puts the container definitions into the canonical form returned by the ECS API.
+// Fields which may be re-ordered by the API are sorted and fields which the API defaults are set.
+// See https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task_definition_parameters.html#container_definitions.
+func (cd containerDefinitions) normalize(isAWSVPC bool) {
+	cd.orderContainers()
+	cd.orderEnvironmentVariables()
+	cd.orderSecrets()
+
+	// Compact any sparse lists.
+	cd.compactArrays()
+
+	for i, def := range cd {
+		if def.Essential == nil {
+			cd[i].Essential = aws.Bool(true)
+		}
+
+		if hc := def.HealthCheck; hc != nil {
+			if hc.Interval == nil {
+				hc.Interval = aws.Int32(30)
+			}
+			if hc.Retries == nil {
+				hc.Retries = aws.Int32(3)
+			}
+			if hc.Timeout == nil {
+				hc.Timeout = aws.Int32(5)
+			}
+		}
+
+		for j, pm := range def.PortMappings {
+			if pm.Protocol == "" {
+				cd[i].PortMappings[j].Protocol = awstypes.TransportProtocolTcp
+			}
+			if pm.ContainerPort != nil {
+				// In awsvpc network mode the host port is the container port, otherwise it is dynamically assigned.
+				if isAWSVPC && aws.ToInt32(pm.HostPort) == 0 {
+					cd[i].PortMappings[j].HostPort = pm.ContainerPort
+				} else if pm.HostPort == nil {
+					cd[i].PortMappings[j].HostPort = aws.Int32(0)
+				}
+			}
+		}
+
+		// The API always returns these lists, even if empty.
+		if def.Environment == nil {
+			cd[i].Environment = []awstypes.KeyValuePair{}
+		}
+		if def.MountPoints == nil {
+			cd[i].MountPoints = []awstypes.MountPoint{}
+		}
+		if def.PortMappings == nil {
+			cd[i].PortMappings = []awstypes.PortMapping{}
+		}
+		if def.SystemControls == nil {
+			cd[i].SystemControls = []awstypes.SystemControl{}
+		}
+		if def.VolumesFrom == nil {
+			cd[i].VolumesFrom = []awstypes.VolumeFrom{}
+		}
+	}
+}
+
 func (cd containerDefinitions) orderEnvironmentVariables() {
 	for i, def := range cd {
 		slices.SortFunc(def.Environment, func(a, b awstypes.KeyValuePair) int {
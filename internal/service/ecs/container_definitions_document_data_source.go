@@ -0,0 +1,520 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ecs_container_definitions_document", name="Container Definitions Document")
+func newContainerDefinitionsDocumentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &containerDefinitionsDocumentDataSource{}, nil
+}
+
+type containerDefinitionsDocumentDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *containerDefinitionsDocumentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	secretBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionsDocumentSecretModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrName: schema.StringAttribute{
+						Required: true,
+					},
+					"value_from": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrJSON: schema.StringAttribute{
+				Computed: true,
+			},
+			"network_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.NetworkMode](),
+				Optional:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"container": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionsDocumentContainerModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"command": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"cpu": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"docker_labels": schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"entry_point": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"essential": schema.BoolAttribute{
+							Optional: true,
+						},
+						"hostname": schema.StringAttribute{
+							Optional: true,
+						},
+						"image": schema.StringAttribute{
+							Required: true,
+						},
+						"links": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"memory": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(6),
+							},
+						},
+						"memory_reservation": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(6),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"privileged": schema.BoolAttribute{
+							Optional: true,
+						},
+						"readonly_root_filesystem": schema.BoolAttribute{
+							Optional: true,
+						},
+						"start_timeout": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"stop_timeout": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 120),
+							},
+						},
+						"user": schema.StringAttribute{
+							Optional: true,
+						},
+						"working_directory": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"depends_on": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionsDocumentContainerDependencyModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCondition: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ContainerCondition](),
+										Required:   true,
+									},
+									"container_name": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						names.AttrEnvironment: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionsDocumentKeyValuePairModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrValue: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"health_check": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionsDocumentHealthCheckModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"command": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.List{
+											listvalidator.SizeAtLeast(1),
+										},
+									},
+									names.AttrInterval: schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(5, 300),
+										},
+									},
+									"retries": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(1, 10),
+										},
+									},
+									"start_period": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(0, 300),
+										},
+									},
+									names.AttrTimeout: schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(2, 120),
+										},
+									},
+								},
+							},
+						},
+						"linux_parameters": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionsDocumentLinuxParametersModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"init_process_enabled": schema.BoolAttribute{
+										Optional: true,
+									},
+									"max_swap": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+									},
+									"shared_memory_size": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+									"swappiness": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(0, 100),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"capabilities": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionsDocumentKernelCapabilitiesModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"add": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Optional:    true,
+												},
+												"drop": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Optional:    true,
+												},
+											},
+										},
+									},
+									"device": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionsDocumentDeviceModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"container_path": schema.StringAttribute{
+													Optional: true,
+												},
+												"host_path": schema.StringAttribute{
+													Required: true,
+												},
+												"permissions": schema.ListAttribute{
+													CustomType: fwtypes.ListOfStringEnumType[awstypes.DeviceCgroupPermission](),
+													Optional:   true,
+												},
+											},
+										},
+									},
+									"tmpfs": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionsDocumentTmpfsModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"container_path": schema.StringAttribute{
+													Required: true,
+												},
+												"mount_options": schema.ListAttribute{
+													CustomType:  fwtypes.ListOfStringType,
+													ElementType: types.StringType,
+													Optional:    true,
+												},
+												names.AttrSize: schema.Int64Attribute{
+													Required: true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"log_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionsDocumentLogConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"log_driver": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.LogDriver](),
+										Required:   true,
+									},
+									"options": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"secret_option": secretBlock(),
+								},
+							},
+						},
+						"port_mapping": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[containerDefinitionsDocumentPortMappingModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"app_protocol": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ApplicationProtocol](),
+										Optional:   true,
+									},
+									"container_port": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(1, 65535),
+											int64validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("container_port_range")),
+										},
+									},
+									"container_port_range": schema.StringAttribute{
+										Optional: true,
+									},
+									"host_port": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(0, 65535),
+										},
+									},
+									names.AttrName: schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrProtocol: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.TransportProtocol](),
+										Optional:   true,
+									},
+								},
+							},
+						},
+						"secret": secretBlock(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *containerDefinitionsDocumentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data containerDefinitionsDocumentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var input ecs.RegisterTaskDefinitionInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	containerNames := make(map[string]struct{})
+	for i, apiObject := range input.ContainerDefinitions {
+		name := aws.ToString(apiObject.Name)
+
+		if _, ok := containerNames[name]; ok {
+			response.Diagnostics.AddAttributeError(path.Root("container").AtListIndex(i).AtName(names.AttrName), "Duplicate container name", fmt.Sprintf("Container %q is defined more than once.", name))
+			return
+		}
+
+		containerNames[name] = struct{}{}
+	}
+
+	for i, apiObject := range input.ContainerDefinitions {
+		for j, v := range apiObject.DependsOn {
+			name := aws.ToString(v.ContainerName)
+
+			if _, ok := containerNames[name]; !ok || name == aws.ToString(apiObject.Name) {
+				response.Diagnostics.AddAttributeError(path.Root("container").AtListIndex(i).AtName("depends_on").AtListIndex(j).AtName("container_name"), "Invalid container dependency", fmt.Sprintf("Container %q cannot depend on container %q.", aws.ToString(apiObject.Name), name))
+				return
+			}
+		}
+	}
+
+	containerDefinitions(input.ContainerDefinitions).normalize(input.NetworkMode == awstypes.NetworkModeAwsvpc)
+
+	json, err := flattenContainerDefinitions(input.ContainerDefinitions)
+
+	if err != nil {
+		response.Diagnostics.AddError("flattening ECS Container Definitions", err.Error())
+		return
+	}
+
+	data.JSON = types.StringValue(json)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type containerDefinitionsDocumentDataSourceModel struct {
+	ContainerDefinitions fwtypes.ListNestedObjectValueOf[containerDefinitionsDocumentContainerModel] `tfsdk:"container"`
+	JSON                 types.String                                                                `tfsdk:"json"`
+	NetworkMode          fwtypes.StringEnum[awstypes.NetworkMode]                                    `tfsdk:"network_mode"`
+}
+
+type containerDefinitionsDocumentContainerModel struct {
+	Command                fwtypes.ListOfString                                                                  `tfsdk:"command"`
+	Cpu                    types.Int64                                                                           `tfsdk:"cpu"`
+	DependsOn              fwtypes.ListNestedObjectValueOf[containerDefinitionsDocumentContainerDependencyModel] `tfsdk:"depends_on"`
+	DockerLabels           fwtypes.MapOfString                                                                   `tfsdk:"docker_labels"`
+	EntryPoint             fwtypes.ListOfString                                                                  `tfsdk:"entry_point"`
+	Environment            fwtypes.ListNestedObjectValueOf[containerDefinitionsDocumentKeyValuePairModel]        `tfsdk:"environment"`
+	Essential              types.Bool                                                                            `tfsdk:"essential"`
+	HealthCheck            fwtypes.ListNestedObjectValueOf[containerDefinitionsDocumentHealthCheckModel]         `tfsdk:"health_check"`
+	Hostname               types.String                                                                          `tfsdk:"hostname"`
+	Image                  types.String                                                                          `tfsdk:"image"`
+	Links                  fwtypes.ListOfString                                                                  `tfsdk:"links"`
+	LinuxParameters        fwtypes.ListNestedObjectValueOf[containerDefinitionsDocumentLinuxParametersModel]     `tfsdk:"linux_parameters"`
+	LogConfiguration       fwtypes.ListNestedObjectValueOf[containerDefinitionsDocumentLogConfigurationModel]    `tfsdk:"log_configuration"`
+	Memory                 types.Int64                                                                           `tfsdk:"memory"`
+	MemoryReservation      types.Int64                                                                           `tfsdk:"memory_reservation"`
+	Name                   types.String                                                                          `tfsdk:"name"`
+	PortMappings           fwtypes.ListNestedObjectValueOf[containerDefinitionsDocumentPortMappingModel]         `tfsdk:"port_mapping"`
+	Privileged             types.Bool                                                                            `tfsdk:"privileged"`
+	ReadonlyRootFilesystem types.Bool                                                                            `tfsdk:"readonly_root_filesystem"`
+	Secrets                fwtypes.ListNestedObjectValueOf[containerDefinitionsDocumentSecretModel]              `tfsdk:"secret"`
+	StartTimeout           types.Int64                                                                           `tfsdk:"start_timeout"`
+	StopTimeout            types.Int64                                                                           `tfsdk:"stop_timeout"`
+	User                   types.String                                                                          `tfsdk:"user"`
+	WorkingDirectory       types.String                                                                          `tfsdk:"working_directory"`
+}
+
+type containerDefinitionsDocumentContainerDependencyModel struct {
+	Condition     fwtypes.StringEnum[awstypes.ContainerCondition] `tfsdk:"condition"`
+	ContainerName types.String                                    `tfsdk:"container_name"`
+}
+
+type containerDefinitionsDocumentKeyValuePairModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
+
+type containerDefinitionsDocumentHealthCheckModel struct {
+	Command     fwtypes.ListOfString `tfsdk:"command"`
+	Interval    types.Int64          `tfsdk:"interval"`
+	Retries     types.Int64          `tfsdk:"retries"`
+	StartPeriod types.Int64          `tfsdk:"start_period"`
+	Timeout     types.Int64          `tfsdk:"timeout"`
+}
+
+type containerDefinitionsDocumentLinuxParametersModel struct {
+	Capabilities       fwtypes.ListNestedObjectValueOf[containerDefinitionsDocumentKernelCapabilitiesModel] `tfsdk:"capabilities"`
+	Devices            fwtypes.ListNestedObjectValueOf[containerDefinitionsDocumentDeviceModel]             `tfsdk:"device"`
+	InitProcessEnabled types.Bool                                                                           `tfsdk:"init_process_enabled"`
+	MaxSwap            types.Int64                                                                          `tfsdk:"max_swap"`
+	SharedMemorySize   types.Int64                                                                          `tfsdk:"shared_memory_size"`
+	Swappiness         types.Int64                                                                          `tfsdk:"swappiness"`
+	Tmpfs              fwtypes.ListNestedObjectValueOf[containerDefinitionsDocumentTmpfsModel]              `tfsdk:"tmpfs"`
+}
+
+type containerDefinitionsDocumentKernelCapabilitiesModel struct {
+	Add  fwtypes.ListOfString `tfsdk:"add"`
+	Drop fwtypes.ListOfString `tfsdk:"drop"`
+}
+
+type containerDefinitionsDocumentDeviceModel struct {
+	ContainerPath types.String                                                             `tfsdk:"container_path"`
+	HostPath      types.String                                                             `tfsdk:"host_path"`
+	Permissions   fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.DeviceCgroupPermission]] `tfsdk:"permissions"`
+}
+
+type containerDefinitionsDocumentTmpfsModel struct {
+	ContainerPath types.String         `tfsdk:"container_path"`
+	MountOptions  fwtypes.ListOfString `tfsdk:"mount_options"`
+	Size          types.Int64          `tfsdk:"size"`
+}
+
+type containerDefinitionsDocumentLogConfigurationModel struct {
+	LogDriver     fwtypes.StringEnum[awstypes.LogDriver]                                   `tfsdk:"log_driver"`
+	Options       fwtypes.MapOfString                                                      `tfsdk:"options"`
+	SecretOptions fwtypes.ListNestedObjectValueOf[containerDefinitionsDocumentSecretModel] `tfsdk:"secret_option"`
+}
+
+type containerDefinitionsDocumentPortMappingModel struct {
+	AppProtocol        fwtypes.StringEnum[awstypes.ApplicationProtocol] `tfsdk:"app_protocol"`
+	ContainerPort      types.Int64                                      `tfsdk:"container_port"`
+	ContainerPortRange types.String                                     `tfsdk:"container_port_range"`
+	HostPort           types.Int64                                      `tfsdk:"host_port"`
+	Name               types.String                                     `tfsdk:"name"`
+	Protocol           fwtypes.StringEnum[awstypes.TransportProtocol]   `tfsdk:"protocol"`
+}
+
+type containerDefinitionsDocumentSecretModel struct {
+	Name      types.String `tfsdk:"name"`
+	ValueFrom types.String `tfsdk:"value_from"`
+}
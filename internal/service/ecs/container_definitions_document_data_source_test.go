@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSContainerDefinitionsDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecs_container_definitions_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerDefinitionsDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, `[
+  {
+    "name": "app",
+    "image": "alpine",
+    "command": ["sleep", "3600"],
+    "memory": 128,
+    "essential": false,
+    "environment": [],
+    "mountPoints": [],
+    "portMappings": [],
+    "systemControls": [],
+    "volumesFrom": []
+  },
+  {
+    "name": "web",
+    "image": "nginx",
+    "cpu": 256,
+    "memory": 512,
+    "essential": true,
+    "dependsOn": [{"containerName": "app", "condition": "START"}],
+    "environment": [
+      {"name": "A", "value": "1"},
+      {"name": "B", "value": "2"}
+    ],
+    "healthCheck": {
+      "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"],
+      "interval": 30,
+      "retries": 3,
+      "timeout": 5
+    },
+    "linuxParameters": {
+      "initProcessEnabled": true,
+      "capabilities": {"add": ["SYS_PTRACE"]}
+    },
+    "logConfiguration": {
+      "logDriver": "awslogs",
+      "options": {"awslogs-group": "example"},
+      "secretOptions": [{"name": "token", "valueFrom": "arn:aws:ssm:us-west-2:123456789012:parameter/token"}]
+    },
+    "mountPoints": [],
+    "portMappings": [{"containerPort": 80, "hostPort": 0, "protocol": "tcp"}],
+    "secrets": [{"name": "PASSWORD", "valueFrom": "arn:aws:ssm:us-west-2:123456789012:parameter/password"}],
+    "systemControls": [],
+    "volumesFrom": []
+  }
+]`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSContainerDefinitionsDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContainerDefinitionsDocumentDataSourceConfig_duplicateName,
+				ExpectError: regexache.MustCompile(`Duplicate container name`),
+			},
+			{
+				Config:      testAccContainerDefinitionsDocumentDataSourceConfig_invalidDependency,
+				ExpectError: regexache.MustCompile(`Invalid container dependency`),
+			},
+		},
+	})
+}
+
+func TestAccECSContainerDefinitionsDocumentDataSource_taskDefinition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def awstypes.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerDefinitionsDocumentDataSourceConfig_taskDefinition(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					acctest.CheckResourceAttrJMES(resourceName, "container_definitions", "[0].portMappings[0].hostPort", "80"),
+					acctest.CheckResourceAttrJMES(resourceName, "container_definitions", "[0].environment[0].name", "A"),
+					acctest.CheckResourceAttrJMES(resourceName, "container_definitions", "[0].healthCheck.interval", "30"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+const testAccContainerDefinitionsDocumentDataSourceConfig_basic = `
+data "aws_ecs_container_definitions_document" "test" {
+  container {
+    name   = "web"
+    image  = "nginx"
+    cpu    = 256
+    memory = 512
+
+    port_mapping {
+      container_port = 80
+    }
+
+    environment {
+      name  = "B"
+      value = "2"
+    }
+
+    environment {
+      name  = "A"
+      value = "1"
+    }
+
+    secret {
+      name       = "PASSWORD"
+      value_from = "arn:aws:ssm:us-west-2:123456789012:parameter/password"
+    }
+
+    log_configuration {
+      log_driver = "awslogs"
+      options = {
+        "awslogs-group" = "example"
+      }
+
+      secret_option {
+        name       = "token"
+        value_from = "arn:aws:ssm:us-west-2:123456789012:parameter/token"
+      }
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+    }
+
+    depends_on {
+      container_name = "app"
+      condition      = "START"
+    }
+
+    linux_parameters {
+      init_process_enabled = true
+
+      capabilities {
+        add = ["SYS_PTRACE"]
+      }
+    }
+  }
+
+  container {
+    name      = "app"
+    image     = "alpine"
+    command   = ["sleep", "3600"]
+    memory    = 128
+    essential = false
+  }
+}
+`
+
+const testAccContainerDefinitionsDocumentDataSourceConfig_duplicateName = `
+data "aws_ecs_container_definitions_document" "test" {
+  container {
+    name  = "web"
+    image = "nginx"
+  }
+
+  container {
+    name  = "web"
+    image = "alpine"
+  }
+}
+`
+
+const testAccContainerDefinitionsDocumentDataSourceConfig_invalidDependency = `
+data "aws_ecs_container_definitions_document" "test" {
+  container {
+    name  = "web"
+    image = "nginx"
+
+    depends_on {
+      container_name = "missing"
+      condition      = "START"
+    }
+  }
+}
+`
+
+func testAccContainerDefinitionsDocumentDataSourceConfig_taskDefinition(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ecs_container_definitions_document" "test" {
+  network_mode = "awsvpc"
+
+  container {
+    name  = "web"
+    image = "nginx:latest"
+
+    port_mapping {
+      container_port = 80
+    }
+
+    environment {
+      name  = "B"
+      value = "2"
+    }
+
+    environment {
+      name  = "A"
+      value = "1"
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "exit 0"]
+    }
+  }
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = 256
+  memory                   = 512
+  container_definitions    = data.aws_ecs_container_definitions_document.test.json
+}
+`, rName)
+}
@@ -5,6 +5,8 @@ package ecs
 
 import (
 	"testing"
+
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
 )
 
 func TestContainerDefinitionsAreEquivalent_basic(t *testing.T) {
@@ -141,6 +143,47 @@ func TestContainerDefinitionsAreEquivalent_portMappings(t *testing.T) {
 	}
 }
 
+func TestContainerDefinitionsAreEquivalent_portMappingsZeroHostPortWithoutContainerPort(t *testing.T) {
+	t.Parallel()
+
+	def1 := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "portMappings": [
+        {
+          "containerPortRange": "8000-8010",
+          "hostPort": 0
+        }
+      ]
+    }
+]`
+
+	def2 := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "portMappings": [
+        {
+          "containerPortRange": "8000-8010"
+        }
+      ]
+    }
+]`
+
+	for _, isAWSVPC := range []bool{false, true} {
+		equal, err := containerDefinitionsAreEquivalent(def1, def2, isAWSVPC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !equal {
+			t.Fatalf("Expected definitions to be equal (awsvpc: %t).", isAWSVPC)
+		}
+	}
+}
+
 func TestContainerDefinitionsAreEquivalent_portMappingsIgnoreHostPort(t *testing.T) {
 	t.Parallel()
 
@@ -698,6 +741,186 @@ func TestContainerDefinitionsAreEquivalent_healthCheck(t *testing.T) {
 	}
 }
 
+func TestContainerDefinitionsNormalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		isAWSVPC bool
+		expected string
+	}{
+		"defaults": {
+			input: `
+[
+    {
+      "name": "web",
+      "image": "nginx",
+      "portMappings": [
+        {
+          "containerPort": 80
+        }
+      ],
+      "healthCheck": {
+        "command": ["CMD-SHELL", "exit 0"]
+      }
+    }
+]`,
+			expected: `
+[
+    {
+      "name": "web",
+      "image": "nginx",
+      "essential": true,
+      "environment": [],
+      "healthCheck": {
+        "command": ["CMD-SHELL", "exit 0"],
+        "interval": 30,
+        "retries": 3,
+        "timeout": 5
+      },
+      "mountPoints": [],
+      "portMappings": [
+        {
+          "containerPort": 80,
+          "hostPort": 0,
+          "protocol": "tcp"
+        }
+      ],
+      "systemControls": [],
+      "volumesFrom": []
+    }
+]`,
+		},
+		"awsvpc": {
+			input: `
+[
+    {
+      "name": "web",
+      "image": "nginx",
+      "essential": false,
+      "portMappings": [
+        {
+          "containerPort": 80
+        },
+        {
+          "containerPort": 443,
+          "hostPort": 0,
+          "protocol": "udp"
+        }
+      ]
+    }
+]`,
+			isAWSVPC: true,
+			expected: `
+[
+    {
+      "name": "web",
+      "image": "nginx",
+      "essential": false,
+      "environment": [],
+      "mountPoints": [],
+      "portMappings": [
+        {
+          "containerPort": 80,
+          "hostPort": 80,
+          "protocol": "tcp"
+        },
+        {
+          "containerPort": 443,
+          "hostPort": 443,
+          "protocol": "udp"
+        }
+      ],
+      "systemControls": [],
+      "volumesFrom": []
+    }
+]`,
+		},
+		"ordering": {
+			input: `
+[
+    {
+      "name": "web",
+      "image": "nginx",
+      "environment": [
+        {"name": "B", "value": "2"},
+        {},
+        {"name": "A", "value": "1"}
+      ],
+      "secrets": [
+        {"name": "Y", "valueFrom": "arn:y"},
+        {"name": "X", "valueFrom": "arn:x"}
+      ]
+    },
+    {
+      "name": "app",
+      "image": "alpine"
+    }
+]`,
+			expected: `
+[
+    {
+      "name": "app",
+      "image": "alpine",
+      "essential": true,
+      "environment": [],
+      "mountPoints": [],
+      "portMappings": [],
+      "systemControls": [],
+      "volumesFrom": []
+    },
+    {
+      "name": "web",
+      "image": "nginx",
+      "essential": true,
+      "environment": [
+        {"name": "A", "value": "1"},
+        {"name": "B", "value": "2"}
+      ],
+      "mountPoints": [],
+      "portMappings": [],
+      "secrets": [
+        {"name": "X", "valueFrom": "arn:x"},
+        {"name": "Y", "valueFrom": "arn:y"}
+      ],
+      "systemControls": [],
+      "volumesFrom": []
+    }
+]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var input containerDefinitions
+			if err := tfjson.DecodeFromString(testCase.input, &input); err != nil {
+				t.Fatal(err)
+			}
+			input.normalize(testCase.isAWSVPC)
+
+			var expected containerDefinitions
+			if err := tfjson.DecodeFromString(testCase.expected, &expected); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tfjson.EncodeToBytes(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := tfjson.EncodeToBytes(expected)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !tfjson.EqualBytes(got, want) {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
 func TestExpandContainerDefinitions_InvalidVersionConsistency(t *testing.T) {
 	t.Parallel()
 
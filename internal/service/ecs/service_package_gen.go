@@ -21,6 +21,11 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			TypeName: "aws_ecs_clusters",
 			Name:     "Clusters",
 		},
+		{
+			Factory:  newContainerDefinitionsDocumentDataSource,
+			TypeName: "aws_ecs_container_definitions_document",
+			Name:     "Container Definitions Document",
+		},
 	}
 }
 
@@ -718,12 +718,10 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "setting volume: %s", err)
 	}
 
-	// Sort the lists of environment variables as they come in, so we won't get spurious reorderings in plans
+	// Normalize the container definitions as they come in, so we won't get spurious reorderings in plans
 	// (diff is suppressed if the environment variables haven't changed, but they still show in the plan if
 	// some other property changes).
-	containerDefinitions(taskDefinition.ContainerDefinitions).orderContainers()
-	containerDefinitions(taskDefinition.ContainerDefinitions).orderEnvironmentVariables()
-	containerDefinitions(taskDefinition.ContainerDefinitions).orderSecrets()
+	containerDefinitions(taskDefinition.ContainerDefinitions).normalize(taskDefinition.NetworkMode == awstypes.NetworkModeAwsvpc)
 
 	defs, err := flattenContainerDefinitions(taskDefinition.ContainerDefinitions)
 	if err != nil {
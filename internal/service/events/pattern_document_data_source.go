@@ -0,0 +1,597 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_cloudwatch_event_pattern_document", name="Pattern Document")
+func newPatternDocumentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &patternDocumentDataSource{}, nil
+}
+
+type patternDocumentDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *patternDocumentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrJSON: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"any_of": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[patternDocumentAnyOf](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrPath: schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"pattern": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[patternDocumentPattern](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(2),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"field": patternDocumentFieldBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+			"field": patternDocumentFieldBlock(ctx),
+		},
+	}
+}
+
+func patternDocumentFieldBlock(ctx context.Context) schema.ListNestedBlock {
+	stringsAttribute := func() schema.ListAttribute {
+		return schema.ListAttribute{
+			CustomType:  fwtypes.ListOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+		}
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[patternDocumentField](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"cidr": schema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					ElementType: types.StringType,
+					Optional:    true,
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+						listvalidator.ValueStringsAre(stringvalidator.Any(
+							fwvalidators.IPv4CIDRNetworkAddress(),
+							fwvalidators.IPv6CIDRNetworkAddress(),
+						)),
+					},
+				},
+				"equals": stringsAttribute(),
+				"equals_boolean": schema.ListAttribute{
+					ElementType: types.BoolType,
+					Optional:    true,
+				},
+				"equals_ignore_case": stringsAttribute(),
+				"equals_null": schema.BoolAttribute{
+					Optional: true,
+				},
+				"equals_number": schema.ListAttribute{
+					ElementType: types.Float64Type,
+					Optional:    true,
+				},
+				"exists": schema.BoolAttribute{
+					Optional: true,
+				},
+				names.AttrPath: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrPrefix:     stringsAttribute(),
+				"prefix_ignore_case": stringsAttribute(),
+				"suffix":             stringsAttribute(),
+				"suffix_ignore_case": stringsAttribute(),
+				"wildcard":           stringsAttribute(),
+			},
+			Blocks: map[string]schema.Block{
+				"anything_but": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[patternDocumentAnythingBut](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"equals":             stringsAttribute(),
+							"equals_ignore_case": stringsAttribute(),
+							"equals_number": schema.ListAttribute{
+								ElementType: types.Float64Type,
+								Optional:    true,
+							},
+							names.AttrPrefix: schema.StringAttribute{
+								Optional: true,
+							},
+							"suffix": schema.StringAttribute{
+								Optional: true,
+							},
+							"wildcard": stringsAttribute(),
+						},
+					},
+				},
+				"numeric": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[patternDocumentNumeric](ctx),
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"equal": schema.Float64Attribute{
+								Optional: true,
+							},
+							"greater_than": schema.Float64Attribute{
+								Optional: true,
+							},
+							"greater_than_or_equal": schema.Float64Attribute{
+								Optional: true,
+							},
+							"less_than": schema.Float64Attribute{
+								Optional: true,
+							},
+							"less_than_or_equal": schema.Float64Attribute{
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *patternDocumentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data patternDocumentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	pattern, diags := expandPatternDocumentFields(ctx, data.Fields)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	anyOfs, diags := data.AnyOf.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for _, v := range anyOfs {
+		patterns, diags := v.Patterns.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		var alternatives []any
+
+		for _, v := range patterns {
+			alternative, diags := expandPatternDocumentFields(ctx, v.Fields)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if len(alternative) == 0 {
+				response.Diagnostics.AddError("Invalid any_of pattern", "Each pattern must contain at least one field.")
+				return
+			}
+
+			alternatives = append(alternatives, alternative)
+		}
+
+		if err := setPatternDocumentValue(pattern, patternDocumentPath(v.Path.ValueString(), "$or"), alternatives); err != nil {
+			response.Diagnostics.AddError("Invalid any_of", err.Error())
+			return
+		}
+	}
+
+	if len(pattern) == 0 {
+		response.Diagnostics.AddError("Empty event pattern", "At least one field or any_of block must be specified.")
+		return
+	}
+
+	bytes, err := json.MarshalIndent(pattern, "", "  ")
+
+	if err != nil {
+		response.Diagnostics.AddError("Marshalling event pattern to JSON", err.Error())
+		return
+	}
+
+	data.JSON = types.StringValue(string(bytes))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// expandPatternDocumentFields builds an event pattern from field matchers.
+// The matchers of fields with the same path are combined, so that the field matches if any of them match.
+func expandPatternDocumentFields(ctx context.Context, fields fwtypes.ListNestedObjectValueOf[patternDocumentField]) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	pattern := make(map[string]any)
+
+	values, d := fields.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, v := range values {
+		path := v.Path.ValueString()
+
+		matchers, d := v.expand(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if len(matchers) == 0 {
+			diags.AddError("Invalid field", fmt.Sprintf("Field (%s) must specify at least one matcher.", path))
+			return nil, diags
+		}
+
+		elems := patternDocumentPath(path)
+
+		if existing, ok := getPatternDocumentValue(pattern, elems).([]any); ok {
+			matchers = append(existing, matchers...)
+		}
+
+		if err := setPatternDocumentValue(pattern, elems, matchers); err != nil {
+			diags.AddError("Invalid field", err.Error())
+			return nil, diags
+		}
+	}
+
+	return pattern, diags
+}
+
+// patternDocumentPath splits a dot-separated path into its elements, appending any additional elements.
+func patternDocumentPath(path string, elems ...string) []string {
+	if path == "" {
+		return elems
+	}
+
+	return append(strings.Split(path, "."), elems...)
+}
+
+func getPatternDocumentValue(pattern map[string]any, elems []string) any {
+	var v any = pattern
+
+	for _, elem := range elems {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		v = m[elem]
+	}
+
+	return v
+}
+
+// setPatternDocumentValue sets the value at the specified path, creating intermediate objects as required.
+func setPatternDocumentValue(pattern map[string]any, elems []string, value any) error {
+	path := strings.Join(elems, ".")
+
+	for i, elem := range elems {
+		if elem == "" {
+			return fmt.Errorf("path (%s) contains an empty element", path)
+		}
+
+		if i == len(elems)-1 {
+			if _, ok := pattern[elem].(map[string]any); ok {
+				return fmt.Errorf("path (%s) is both a field and an object containing other fields", path)
+			}
+
+			if _, ok := value.([]any); ok && elem == "$or" {
+				if _, ok := pattern[elem]; ok {
+					return fmt.Errorf("path (%s) has more than one any_of", path)
+				}
+			}
+
+			pattern[elem] = value
+
+			return nil
+		}
+
+		switch v := pattern[elem].(type) {
+		case nil:
+			m := make(map[string]any)
+			pattern[elem] = m
+			pattern = m
+		case map[string]any:
+			pattern = v
+		default:
+			return fmt.Errorf("path (%s) is both a field and an object containing other fields", path)
+		}
+	}
+
+	return nil
+}
+
+type patternDocumentDataSourceModel struct {
+	AnyOf  fwtypes.ListNestedObjectValueOf[patternDocumentAnyOf] `tfsdk:"any_of"`
+	Fields fwtypes.ListNestedObjectValueOf[patternDocumentField] `tfsdk:"field"`
+	JSON   types.String                                          `tfsdk:"json"`
+}
+
+type patternDocumentAnyOf struct {
+	Path     types.String                                            `tfsdk:"path"`
+	Patterns fwtypes.ListNestedObjectValueOf[patternDocumentPattern] `tfsdk:"pattern"`
+}
+
+type patternDocumentPattern struct {
+	Fields fwtypes.ListNestedObjectValueOf[patternDocumentField] `tfsdk:"field"`
+}
+
+type patternDocumentField struct {
+	AnythingBut      fwtypes.ListNestedObjectValueOf[patternDocumentAnythingBut] `tfsdk:"anything_but"`
+	CIDR             fwtypes.ListValueOf[types.String]                           `tfsdk:"cidr"`
+	Equals           fwtypes.ListValueOf[types.String]                           `tfsdk:"equals"`
+	EqualsBoolean    types.List                                                  `tfsdk:"equals_boolean"`
+	EqualsIgnoreCase fwtypes.ListValueOf[types.String]                           `tfsdk:"equals_ignore_case"`
+	EqualsNull       types.Bool                                                  `tfsdk:"equals_null"`
+	EqualsNumber     types.List                                                  `tfsdk:"equals_number"`
+	Exists           types.Bool                                                  `tfsdk:"exists"`
+	Numeric          fwtypes.ListNestedObjectValueOf[patternDocumentNumeric]     `tfsdk:"numeric"`
+	Path             types.String                                                `tfsdk:"path"`
+	Prefix           fwtypes.ListValueOf[types.String]                           `tfsdk:"prefix"`
+	PrefixIgnoreCase fwtypes.ListValueOf[types.String]                           `tfsdk:"prefix_ignore_case"`
+	Suffix           fwtypes.ListValueOf[types.String]                           `tfsdk:"suffix"`
+	SuffixIgnoreCase fwtypes.ListValueOf[types.String]                           `tfsdk:"suffix_ignore_case"`
+	Wildcard         fwtypes.ListValueOf[types.String]                           `tfsdk:"wildcard"`
+}
+
+// expand returns the field's matchers.
+func (m *patternDocumentField) expand(ctx context.Context) ([]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var matchers []any
+
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, m.Equals) {
+		matchers = append(matchers, v)
+	}
+
+	if !m.EqualsNumber.IsNull() {
+		var numbers []float64
+		diags.Append(m.EqualsNumber.ElementsAs(ctx, &numbers, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, v := range numbers {
+			matchers = append(matchers, v)
+		}
+	}
+
+	if !m.EqualsBoolean.IsNull() {
+		var booleans []bool
+		diags.Append(m.EqualsBoolean.ElementsAs(ctx, &booleans, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, v := range booleans {
+			matchers = append(matchers, v)
+		}
+	}
+
+	if m.EqualsNull.ValueBool() {
+		matchers = append(matchers, nil)
+	}
+
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, m.EqualsIgnoreCase) {
+		matchers = append(matchers, map[string]any{"equals-ignore-case": v})
+	}
+
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, m.Prefix) {
+		matchers = append(matchers, map[string]any{"prefix": v})
+	}
+
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, m.PrefixIgnoreCase) {
+		matchers = append(matchers, map[string]any{"prefix": map[string]any{"equals-ignore-case": v}})
+	}
+
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, m.Suffix) {
+		matchers = append(matchers, map[string]any{"suffix": v})
+	}
+
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, m.SuffixIgnoreCase) {
+		matchers = append(matchers, map[string]any{"suffix": map[string]any{"equals-ignore-case": v}})
+	}
+
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, m.Wildcard) {
+		matchers = append(matchers, map[string]any{"wildcard": v})
+	}
+
+	for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, m.CIDR) {
+		matchers = append(matchers, map[string]any{"cidr": v})
+	}
+
+	if !m.Exists.IsNull() {
+		matchers = append(matchers, map[string]any{"exists": m.Exists.ValueBool()})
+	}
+
+	numerics, d := m.Numeric.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, v := range numerics {
+		conditions, err := v.expand()
+
+		if err != nil {
+			diags.AddError("Invalid numeric matcher", fmt.Sprintf("Field (%s): %s", m.Path.ValueString(), err))
+			return nil, diags
+		}
+
+		matchers = append(matchers, map[string]any{"numeric": conditions})
+	}
+
+	anythingBut, d := m.AnythingBut.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if anythingBut != nil {
+		operand, d := anythingBut.expand(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if operand == nil {
+			diags.AddError("Invalid anything_but matcher", fmt.Sprintf("Field (%s): exactly one of equals, equals_ignore_case, equals_number, prefix, suffix or wildcard must be specified.", m.Path.ValueString()))
+			return nil, diags
+		}
+
+		matchers = append(matchers, map[string]any{"anything-but": operand})
+	}
+
+	return matchers, diags
+}
+
+type patternDocumentAnythingBut struct {
+	Equals           fwtypes.ListValueOf[types.String] `tfsdk:"equals"`
+	EqualsIgnoreCase fwtypes.ListValueOf[types.String] `tfsdk:"equals_ignore_case"`
+	EqualsNumber     types.List                        `tfsdk:"equals_number"`
+	Prefix           types.String                      `tfsdk:"prefix"`
+	Suffix           types.String                      `tfsdk:"suffix"`
+	Wildcard         fwtypes.ListValueOf[types.String] `tfsdk:"wildcard"`
+}
+
+// expand returns the operand of the anything-but matcher, or nil if not exactly one exclusion is specified.
+func (m *patternDocumentAnythingBut) expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var operands []any
+
+	if !m.Equals.IsNull() || !m.EqualsNumber.IsNull() {
+		var values []any
+
+		for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, m.Equals) {
+			values = append(values, v)
+		}
+
+		if !m.EqualsNumber.IsNull() {
+			var numbers []float64
+			diags.Append(m.EqualsNumber.ElementsAs(ctx, &numbers, false)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			for _, v := range numbers {
+				values = append(values, v)
+			}
+		}
+
+		operands = append(operands, values)
+	}
+
+	if !m.EqualsIgnoreCase.IsNull() {
+		operands = append(operands, map[string]any{"equals-ignore-case": fwflex.ExpandFrameworkStringValueList(ctx, m.EqualsIgnoreCase)})
+	}
+
+	if !m.Prefix.IsNull() {
+		operands = append(operands, map[string]any{"prefix": m.Prefix.ValueString()})
+	}
+
+	if !m.Suffix.IsNull() {
+		operands = append(operands, map[string]any{"suffix": m.Suffix.ValueString()})
+	}
+
+	if !m.Wildcard.IsNull() {
+		operands = append(operands, map[string]any{"wildcard": fwflex.ExpandFrameworkStringValueList(ctx, m.Wildcard)})
+	}
+
+	if len(operands) != 1 {
+		return nil, diags
+	}
+
+	return operands[0], diags
+}
+
+type patternDocumentNumeric struct {
+	Equal              types.Float64 `tfsdk:"equal"`
+	GreaterThan        types.Float64 `tfsdk:"greater_than"`
+	GreaterThanOrEqual types.Float64 `tfsdk:"greater_than_or_equal"`
+	LessThan           types.Float64 `tfsdk:"less_than"`
+	LessThanOrEqual    types.Float64 `tfsdk:"less_than_or_equal"`
+}
+
+// expand returns the conditions of the numeric matcher: either an equality or at most one lower and one upper bound.
+func (m *patternDocumentNumeric) expand() ([]any, error) {
+	if !m.Equal.IsNull() {
+		if !m.GreaterThan.IsNull() || !m.GreaterThanOrEqual.IsNull() || !m.LessThan.IsNull() || !m.LessThanOrEqual.IsNull() {
+			return nil, fmt.Errorf("equal cannot be combined with other comparisons")
+		}
+
+		return []any{"=", m.Equal.ValueFloat64()}, nil
+	}
+
+	if !m.GreaterThan.IsNull() && !m.GreaterThanOrEqual.IsNull() {
+		return nil, fmt.Errorf("only one of greater_than or greater_than_or_equal can be specified")
+	}
+
+	if !m.LessThan.IsNull() && !m.LessThanOrEqual.IsNull() {
+		return nil, fmt.Errorf("only one of less_than or less_than_or_equal can be specified")
+	}
+
+	var conditions []any
+
+	if !m.GreaterThan.IsNull() {
+		conditions = append(conditions, ">", m.GreaterThan.ValueFloat64())
+	}
+
+	if !m.GreaterThanOrEqual.IsNull() {
+		conditions = append(conditions, ">=", m.GreaterThanOrEqual.ValueFloat64())
+	}
+
+	if !m.LessThan.IsNull() {
+		conditions = append(conditions, "<", m.LessThan.ValueFloat64())
+	}
+
+	if !m.LessThanOrEqual.IsNull() {
+		conditions = append(conditions, "<=", m.LessThanOrEqual.ValueFloat64())
+	}
+
+	if len(conditions) == 0 {
+		return nil, fmt.Errorf("at least one comparison must be specified")
+	}
+
+	return conditions, nil
+}
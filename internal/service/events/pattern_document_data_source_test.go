@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEventsPatternDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_event_pattern_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, `{
+  "source": ["aws.ec2"],
+  "detail-type": [{"prefix": "EC2 Instance"}],
+  "detail": {
+    "state": ["running", {"equals-ignore-case": "PENDING"}, {"anything-but": {"prefix": "stop"}}],
+    "cpu": [{"numeric": [">", 50, "<=", 100]}],
+    "source-ip": [{"cidr": "10.0.0.0/24"}],
+    "file": [{"wildcard": "images/*.png"}],
+    "reason": [{"exists": false}]
+  }
+}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternDocumentDataSource_anyOf(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_event_pattern_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternDocumentDataSourceConfig_anyOf,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, `{
+  "source": ["aws.ec2"],
+  "detail": {
+    "$or": [
+      {"state": ["terminated"]},
+      {"cpu": [{"numeric": ["=", 0]}]}
+    ]
+  }
+}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPatternDocumentDataSourceConfig_conflictingPaths,
+				ExpectError: regexache.MustCompile(`is both a field and an object containing other fields`),
+			},
+			{
+				Config:      testAccPatternDocumentDataSourceConfig_noMatchers,
+				ExpectError: regexache.MustCompile(`must specify at least one matcher`),
+			},
+		},
+	})
+}
+
+func TestAccEventsPatternDocumentDataSource_rule(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatternDocumentDataSourceConfig_rule(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "event_pattern", `{"source": ["aws.ec2"], "detail": {"state": ["running"]}}`),
+				),
+			},
+		},
+	})
+}
+
+const testAccPatternDocumentDataSourceConfig_basic = `
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path   = "source"
+    equals = ["aws.ec2"]
+  }
+
+  field {
+    path   = "detail-type"
+    prefix = ["EC2 Instance"]
+  }
+
+  field {
+    path   = "detail.state"
+    equals = ["running"]
+  }
+
+  field {
+    path               = "detail.state"
+    equals_ignore_case = ["PENDING"]
+
+    anything_but {
+      prefix = "stop"
+    }
+  }
+
+  field {
+    path = "detail.cpu"
+
+    numeric {
+      greater_than       = 50
+      less_than_or_equal = 100
+    }
+  }
+
+  field {
+    path = "detail.source-ip"
+    cidr = ["10.0.0.0/24"]
+  }
+
+  field {
+    path     = "detail.file"
+    wildcard = ["images/*.png"]
+  }
+
+  field {
+    path   = "detail.reason"
+    exists = false
+  }
+}
+`
+
+const testAccPatternDocumentDataSourceConfig_anyOf = `
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path   = "source"
+    equals = ["aws.ec2"]
+  }
+
+  any_of {
+    path = "detail"
+
+    pattern {
+      field {
+        path   = "state"
+        equals = ["terminated"]
+      }
+    }
+
+    pattern {
+      field {
+        path = "cpu"
+
+        numeric {
+          equal = 0
+        }
+      }
+    }
+  }
+}
+`
+
+const testAccPatternDocumentDataSourceConfig_conflictingPaths = `
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path   = "detail"
+    exists = true
+  }
+
+  field {
+    path   = "detail.state"
+    equals = ["running"]
+  }
+}
+`
+
+const testAccPatternDocumentDataSourceConfig_noMatchers = `
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path = "source"
+  }
+}
+`
+
+func testAccPatternDocumentDataSourceConfig_rule(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path   = "source"
+    equals = ["aws.ec2"]
+  }
+
+  field {
+    path   = "detail.state"
+    equals = ["running"]
+  }
+}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name          = %[1]q
+  event_pattern = data.aws_cloudwatch_event_pattern_document.test.json
+}
+`, rName)
+}
@@ -21,6 +21,11 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			TypeName: "aws_cloudwatch_event_buses",
 			Name:     "Event Buses",
 		},
+		{
+			Factory:  newPatternDocumentDataSource,
+			TypeName: "aws_cloudwatch_event_pattern_document",
+			Name:     "Pattern Document",
+		},
 	}
 }
 
@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/fis/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_fis_experiment", name="Experiment")
+// @Tags(identifierAttribute="arn")
+func newExperimentResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &experimentResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type experimentResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[experimentResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *experimentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"actions_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ActionsMode](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"experiment_template_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ExperimentStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state_reason": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *experimentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data experimentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().FISClient(ctx)
+
+	templateID := data.ExperimentTemplateID.ValueString()
+	input := fis.StartExperimentInput{
+		ClientToken:          aws.String(id.UniqueId()),
+		ExperimentTemplateId: aws.String(templateID),
+		Tags:                 getTagsIn(ctx),
+	}
+	if !data.ActionsMode.IsNull() && !data.ActionsMode.IsUnknown() {
+		input.ExperimentOptions = &awstypes.StartExperimentExperimentOptionsInput{
+			ActionsMode: data.ActionsMode.ValueEnum(),
+		}
+	}
+
+	output, err := conn.StartExperiment(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting FIS Experiment (%s)", templateID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.Experiment.Id)
+
+	experiment, err := waitExperimentFinished(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for FIS Experiment (%s) finish", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.flatten(ctx, experiment)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *experimentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data experimentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().FISClient(ctx)
+
+	output, err := findExperimentByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading FIS Experiment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.flatten(ctx, output)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *experimentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data experimentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().FISClient(ctx)
+
+	// Experiments cannot be deleted. Stop the experiment if it is still in progress.
+	output, err := findExperimentByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading FIS Experiment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if status := output.State.Status; !experimentInProgress(status) {
+		return
+	}
+
+	input := fis.StopExperimentInput{
+		Id: data.ID.ValueStringPointer(),
+	}
+	_, err = conn.StopExperiment(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("stopping FIS Experiment (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitExperimentFinished(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for FIS Experiment (%s) stop", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findExperimentByID(ctx context.Context, conn *fis.Client, id string) (*awstypes.Experiment, error) {
+	input := &fis.GetExperimentInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetExperiment(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Experiment == nil || output.Experiment.State == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Experiment, nil
+}
+
+func statusExperiment(ctx context.Context, conn *fis.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findExperimentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State.Status), nil
+	}
+}
+
+func experimentInProgress(status awstypes.ExperimentStatus) bool {
+	switch status {
+	case awstypes.ExperimentStatusPending, awstypes.ExperimentStatusInitiating, awstypes.ExperimentStatusRunning, awstypes.ExperimentStatusStopping:
+		return true
+	default:
+		return false
+	}
+}
+
+// waitExperimentFinished waits for an experiment to reach any terminal state.
+// A failed or cancelled experiment is not an error; the final state and reason are reported via attributes.
+func waitExperimentFinished(ctx context.Context, conn *fis.Client, id string, timeout time.Duration) (*awstypes.Experiment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.ExperimentStatusPending, awstypes.ExperimentStatusInitiating, awstypes.ExperimentStatusRunning, awstypes.ExperimentStatusStopping),
+		Target:       enum.Slice(awstypes.ExperimentStatusCompleted, awstypes.ExperimentStatusStopped, awstypes.ExperimentStatusFailed, awstypes.ExperimentStatusCancelled),
+		Refresh:      statusExperiment(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Experiment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type experimentResourceModel struct {
+	ActionsMode          fwtypes.StringEnum[awstypes.ActionsMode]      `tfsdk:"actions_mode"`
+	ARN                  types.String                                  `tfsdk:"arn"`
+	EndTime              timetypes.RFC3339                             `tfsdk:"end_time"`
+	ExperimentTemplateID types.String                                  `tfsdk:"experiment_template_id"`
+	ID                   types.String                                  `tfsdk:"id"`
+	StartTime            timetypes.RFC3339                             `tfsdk:"start_time"`
+	State                fwtypes.StringEnum[awstypes.ExperimentStatus] `tfsdk:"state"`
+	StateReason          types.String                                  `tfsdk:"state_reason"`
+	Tags                 tftags.Map                                    `tfsdk:"tags"`
+	TagsAll              tftags.Map                                    `tfsdk:"tags_all"`
+	Timeouts             timeouts.Value                                `tfsdk:"timeouts"`
+}
+
+func (m *experimentResourceModel) flatten(ctx context.Context, experiment *awstypes.Experiment) {
+	if v := experiment.ExperimentOptions; v != nil && v.ActionsMode != "" {
+		m.ActionsMode = fwtypes.StringEnumValue(v.ActionsMode)
+	} else {
+		m.ActionsMode = fwtypes.StringEnumNull[awstypes.ActionsMode]()
+	}
+	m.ARN = fwflex.StringToFramework(ctx, experiment.Arn)
+	m.EndTime = timetypes.NewRFC3339TimePointerValue(experiment.EndTime)
+	m.ExperimentTemplateID = fwflex.StringToFramework(ctx, experiment.ExperimentTemplateId)
+	m.StartTime = timetypes.NewRFC3339TimePointerValue(experiment.StartTime)
+	m.State = fwtypes.StringEnumValue(experiment.State.Status)
+	m.StateReason = fwflex.StringToFramework(ctx, experiment.State.Reason)
+}
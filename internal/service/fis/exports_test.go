@@ -5,7 +5,11 @@ package fis
 
 // Exports for use in tests only.
 var (
-	ResourceExperimentTemplate = resourceExperimentTemplate
+	ResourceExperiment                 = newExperimentResource
+	ResourceExperimentTemplate         = resourceExperimentTemplate
+	ResourceTargetAccountConfiguration = newTargetAccountConfigurationResource
 
-	FindExperimentTemplateByID = findExperimentTemplateByID
+	FindExperimentByID                         = findExperimentByID
+	FindExperimentTemplateByID                 = findExperimentTemplateByID
+	FindTargetAccountConfigurationByTwoPartKey = findTargetAccountConfigurationByTwoPartKey
 )
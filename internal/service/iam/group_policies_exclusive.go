@@ -20,7 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -154,33 +154,26 @@ func (r *resourceGroupPoliciesExclusive) syncAttachments(ctx context.Context, gr
 		return err
 	}
 
-	create, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
-
-	for _, name := range create {
-		in := &iam.PutGroupPolicyInput{
-			GroupName:  aws.String(groupName),
-			PolicyName: aws.String(name),
-		}
+	return exclusive.Sync(ctx, have, want,
+		func(ctx context.Context, name string) error {
+			in := &iam.PutGroupPolicyInput{
+				GroupName:  aws.String(groupName),
+				PolicyName: aws.String(name),
+			}
 
-		_, err := conn.PutGroupPolicy(ctx, in)
-		if err != nil {
+			_, err := conn.PutGroupPolicy(ctx, in)
 			return err
-		}
-	}
-
-	for _, name := range remove {
-		in := &iam.DeleteGroupPolicyInput{
-			GroupName:  aws.String(groupName),
-			PolicyName: aws.String(name),
-		}
+		},
+		func(ctx context.Context, name string) error {
+			in := &iam.DeleteGroupPolicyInput{
+				GroupName:  aws.String(groupName),
+				PolicyName: aws.String(name),
+			}
 
-		_, err := conn.DeleteGroupPolicy(ctx, in)
-		if err != nil {
+			_, err := conn.DeleteGroupPolicy(ctx, in)
 			return err
-		}
-	}
-
-	return nil
+		},
+	)
 }
 
 func (r *resourceGroupPoliciesExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
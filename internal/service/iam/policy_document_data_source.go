@@ -17,11 +17,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
+const (
+	policyDocumentLintError = "error"
+	policyDocumentLintOff   = "off"
+	policyDocumentLintWarn  = "warn"
+)
+
+func policyDocumentLint_Values() []string {
+	return []string{
+		policyDocumentLintError,
+		policyDocumentLintOff,
+		policyDocumentLintWarn,
+	}
+}
+
 // @SDKDataSource("aws_iam_policy_document", name="Policy Document")
 func dataSourcePolicyDocument() *schema.Resource {
 	return &schema.Resource{
@@ -64,6 +79,12 @@ func dataSourcePolicyDocument() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"lint": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      policyDocumentLintWarn,
+					ValidateFunc: validation.StringInSlice(policyDocumentLint_Values(), false),
+				},
 				"minified_json": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -327,6 +348,27 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
+	if lint := d.Get("lint").(string); lint != policyDocumentLintOff {
+		findings, err := iampolicy.Lint(jsonString)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "linting IAM Policy Document: %s", err)
+		}
+
+		severity := diag.Warning
+		if lint == policyDocumentLintError {
+			severity = diag.Error
+		}
+
+		for _, finding := range findings {
+			diags = append(diags, diag.Diagnostic{
+				Severity: severity,
+				Summary:  finding.Summary,
+				Detail:   finding.String(),
+			})
+		}
+	}
+
 	return diags
 }
 
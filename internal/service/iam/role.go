@@ -10,10 +10,12 @@ import (
 	"log"
 	"net/url"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
@@ -41,6 +43,7 @@ const (
 
 // @SDKResource("aws_iam_role", name="Role")
 // @Tags(identifierAttribute="name", resourceType="Role")
+// @ARNLookup(service="iam", resourceType="role", finder="lookupRoleByARN")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.Role")
 func resourceRole() *schema.Resource {
 	return &schema.Resource{
@@ -628,6 +631,24 @@ func retryCreateRole(ctx context.Context, conn *iam.Client, input *iam.CreateRol
 	return output, err
 }
 
+// lookupRoleByARN finds the IAM role with the specified ARN for lookup-by-ARN.
+func lookupRoleByARN(ctx context.Context, meta any, roleARN string) error {
+	v, err := arn.Parse(roleARN)
+	if err != nil {
+		return err
+	}
+
+	// The role name is the last element of the resource path, e.g. `role/path/name`.
+	resource := v.Resource
+	if i := strings.LastIndex(resource, "/"); i >= 0 {
+		resource = resource[i+1:]
+	}
+
+	_, err = findRoleByName(ctx, meta.(*conns.AWSClient).IAMClient(ctx), resource)
+
+	return err
+}
+
 func findRoleByName(ctx context.Context, conn *iam.Client, name string) (*awstypes.Role, error) {
 	input := &iam.GetRoleInput{
 		RoleName: aws.String(name),
@@ -20,7 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -154,33 +154,26 @@ func (r *resourceRolePoliciesExclusive) syncAttachments(ctx context.Context, rol
 		return err
 	}
 
-	create, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
-
-	for _, name := range create {
-		in := &iam.PutRolePolicyInput{
-			RoleName:   aws.String(roleName),
-			PolicyName: aws.String(name),
-		}
+	return exclusive.Sync(ctx, have, want,
+		func(ctx context.Context, name string) error {
+			in := &iam.PutRolePolicyInput{
+				RoleName:   aws.String(roleName),
+				PolicyName: aws.String(name),
+			}
 
-		_, err := conn.PutRolePolicy(ctx, in)
-		if err != nil {
+			_, err := conn.PutRolePolicy(ctx, in)
 			return err
-		}
-	}
-
-	for _, name := range remove {
-		in := &iam.DeleteRolePolicyInput{
-			RoleName:   aws.String(roleName),
-			PolicyName: aws.String(name),
-		}
+		},
+		func(ctx context.Context, name string) error {
+			in := &iam.DeleteRolePolicyInput{
+				RoleName:   aws.String(roleName),
+				PolicyName: aws.String(name),
+			}
 
-		_, err := conn.DeleteRolePolicy(ctx, in)
-		if err != nil {
+			_, err := conn.DeleteRolePolicy(ctx, in)
 			return err
-		}
-	}
-
-	return nil
+		},
+	)
 }
 
 func (r *resourceRolePoliciesExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -20,7 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -154,23 +154,14 @@ func (r *resourceRolePolicyAttachmentsExclusive) syncAttachments(ctx context.Con
 		return err
 	}
 
-	create, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
-
-	for _, arn := range create {
-		err := attachPolicyToRole(ctx, conn, roleName, arn)
-		if err != nil {
-			return err
-		}
-	}
-
-	for _, arn := range remove {
-		err := detachPolicyFromRole(ctx, conn, roleName, arn)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return exclusive.Sync(ctx, have, want,
+		func(ctx context.Context, arn string) error {
+			return attachPolicyToRole(ctx, conn, roleName, arn)
+		},
+		func(ctx context.Context, arn string) error {
+			return detachPolicyFromRole(ctx, conn, roleName, arn)
+		},
+	)
 }
 
 func (r *resourceRolePolicyAttachmentsExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
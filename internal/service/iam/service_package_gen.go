@@ -229,6 +229,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrName,
 				ResourceType:        "Role",
 			},
+			ARNLookup: &types.ServicePackageResourceARNLookup{
+				Service:      "iam",
+				ResourceType: "role",
+				Find:         lookupRoleByARN,
+			},
 		},
 		{
 			Factory:  resourceRolePolicy,
@@ -20,7 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -154,33 +154,26 @@ func (r *resourceUserPoliciesExclusive) syncAttachments(ctx context.Context, use
 		return err
 	}
 
-	create, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
-
-	for _, name := range create {
-		in := &iam.PutUserPolicyInput{
-			UserName:   aws.String(userName),
-			PolicyName: aws.String(name),
-		}
+	return exclusive.Sync(ctx, have, want,
+		func(ctx context.Context, name string) error {
+			in := &iam.PutUserPolicyInput{
+				UserName:   aws.String(userName),
+				PolicyName: aws.String(name),
+			}
 
-		_, err := conn.PutUserPolicy(ctx, in)
-		if err != nil {
+			_, err := conn.PutUserPolicy(ctx, in)
 			return err
-		}
-	}
-
-	for _, name := range remove {
-		in := &iam.DeleteUserPolicyInput{
-			UserName:   aws.String(userName),
-			PolicyName: aws.String(name),
-		}
+		},
+		func(ctx context.Context, name string) error {
+			in := &iam.DeleteUserPolicyInput{
+				UserName:   aws.String(userName),
+				PolicyName: aws.String(name),
+			}
 
-		_, err := conn.DeleteUserPolicy(ctx, in)
-		if err != nil {
+			_, err := conn.DeleteUserPolicy(ctx, in)
 			return err
-		}
-	}
-
-	return nil
+		},
+	)
 }
 
 func (r *resourceUserPoliciesExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
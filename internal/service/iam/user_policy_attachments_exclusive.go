@@ -20,7 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
-	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -154,23 +154,14 @@ func (r *resourceUserPolicyAttachmentsExclusive) syncAttachments(ctx context.Con
 		return err
 	}
 
-	create, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
-
-	for _, arn := range create {
-		err := attachPolicyToUser(ctx, conn, userName, arn)
-		if err != nil {
-			return err
-		}
-	}
-
-	for _, arn := range remove {
-		err := detachPolicyFromUser(ctx, conn, userName, arn)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return exclusive.Sync(ctx, have, want,
+		func(ctx context.Context, arn string) error {
+			return attachPolicyToUser(ctx, conn, userName, arn)
+		},
+		func(ctx context.Context, arn string) error {
+			return detachPolicyFromUser(ctx, conn, userName, arn)
+		},
+	)
 }
 
 func (r *resourceUserPolicyAttachmentsExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,559 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_inspector2_cis_scan_configuration", name="CIS Scan Configuration")
+// @Tags(identifierAttribute="arn")
+func newCISScanConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &cisScanConfigurationResource{}
+
+	return r, nil
+}
+
+type cisScanConfigurationResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *cisScanConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	startTimeBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[cisTimeModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"time_of_day": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(regexache.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`), "must be in the format HH:MM"),
+					},
+				},
+				"timezone": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+	scheduleTypes := []path.Expression{
+		path.MatchRelative().AtParent().AtName("daily"),
+		path.MatchRelative().AtParent().AtName("monthly"),
+		path.MatchRelative().AtParent().AtName("one_time"),
+		path.MatchRelative().AtParent().AtName("weekly"),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"scan_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"security_level": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CisSecurityLevel](),
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrSchedule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[cisScheduleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"daily": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cisDailyScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(scheduleTypes...),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									names.AttrStartTime: startTimeBlock,
+								},
+							},
+						},
+						"monthly": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cisMonthlyScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"day": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Day](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrStartTime: startTimeBlock,
+								},
+							},
+						},
+						"one_time": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cisOneTimeScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+						},
+						"weekly": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[cisWeeklyScheduleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"days": schema.SetAttribute{
+										CustomType: fwtypes.SetOfStringEnumType[awstypes.Day](),
+										Required:   true,
+										Validators: []validator.Set{
+											setvalidator.SizeAtLeast(1),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrStartTime: startTimeBlock,
+								},
+							},
+						},
+					},
+				},
+			},
+			"targets": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[cisTargetsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_ids": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, 10000),
+							},
+						},
+						"target_resource_tags": schema.MapAttribute{
+							ElementType: types.ListType{ElemType: types.StringType},
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *cisScanConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	name := data.ScanName.ValueString()
+	var input inspector2.CreateCisScanConfigurationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithIgnoredFieldNamesAppend("Targets"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	accountIDs, targetResourceTags, diags := data.expandTargets(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.Targets = &awstypes.CreateCisTargets{
+		AccountIds:         accountIDs,
+		TargetResourceTags: targetResourceTags,
+	}
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateCisScanConfiguration(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Inspector2 CIS Scan Configuration (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ScanConfigurationARN = fwflex.StringToFramework(ctx, output.ScanConfigurationArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *cisScanConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	output, err := findCISScanConfigurationByARN(ctx, conn, data.ScanConfigurationARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 CIS Scan Configuration (%s)", data.ScanConfigurationARN.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithIgnoredFieldNamesAppend("Targets"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(data.flattenTargets(ctx, output.Targets)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *cisScanConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input inspector2.UpdateCisScanConfigurationInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, fwflex.WithIgnoredFieldNamesAppend("Targets"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		accountIDs, targetResourceTags, diags := new.expandTargets(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.ScanConfigurationArn = new.ScanConfigurationARN.ValueStringPointer()
+		input.Targets = &awstypes.UpdateCisTargets{
+			AccountIds:         accountIDs,
+			TargetResourceTags: targetResourceTags,
+		}
+
+		_, err := conn.UpdateCisScanConfiguration(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Inspector2 CIS Scan Configuration (%s)", new.ScanConfigurationARN.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *cisScanConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data cisScanConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	input := inspector2.DeleteCisScanConfigurationInput{
+		ScanConfigurationArn: data.ScanConfigurationARN.ValueStringPointer(),
+	}
+	_, err := conn.DeleteCisScanConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Inspector2 CIS Scan Configuration (%s)", data.ScanConfigurationARN.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *cisScanConfigurationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+func findCISScanConfigurationByARN(ctx context.Context, conn *inspector2.Client, arn string) (*awstypes.CisScanConfiguration, error) {
+	input := inspector2.ListCisScanConfigurationsInput{
+		FilterCriteria: &awstypes.ListCisScanConfigurationsFilterCriteria{
+			ScanConfigurationArnFilters: []awstypes.CisStringFilter{
+				{
+					Comparison: awstypes.CisStringComparisonEquals,
+					Value:      aws.String(arn),
+				},
+			},
+		},
+	}
+
+	return findCISScanConfiguration(ctx, conn, &input)
+}
+
+func findCISScanConfiguration(ctx context.Context, conn *inspector2.Client, input *inspector2.ListCisScanConfigurationsInput) (*awstypes.CisScanConfiguration, error) {
+	output, err := findCISScanConfigurations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCISScanConfigurations(ctx context.Context, conn *inspector2.Client, input *inspector2.ListCisScanConfigurationsInput) ([]awstypes.CisScanConfiguration, error) {
+	var output []awstypes.CisScanConfiguration
+
+	pages := inspector2.NewListCisScanConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ScanConfigurations...)
+	}
+
+	return output, nil
+}
+
+type cisScanConfigurationResourceModel struct {
+	ScanConfigurationARN types.String                                      `tfsdk:"arn"`
+	ScanName             types.String                                      `tfsdk:"scan_name"`
+	Schedule             fwtypes.ListNestedObjectValueOf[cisScheduleModel] `tfsdk:"schedule"`
+	SecurityLevel        fwtypes.StringEnum[awstypes.CisSecurityLevel]     `tfsdk:"security_level"`
+	Tags                 tftags.Map                                        `tfsdk:"tags"`
+	TagsAll              tftags.Map                                        `tfsdk:"tags_all"`
+	Targets              fwtypes.ListNestedObjectValueOf[cisTargetsModel]  `tfsdk:"targets"`
+}
+
+// The target resource tags are a map of lists of strings, which AutoFlex doesn't support.
+func (m cisScanConfigurationResourceModel) expandTargets(ctx context.Context) ([]string, map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := m.Targets.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, nil, diags
+	}
+
+	var targetResourceTags map[string][]string
+	diags.Append(data.TargetResourceTags.ElementsAs(ctx, &targetResourceTags, false)...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	return fwflex.ExpandFrameworkStringValueSet(ctx, data.AccountIDs), targetResourceTags, diags
+}
+
+func (m *cisScanConfigurationResourceModel) flattenTargets(ctx context.Context, apiObject *awstypes.CisTargets) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		m.Targets = fwtypes.NewListNestedObjectValueOfNull[cisTargetsModel](ctx)
+
+		return diags
+	}
+
+	var data cisTargetsModel
+	diags.Append(fwflex.Flatten(ctx, apiObject, &data, fwflex.WithIgnoredFieldNamesAppend("TargetResourceTags"))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	targetResourceTags, d := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, apiObject.TargetResourceTags)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	data.TargetResourceTags = targetResourceTags
+
+	m.Targets = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	return diags
+}
+
+type cisScheduleModel struct {
+	Daily   fwtypes.ListNestedObjectValueOf[cisDailyScheduleModel]   `tfsdk:"daily"`
+	Monthly fwtypes.ListNestedObjectValueOf[cisMonthlyScheduleModel] `tfsdk:"monthly"`
+	OneTime fwtypes.ListNestedObjectValueOf[cisOneTimeScheduleModel] `tfsdk:"one_time"`
+	Weekly  fwtypes.ListNestedObjectValueOf[cisWeeklyScheduleModel]  `tfsdk:"weekly"`
+}
+
+var (
+	_ fwflex.Expander  = cisScheduleModel{}
+	_ fwflex.Flattener = &cisScheduleModel{}
+)
+
+func (m cisScheduleModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data, d := m.Daily.ToPtr(ctx); d.HasError() {
+		return nil, append(diags, d...)
+	} else if data != nil {
+		var apiObject awstypes.ScheduleMemberDaily
+		diags.Append(fwflex.Expand(ctx, data, &apiObject.Value)...)
+		return &apiObject, diags
+	}
+
+	if data, d := m.Monthly.ToPtr(ctx); d.HasError() {
+		return nil, append(diags, d...)
+	} else if data != nil {
+		var apiObject awstypes.ScheduleMemberMonthly
+		diags.Append(fwflex.Expand(ctx, data, &apiObject.Value)...)
+		return &apiObject, diags
+	}
+
+	if data, d := m.OneTime.ToPtr(ctx); d.HasError() {
+		return nil, append(diags, d...)
+	} else if data != nil {
+		return &awstypes.ScheduleMemberOneTime{}, diags
+	}
+
+	if data, d := m.Weekly.ToPtr(ctx); d.HasError() {
+		return nil, append(diags, d...)
+	} else if data != nil {
+		var apiObject awstypes.ScheduleMemberWeekly
+		diags.Append(fwflex.Expand(ctx, data, &apiObject.Value)...)
+		return &apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func (m *cisScheduleModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Daily = fwtypes.NewListNestedObjectValueOfNull[cisDailyScheduleModel](ctx)
+	m.Monthly = fwtypes.NewListNestedObjectValueOfNull[cisMonthlyScheduleModel](ctx)
+	m.OneTime = fwtypes.NewListNestedObjectValueOfNull[cisOneTimeScheduleModel](ctx)
+	m.Weekly = fwtypes.NewListNestedObjectValueOfNull[cisWeeklyScheduleModel](ctx)
+
+	switch t := v.(type) {
+	case awstypes.ScheduleMemberDaily:
+		var data cisDailyScheduleModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+		m.Daily = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	case awstypes.ScheduleMemberMonthly:
+		var data cisMonthlyScheduleModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+		m.Monthly = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	case awstypes.ScheduleMemberOneTime:
+		m.OneTime = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &cisOneTimeScheduleModel{})
+	case awstypes.ScheduleMemberWeekly:
+		var data cisWeeklyScheduleModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &data)...)
+		if diags.HasError() {
+			return diags
+		}
+		m.Weekly = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+	}
+
+	return diags
+}
+
+type cisDailyScheduleModel struct {
+	StartTime fwtypes.ListNestedObjectValueOf[cisTimeModel] `tfsdk:"start_time"`
+}
+
+type cisMonthlyScheduleModel struct {
+	Day       fwtypes.StringEnum[awstypes.Day]              `tfsdk:"day"`
+	StartTime fwtypes.ListNestedObjectValueOf[cisTimeModel] `tfsdk:"start_time"`
+}
+
+type cisOneTimeScheduleModel struct{}
+
+type cisWeeklyScheduleModel struct {
+	Days      fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.Day]] `tfsdk:"days"`
+	StartTime fwtypes.ListNestedObjectValueOf[cisTimeModel]        `tfsdk:"start_time"`
+}
+
+type cisTimeModel struct {
+	TimeOfDay types.String `tfsdk:"time_of_day"`
+	Timezone  types.String `tfsdk:"timezone"`
+}
+
+type cisTargetsModel struct {
+	AccountIDs         fwtypes.SetOfString `tfsdk:"account_ids"`
+	TargetResourceTags types.Map           `tfsdk:"target_resource_tags"`
+}
@@ -5,13 +5,19 @@ package inspector2
 
 // Exports for use in tests only.
 var (
+	ResourceCISScanConfiguration      = newCISScanConfigurationResource
 	ResourceDelegatedAdminAccount     = resourceDelegatedAdminAccount
 	ResourceMemberAssociation         = resourceMemberAssociation
+	ResourceFilter                    = newFilterResource
 	ResourceOrganizationConfiguration = resourceOrganizationConfiguration
+	ResourceSBOMExport                = newSBOMExportResource
 
+	FindCISScanConfigurationByARN = findCISScanConfigurationByARN
 	FindDelegatedAdminAccountByID = findDelegatedAdminAccountByID
+	FindFilterByARN               = findFilterByARN
 	FindMemberByAccountID         = findMemberByAccountID
 	FindOrganizationConfiguration = findOrganizationConfiguration
+	FindSBOMExportByID            = findSBOMExportByID
 
 	EnablerID      = enablerID
 	ParseEnablerID = parseEnablerID
@@ -0,0 +1,522 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_inspector2_filter", name="Filter")
+// @Tags(identifierAttribute="arn")
+func newFilterResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &filterResource{}
+
+	return r, nil
+}
+
+type filterResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *filterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAction: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FilterAction](),
+				Required:   true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"reason": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			tftags.SkipDefaultTagsAttributeName: tftags.SkipDefaultTagsAttribute(),
+			names.AttrTags:                      tftags.TagsAttribute(),
+			names.AttrTagsAll:                   tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"filter_criteria": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[filterCriteriaModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aws_account_id":                     stringFilterBlock(ctx),
+						"code_vulnerability_detector_name":   stringFilterBlock(ctx),
+						"code_vulnerability_detector_tags":   stringFilterBlock(ctx),
+						"code_vulnerability_file_path":       stringFilterBlock(ctx),
+						"component_id":                       stringFilterBlock(ctx),
+						"component_type":                     stringFilterBlock(ctx),
+						"ec2_instance_image_id":              stringFilterBlock(ctx),
+						"ec2_instance_subnet_id":             stringFilterBlock(ctx),
+						"ec2_instance_vpc_id":                stringFilterBlock(ctx),
+						"ecr_image_architecture":             stringFilterBlock(ctx),
+						"ecr_image_hash":                     stringFilterBlock(ctx),
+						"ecr_image_pushed_at":                dateFilterBlock(ctx),
+						"ecr_image_registry":                 stringFilterBlock(ctx),
+						"ecr_image_repository_name":          stringFilterBlock(ctx),
+						"ecr_image_tags":                     stringFilterBlock(ctx),
+						"epss_score":                         numberFilterBlock(ctx),
+						"exploit_available":                  stringFilterBlock(ctx),
+						"finding_arn":                        stringFilterBlock(ctx),
+						"finding_status":                     stringFilterBlock(ctx),
+						"finding_type":                       stringFilterBlock(ctx),
+						"first_observed_at":                  dateFilterBlock(ctx),
+						"fix_available":                      stringFilterBlock(ctx),
+						"inspector_score":                    numberFilterBlock(ctx),
+						"lambda_function_execution_role_arn": stringFilterBlock(ctx),
+						"lambda_function_last_modified_at":   dateFilterBlock(ctx),
+						"lambda_function_layers":             stringFilterBlock(ctx),
+						"lambda_function_name":               stringFilterBlock(ctx),
+						"lambda_function_runtime":            stringFilterBlock(ctx),
+						"last_observed_at":                   dateFilterBlock(ctx),
+						"network_protocol":                   stringFilterBlock(ctx),
+						"port_range": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[portRangeFilterModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"begin_inclusive": schema.Int64Attribute{
+										Optional: true,
+									},
+									"end_inclusive": schema.Int64Attribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"related_vulnerabilities": stringFilterBlock(ctx),
+						names.AttrResourceID:      stringFilterBlock(ctx),
+						"resource_tags":           mapFilterBlock(ctx),
+						names.AttrResourceType:    stringFilterBlock(ctx),
+						"severity":                stringFilterBlock(ctx),
+						"title":                   stringFilterBlock(ctx),
+						"updated_at":              dateFilterBlock(ctx),
+						"vendor_severity":         stringFilterBlock(ctx),
+						"vulnerability_id":        stringFilterBlock(ctx),
+						"vulnerability_source":    stringFilterBlock(ctx),
+						"vulnerable_packages": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[packageFilterModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"architecture":            singleStringFilterBlock(ctx),
+									"epoch":                   singleNumberFilterBlock(ctx),
+									names.AttrName:            singleStringFilterBlock(ctx),
+									"release":                 singleStringFilterBlock(ctx),
+									"source_lambda_layer_arn": singleStringFilterBlock(ctx),
+									"source_layer_hash":       singleStringFilterBlock(ctx),
+									names.AttrVersion:         singleStringFilterBlock(ctx),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func stringFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[stringFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comparison": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.StringComparison](),
+					Required:   true,
+				},
+				names.AttrValue: schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func singleStringFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	block := stringFilterBlock(ctx)
+	block.Validators = []validator.List{
+		listvalidator.SizeAtMost(1),
+	}
+
+	return block
+}
+
+func dateFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[dateFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"end_inclusive": schema.StringAttribute{
+					CustomType: timetypes.RFC3339Type{},
+					Optional:   true,
+				},
+				"start_inclusive": schema.StringAttribute{
+					CustomType: timetypes.RFC3339Type{},
+					Optional:   true,
+				},
+			},
+		},
+	}
+}
+
+func numberFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[numberFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"lower_inclusive": schema.Float64Attribute{
+					Optional: true,
+				},
+				"upper_inclusive": schema.Float64Attribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func singleNumberFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	block := numberFilterBlock(ctx)
+	block.Validators = []validator.List{
+		listvalidator.SizeAtMost(1),
+	}
+
+	return block
+}
+
+func mapFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[mapFilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comparison": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.MapComparison](),
+					Required:   true,
+				},
+				names.AttrKey: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrValue: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *filterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data filterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	name := data.Name.ValueString()
+	var input inspector2.CreateFilterInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFilter(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Inspector2 Filter (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *filterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data filterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	output, err := findFilterByARN(ctx, conn, data.ARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 Filter (%s)", data.ARN.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The API returns the filter criteria as Criteria.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.Criteria, &data.FilterCriteria)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *filterResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new filterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input inspector2.UpdateFilterInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.FilterArn = new.ARN.ValueStringPointer()
+
+		_, err := conn.UpdateFilter(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Inspector2 Filter (%s)", new.ARN.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *filterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data filterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	input := inspector2.DeleteFilterInput{
+		Arn: data.ARN.ValueStringPointer(),
+	}
+	_, err := conn.DeleteFilter(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Inspector2 Filter (%s)", data.ARN.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *filterResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+func findFilterByARN(ctx context.Context, conn *inspector2.Client, arn string) (*awstypes.Filter, error) {
+	input := inspector2.ListFiltersInput{
+		Arns: []string{arn},
+	}
+
+	return findFilter(ctx, conn, &input)
+}
+
+func findFilter(ctx context.Context, conn *inspector2.Client, input *inspector2.ListFiltersInput) (*awstypes.Filter, error) {
+	output, err := findFilters(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findFilters(ctx context.Context, conn *inspector2.Client, input *inspector2.ListFiltersInput) ([]awstypes.Filter, error) {
+	var output []awstypes.Filter
+
+	pages := inspector2.NewListFiltersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Filters...)
+	}
+
+	return output, nil
+}
+
+type filterResourceModel struct {
+	Action          fwtypes.StringEnum[awstypes.FilterAction]            `tfsdk:"action"`
+	ARN             types.String                                         `tfsdk:"arn"`
+	Description     types.String                                         `tfsdk:"description"`
+	FilterCriteria  fwtypes.ListNestedObjectValueOf[filterCriteriaModel] `tfsdk:"filter_criteria"`
+	Name            types.String                                         `tfsdk:"name"`
+	Reason          types.String                                         `tfsdk:"reason"`
+	SkipDefaultTags types.Bool                                           `tfsdk:"skip_default_tags"`
+	Tags            tftags.Map                                           `tfsdk:"tags"`
+	TagsAll         tftags.Map                                           `tfsdk:"tags_all"`
+}
+
+type filterCriteriaModel struct {
+	AWSAccountID                   fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"aws_account_id"`
+	CodeVulnerabilityDetectorName  fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"code_vulnerability_detector_name"`
+	CodeVulnerabilityDetectorTags  fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"code_vulnerability_detector_tags"`
+	CodeVulnerabilityFilePath      fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"code_vulnerability_file_path"`
+	ComponentID                    fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"component_id"`
+	ComponentType                  fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"component_type"`
+	EC2InstanceImageID             fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ec2_instance_image_id"`
+	EC2InstanceSubnetID            fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ec2_instance_subnet_id"`
+	EC2InstanceVPCID               fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ec2_instance_vpc_id"`
+	ECRImageArchitecture           fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_architecture"`
+	ECRImageHash                   fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_hash"`
+	ECRImagePushedAt               fwtypes.ListNestedObjectValueOf[dateFilterModel]      `tfsdk:"ecr_image_pushed_at"`
+	ECRImageRegistry               fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_registry"`
+	ECRImageRepositoryName         fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_repository_name"`
+	ECRImageTags                   fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"ecr_image_tags"`
+	EPSSScore                      fwtypes.ListNestedObjectValueOf[numberFilterModel]    `tfsdk:"epss_score"`
+	ExploitAvailable               fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"exploit_available"`
+	FindingARN                     fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"finding_arn"`
+	FindingStatus                  fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"finding_status"`
+	FindingType                    fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"finding_type"`
+	FirstObservedAt                fwtypes.ListNestedObjectValueOf[dateFilterModel]      `tfsdk:"first_observed_at"`
+	FixAvailable                   fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"fix_available"`
+	InspectorScore                 fwtypes.ListNestedObjectValueOf[numberFilterModel]    `tfsdk:"inspector_score"`
+	LambdaFunctionExecutionRoleARN fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_execution_role_arn"`
+	LambdaFunctionLastModifiedAt   fwtypes.ListNestedObjectValueOf[dateFilterModel]      `tfsdk:"lambda_function_last_modified_at"`
+	LambdaFunctionLayers           fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_layers"`
+	LambdaFunctionName             fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_name"`
+	LambdaFunctionRuntime          fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"lambda_function_runtime"`
+	LastObservedAt                 fwtypes.ListNestedObjectValueOf[dateFilterModel]      `tfsdk:"last_observed_at"`
+	NetworkProtocol                fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"network_protocol"`
+	PortRange                      fwtypes.ListNestedObjectValueOf[portRangeFilterModel] `tfsdk:"port_range"`
+	RelatedVulnerabilities         fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"related_vulnerabilities"`
+	ResourceID                     fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"resource_id"`
+	ResourceTags                   fwtypes.ListNestedObjectValueOf[mapFilterModel]       `tfsdk:"resource_tags"`
+	ResourceType                   fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"resource_type"`
+	Severity                       fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"severity"`
+	Title                          fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"title"`
+	UpdatedAt                      fwtypes.ListNestedObjectValueOf[dateFilterModel]      `tfsdk:"updated_at"`
+	VendorSeverity                 fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"vendor_severity"`
+	VulnerabilityID                fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"vulnerability_id"`
+	VulnerabilitySource            fwtypes.ListNestedObjectValueOf[stringFilterModel]    `tfsdk:"vulnerability_source"`
+	VulnerablePackages             fwtypes.ListNestedObjectValueOf[packageFilterModel]   `tfsdk:"vulnerable_packages"`
+}
+
+type stringFilterModel struct {
+	Comparison fwtypes.StringEnum[awstypes.StringComparison] `tfsdk:"comparison"`
+	Value      types.String                                  `tfsdk:"value"`
+}
+
+type dateFilterModel struct {
+	EndInclusive   timetypes.RFC3339 `tfsdk:"end_inclusive"`
+	StartInclusive timetypes.RFC3339 `tfsdk:"start_inclusive"`
+}
+
+type numberFilterModel struct {
+	LowerInclusive types.Float64 `tfsdk:"lower_inclusive"`
+	UpperInclusive types.Float64 `tfsdk:"upper_inclusive"`
+}
+
+type portRangeFilterModel struct {
+	BeginInclusive types.Int64 `tfsdk:"begin_inclusive"`
+	EndInclusive   types.Int64 `tfsdk:"end_inclusive"`
+}
+
+type mapFilterModel struct {
+	Comparison fwtypes.StringEnum[awstypes.MapComparison] `tfsdk:"comparison"`
+	Key        types.String                               `tfsdk:"key"`
+	Value      types.String                               `tfsdk:"value"`
+}
+
+type packageFilterModel struct {
+	Architecture         fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"architecture"`
+	Epoch                fwtypes.ListNestedObjectValueOf[numberFilterModel] `tfsdk:"epoch"`
+	Name                 fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"name"`
+	Release              fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"release"`
+	SourceLambdaLayerARN fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"source_lambda_layer_arn"`
+	SourceLayerHash      fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"source_layer_hash"`
+	Version              fwtypes.ListNestedObjectValueOf[stringFilterModel] `tfsdk:"version"`
+}
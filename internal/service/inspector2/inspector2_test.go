@@ -13,6 +13,11 @@ func TestAccInspector2_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"CISScanConfiguration": {
+			acctest.CtBasic:      testAccCISScanConfiguration_basic,
+			acctest.CtDisappears: testAccCISScanConfiguration_disappears,
+			"update":             testAccCISScanConfiguration_update,
+		},
 		"Enabler": {
 			acctest.CtBasic:                      testAccEnabler_basic,
 			"accountID":                          testAccEnabler_accountID,
@@ -31,6 +36,13 @@ func TestAccInspector2_serial(t *testing.T) {
 			acctest.CtBasic:      testAccDelegatedAdminAccount_basic,
 			acctest.CtDisappears: testAccDelegatedAdminAccount_disappears,
 		},
+		"Filter": {
+			acctest.CtBasic:      testAccFilter_basic,
+			acctest.CtDisappears: testAccFilter_disappears,
+			"update":             testAccFilter_update,
+			"tags":               testAccFilter_tags,
+			"skipDefaultTags":    testAccFilter_skipDefaultTags,
+		},
 		"MemberAssociation": {
 			acctest.CtBasic:      testAccMemberAssociation_basic,
 			acctest.CtDisappears: testAccMemberAssociation_disappears,
@@ -42,6 +54,9 @@ func TestAccInspector2_serial(t *testing.T) {
 			"lambda":             testAccOrganizationConfiguration_lambda,
 			"lambdaCode":         testAccOrganizationConfiguration_lambdaCode,
 		},
+		"SBOMExport": {
+			acctest.CtBasic: testAccSBOMExport_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -0,0 +1,392 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_inspector2_sbom_export", name="SBOM Export")
+func newSBOMExportResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &sbomExportResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type sbomExportResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (r *sbomExportResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"report_format": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SbomReportFormat](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"report_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ExternalReportStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"resource_filter_criteria": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[resourceFilterCriteriaModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"account_id":           resourceStringFilterBlock(ctx),
+						"ec2_instance_tags":    resourceMapFilterBlock(ctx),
+						"ecr_image_tags":       resourceStringFilterBlock(ctx),
+						"ecr_repository_name":  resourceStringFilterBlock(ctx),
+						"lambda_function_name": resourceStringFilterBlock(ctx),
+						"lambda_function_tags": resourceMapFilterBlock(ctx),
+						names.AttrResourceID:   resourceStringFilterBlock(ctx),
+						names.AttrResourceType: resourceStringFilterBlock(ctx),
+					},
+				},
+			},
+			"s3_destination": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[destinationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrBucketName: schema.StringAttribute{
+							Required: true,
+						},
+						"key_prefix": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrKMSKeyARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func resourceStringFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[resourceStringFilterModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeBetween(1, 10),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comparison": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ResourceStringComparison](),
+					Required:   true,
+				},
+				names.AttrValue: schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceMapFilterBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[resourceMapFilterModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeBetween(1, 10),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comparison": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ResourceMapComparison](),
+					Required:   true,
+				},
+				names.AttrKey: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrValue: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func (r *sbomExportResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data sbomExportResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	var input inspector2.CreateSbomExportInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateSbomExport(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Inspector2 SBOM Export", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	reportID := aws.ToString(output.ReportId)
+	data.ReportID = types.StringValue(reportID)
+
+	export, err := waitSBOMExportSucceeded(ctx, conn, reportID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("report_id"), reportID) // Set 'report_id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Inspector2 SBOM Export (%s) create", reportID), err.Error())
+
+		return
+	}
+
+	data.Status = fwtypes.StringEnumValue(export.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *sbomExportResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data sbomExportResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	reportID := data.ReportID.ValueString()
+	output, err := findSBOMExportByID(ctx, conn, reportID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 SBOM Export (%s)", reportID), err.Error())
+
+		return
+	}
+
+	// The Get API returns the request fields under different names.
+	data.ReportFormat = fwtypes.StringEnumValue(output.Format)
+	if !itypes.IsZero(output.FilterCriteria) {
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.FilterCriteria, &data.ResourceFilterCriteria)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.S3Destination, &data.S3Destination)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.Status = fwtypes.StringEnumValue(output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sbomExportResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data sbomExportResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Inspector2Client(ctx)
+
+	// Completed exports are objects in S3 and are not removed; only in-progress exports are cancelled.
+	reportID := data.ReportID.ValueString()
+	output, err := findSBOMExportByID(ctx, conn, reportID)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Inspector2 SBOM Export (%s)", reportID), err.Error())
+
+		return
+	}
+
+	if output.Status != awstypes.ExternalReportStatusInProgress {
+		return
+	}
+
+	input := inspector2.CancelSbomExportInput{
+		ReportId: aws.String(reportID),
+	}
+	_, err = conn.CancelSbomExport(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("cancelling Inspector2 SBOM Export (%s)", reportID), err.Error())
+
+		return
+	}
+}
+
+func findSBOMExportByID(ctx context.Context, conn *inspector2.Client, id string) (*inspector2.GetSbomExportOutput, error) {
+	input := inspector2.GetSbomExportInput{
+		ReportId: aws.String(id),
+	}
+
+	output, err := conn.GetSbomExport(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusSBOMExport(ctx context.Context, conn *inspector2.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findSBOMExportByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitSBOMExportSucceeded(ctx context.Context, conn *inspector2.Client, id string, timeout time.Duration) (*inspector2.GetSbomExportOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(awstypes.ExternalReportStatusInProgress),
+		Target:       enum.Slice(awstypes.ExternalReportStatusSucceeded),
+		Refresh:      statusSBOMExport(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*inspector2.GetSbomExportOutput); ok {
+		if output.Status == awstypes.ExternalReportStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", output.ErrorCode, aws.ToString(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type sbomExportResourceModel struct {
+	ReportFormat           fwtypes.StringEnum[awstypes.SbomReportFormat]                `tfsdk:"report_format"`
+	ReportID               types.String                                                 `tfsdk:"report_id"`
+	ResourceFilterCriteria fwtypes.ListNestedObjectValueOf[resourceFilterCriteriaModel] `tfsdk:"resource_filter_criteria"`
+	S3Destination          fwtypes.ListNestedObjectValueOf[destinationModel]            `tfsdk:"s3_destination"`
+	Status                 fwtypes.StringEnum[awstypes.ExternalReportStatus]            `tfsdk:"status"`
+	Timeouts               timeouts.Value                                               `tfsdk:"timeouts"`
+}
+
+type resourceFilterCriteriaModel struct {
+	AccountID          fwtypes.ListNestedObjectValueOf[resourceStringFilterModel] `tfsdk:"account_id"`
+	EC2InstanceTags    fwtypes.ListNestedObjectValueOf[resourceMapFilterModel]    `tfsdk:"ec2_instance_tags"`
+	ECRImageTags       fwtypes.ListNestedObjectValueOf[resourceStringFilterModel] `tfsdk:"ecr_image_tags"`
+	ECRRepositoryName  fwtypes.ListNestedObjectValueOf[resourceStringFilterModel] `tfsdk:"ecr_repository_name"`
+	LambdaFunctionName fwtypes.ListNestedObjectValueOf[resourceStringFilterModel] `tfsdk:"lambda_function_name"`
+	LambdaFunctionTags fwtypes.ListNestedObjectValueOf[resourceMapFilterModel]    `tfsdk:"lambda_function_tags"`
+	ResourceID         fwtypes.ListNestedObjectValueOf[resourceStringFilterModel] `tfsdk:"resource_id"`
+	ResourceType       fwtypes.ListNestedObjectValueOf[resourceStringFilterModel] `tfsdk:"resource_type"`
+}
+
+type resourceStringFilterModel struct {
+	Comparison fwtypes.StringEnum[awstypes.ResourceStringComparison] `tfsdk:"comparison"`
+	Value      types.String                                          `tfsdk:"value"`
+}
+
+type resourceMapFilterModel struct {
+	Comparison fwtypes.StringEnum[awstypes.ResourceMapComparison] `tfsdk:"comparison"`
+	Key        types.String                                       `tfsdk:"key"`
+	Value      types.String                                       `tfsdk:"value"`
+}
+
+type destinationModel struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+	KeyPrefix  types.String `tfsdk:"key_prefix"`
+	KMSKeyARN  fwtypes.ARN  `tfsdk:"kms_key_arn"`
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inspector2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinspector2 "github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccSBOMExport_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_inspector2_sbom_export.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSBOMExportConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSBOMExportExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "report_format", string(awstypes.SbomReportFormatCyclonedx14)),
+					resource.TestCheckResourceAttrSet(resourceName, "report_id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_filter_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_filter_criteria.0.resource_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_filter_criteria.0.resource_type.0.comparison", "EQUALS"),
+					resource.TestCheckResourceAttr(resourceName, "resource_filter_criteria.0.resource_type.0.value", "AWS_LAMBDA_FUNCTION"),
+					resource.TestCheckResourceAttr(resourceName, "s3_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_destination.0.bucket_name", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "s3_destination.0.key_prefix", "sbom"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_destination.0.kms_key_arn", "aws_kms_key.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ExternalReportStatusSucceeded)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSBOMExportExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Inspector2Client(ctx)
+
+		_, err := tfinspector2.FindSBOMExportByID(ctx, conn, rs.Primary.Attributes["report_id"])
+
+		return err
+	}
+}
+
+func testAccSBOMExportConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "inspector2.amazonaws.com"
+      }
+      Action   = ["s3:PutObject", "s3:PutObjectAcl", "s3:AbortMultipartUpload"]
+      Resource = "${aws_s3_bucket.test.arn}/*"
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+        ArnLike = {
+          "aws:SourceArn" = "arn:${data.aws_partition.current.partition}:inspector2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:report/*"
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "kms:*"
+      Resource = "*"
+      }, {
+      Effect = "Allow"
+      Principal = {
+        Service = "inspector2.amazonaws.com"
+      }
+      Action   = ["kms:Decrypt", "kms:GenerateDataKey*"]
+      Resource = "*"
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+        ArnLike = {
+          "aws:SourceArn" = "arn:${data.aws_partition.current.partition}:inspector2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:report/*"
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_inspector2_sbom_export" "test" {
+  report_format = "CYCLONEDX_1_4"
+
+  resource_filter_criteria {
+    resource_type {
+      comparison = "EQUALS"
+      value      = "AWS_LAMBDA_FUNCTION"
+    }
+  }
+
+  s3_destination {
+    bucket_name = aws_s3_bucket.test.bucket
+    key_prefix  = "sbom"
+    kms_key_arn = aws_kms_key.test.arn
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameDataKey = "Ephemeral Resource Data Key"
+)
+
+// @EphemeralResource(aws_kms_data_key, name="Data Key")
+func newEphemeralDataKey(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralDataKey{}, nil
+}
+
+type ephemeralDataKey struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralDataKey) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ciphertext_blob": schema.StringAttribute{
+				Computed: true,
+			},
+			"encryption_context": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Optional:   true,
+			},
+			"grant_tokens": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Optional:   true,
+			},
+			"key_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+				},
+			},
+			"key_spec": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataKeySpec](),
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("number_of_bytes")),
+				},
+			},
+			"number_of_bytes": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1024),
+				},
+			},
+			"plaintext": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralDataKey) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epDataKeyData
+	conn := e.Meta().KMSClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := kms.GenerateDataKeyInput{
+		EncryptionContext: fwflex.ExpandFrameworkStringValueMap(ctx, data.EncryptionContext),
+		GrantTokens:       fwflex.ExpandFrameworkStringValueList(ctx, data.GrantTokens),
+		KeyId:             fwflex.StringFromFramework(ctx, data.KeyID),
+		KeySpec:           data.KeySpec.ValueEnum(),
+		NumberOfBytes:     fwflex.Int32FromFrameworkInt64(ctx, data.NumberOfBytes),
+	}
+
+	output, err := conn.GenerateDataKey(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.KMS, create.ErrActionReading, ERNameDataKey, data.KeyID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.CiphertextBlob = fwflex.StringValueToFramework(ctx, itypes.Base64Encode(output.CiphertextBlob))
+	data.KeyARN = fwflex.StringToFramework(ctx, output.KeyId)
+	data.Plaintext = fwflex.StringValueToFramework(ctx, itypes.Base64Encode(output.Plaintext))
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epDataKeyData struct {
+	CiphertextBlob    types.String                             `tfsdk:"ciphertext_blob"`
+	EncryptionContext fwtypes.MapValueOf[types.String]         `tfsdk:"encryption_context"`
+	GrantTokens       fwtypes.ListValueOf[types.String]        `tfsdk:"grant_tokens"`
+	KeyARN            types.String                             `tfsdk:"key_arn"`
+	KeyID             types.String                             `tfsdk:"key_id"`
+	KeySpec           fwtypes.StringEnum[awstypes.DataKeySpec] `tfsdk:"key_spec"`
+	NumberOfBytes     types.Int64                              `tfsdk:"number_of_bytes"`
+	Plaintext         types.String                             `tfsdk:"plaintext"`
+}
@@ -32,6 +32,7 @@ import (
 
 // @SDKResource("aws_kms_key", name="Key")
 // @Tags(identifierAttribute="id")
+// @ARNLookup(service="kms", resourceType="key", finder="lookupKeyByARN")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/kms/types;awstypes;awstypes.KeyMetadata")
 // @Testing(importIgnore="deletion_window_in_days;bypass_policy_lockout_safety_check")
 func resourceKey() *schema.Resource {
@@ -415,6 +416,13 @@ func findKeyInfo(ctx context.Context, conn *kms.Client, keyID string, isNewResou
 	return outputRaw.(*kmsKeyInfo), nil
 }
 
+// lookupKeyByARN finds the KMS key with the specified ARN for lookup-by-ARN.
+func lookupKeyByARN(ctx context.Context, meta any, arn string) error {
+	_, err := findKeyByID(ctx, meta.(*conns.AWSClient).KMSClient(ctx), arn)
+
+	return err
+}
+
 func findKeyByID(ctx context.Context, conn *kms.Client, keyID string, optFns ...func(*kms.Options)) (*awstypes.KeyMetadata, error) {
 	input := &kms.DescribeKeyInput{
 		KeyId: aws.String(keyID),
@@ -16,6 +16,11 @@ type servicePackage struct{}
 
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.ServicePackageEphemeralResource {
 	return []*types.ServicePackageEphemeralResource{
+		{
+			Factory:  newEphemeralDataKey,
+			TypeName: "aws_kms_data_key",
+			Name:     "Data Key",
+		},
 		{
 			Factory:  newEphemeralSecrets,
 			TypeName: "aws_kms_secrets",
@@ -109,6 +114,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			ARNLookup: &types.ServicePackageResourceARNLookup{
+				Service:      "kms",
+				ResourceType: "key",
+				Find:         lookupKeyByARN,
+			},
 		},
 		{
 			Factory:  resourceKeyPolicy,
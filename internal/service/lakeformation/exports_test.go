@@ -8,9 +8,10 @@ var (
 	ResourceDataCellsFilter = newResourceDataCellsFilter
 	ResourceResourceLFTag   = newResourceResourceLFTag
 
-	FindDataCellsFilterByID = findDataCellsFilterByID
-	FindResourceLFTagByID   = findResourceLFTagByID
-	LFTagParseResourceID    = lfTagParseResourceID
+	FindDataCellsFilterByID        = findDataCellsFilterByID
+	FindPermissionGrantsByResource = findPermissionGrantsByResource
+	FindResourceLFTagByID          = findResourceLFTagByID
+	LFTagParseResourceID           = lfTagParseResourceID
 
 	ValidPrincipal = validPrincipal
 )
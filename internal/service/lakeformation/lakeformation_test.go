@@ -53,6 +53,9 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"table":            testAccPermissionsDataSource_table,
 			"tableWithColumns": testAccPermissionsDataSource_tableWithColumns,
 		},
+		"PermissionsExclusive": {
+			acctest.CtBasic: testAccPermissionsExclusive_basic,
+		},
 		"PermissionsTable": {
 			acctest.CtBasic:      testAccPermissions_tableBasic,
 			"iamAllowed":         testAccPermissions_tableIAMAllowed,
@@ -0,0 +1,386 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lakeformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_lakeformation_permissions_exclusive", name="Permissions Exclusive")
+func newResourcePermissionsExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourcePermissionsExclusive{}, nil
+}
+
+const (
+	ResNamePermissionsExclusive = "Permissions Exclusive"
+)
+
+type resourcePermissionsExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *resourcePermissionsExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCatalogID: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrDatabaseName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTableName: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"permission": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[principalPermissionsModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrPermissions: schema.SetAttribute{
+							CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.Permission]](ctx),
+							ElementType: fwtypes.StringEnumType[awstypes.Permission](),
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						names.AttrPrincipal: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourcePermissionsExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourcePermissionsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := plan.expandGrants(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncPermissions(ctx, plan.catalogID(), plan.expandResource(), want)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionCreating, ResNamePermissionsExclusive, plan.resourceName(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourcePermissionsExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	var state resourcePermissionsExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findPermissionGrantsByResource(ctx, conn, state.catalogID(), state.expandResource())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LakeFormation, create.ErrActionReading, ResNamePermissionsExclusive, state.resourceName(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.flattenGrants(ctx, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourcePermissionsExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourcePermissionsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Permissions.Equal(state.Permissions) {
+		want, diags := plan.expandGrants(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncPermissions(ctx, plan.catalogID(), plan.expandResource(), want)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LakeFormation, create.ErrActionUpdating, ResNamePermissionsExclusive, plan.resourceName(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncPermissions handles keeping the configured permissions on a database
+// or table in sync with Lake Formation.
+//
+// Each principal/permission pair defined on this resource but not granted
+// will be granted. Pairs granted on the resource but not configured on this
+// resource will be revoked. Permissions granted with the grant option are
+// not managed.
+func (r *resourcePermissionsExclusive) syncPermissions(ctx context.Context, catalogID *string, resource *awstypes.Resource, want []permissionGrant) error {
+	conn := r.Meta().LakeFormationClient(ctx)
+
+	have, err := findPermissionGrantsByResource(ctx, conn, catalogID, resource)
+	if err != nil {
+		return err
+	}
+
+	return exclusive.Sync(ctx, have, want,
+		func(ctx context.Context, v permissionGrant) error {
+			input := lakeformation.GrantPermissionsInput{
+				CatalogId:   catalogID,
+				Permissions: []awstypes.Permission{v.permission},
+				Principal: &awstypes.DataLakePrincipal{
+					DataLakePrincipalIdentifier: aws.String(v.principal),
+				},
+				Resource: resource,
+			}
+
+			if _, err := conn.GrantPermissions(ctx, &input); err != nil {
+				return fmt.Errorf("granting %s to %s: %w", v.permission, v.principal, err)
+			}
+
+			return nil
+		},
+		func(ctx context.Context, v permissionGrant) error {
+			input := lakeformation.RevokePermissionsInput{
+				CatalogId:   catalogID,
+				Permissions: []awstypes.Permission{v.permission},
+				Principal: &awstypes.DataLakePrincipal{
+					DataLakePrincipalIdentifier: aws.String(v.principal),
+				},
+				Resource: resource,
+			}
+
+			if _, err := conn.RevokePermissions(ctx, &input); err != nil {
+				return fmt.Errorf("revoking %s from %s: %w", v.permission, v.principal, err)
+			}
+
+			return nil
+		},
+	)
+}
+
+func (r *resourcePermissionsExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is CATALOG_ID,DATABASE_NAME or CATALOG_ID,DATABASE_NAME,TABLE_NAME.
+	parts := strings.Split(req.ID, ",")
+	if len(parts) < 2 || len(parts) > 3 || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: CATALOG_ID,DATABASE_NAME[,TABLE_NAME]. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrCatalogID), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrDatabaseName), parts[1])...)
+	if len(parts) == 3 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrTableName), parts[2])...)
+	}
+}
+
+// findPermissionGrantsByResource returns the principal/permission pairs granted on a database or table.
+func findPermissionGrantsByResource(ctx context.Context, conn *lakeformation.Client, catalogID *string, resource *awstypes.Resource) ([]permissionGrant, error) {
+	input := &lakeformation.ListPermissionsInput{
+		CatalogId: catalogID,
+		Resource:  resource,
+	}
+
+	var grants []permissionGrant
+	paginator := lakeformation.NewListPermissionsPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if errs.IsA[*awstypes.EntityNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PrincipalResourcePermissions {
+			if v.Principal == nil || v.Resource == nil {
+				continue
+			}
+
+			// Column-level grants are listed alongside table grants.
+			if resource.Table != nil && v.Resource.Table == nil {
+				continue
+			}
+			if resource.Database != nil && v.Resource.Database == nil {
+				continue
+			}
+
+			principal := aws.ToString(v.Principal.DataLakePrincipalIdentifier)
+			for _, p := range v.Permissions {
+				grants = append(grants, permissionGrant{principal: principal, permission: p})
+			}
+		}
+	}
+
+	return grants, nil
+}
+
+// permissionGrant is a single permission granted to a principal.
+type permissionGrant struct {
+	principal  string
+	permission awstypes.Permission
+}
+
+type resourcePermissionsExclusiveData struct {
+	CatalogID    types.String                                              `tfsdk:"catalog_id"`
+	DatabaseName types.String                                              `tfsdk:"database_name"`
+	Permissions  fwtypes.SetNestedObjectValueOf[principalPermissionsModel] `tfsdk:"permission"`
+	TableName    types.String                                              `tfsdk:"table_name"`
+}
+
+type principalPermissionsModel struct {
+	Permissions fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.Permission]] `tfsdk:"permissions"`
+	Principal   types.String                                                `tfsdk:"principal"`
+}
+
+func (data *resourcePermissionsExclusiveData) catalogID() *string {
+	return data.CatalogID.ValueStringPointer()
+}
+
+func (data *resourcePermissionsExclusiveData) resourceName() string {
+	if v := data.TableName.ValueString(); v != "" {
+		return data.DatabaseName.ValueString() + "." + v
+	}
+
+	return data.DatabaseName.ValueString()
+}
+
+func (data *resourcePermissionsExclusiveData) expandResource() *awstypes.Resource {
+	if !data.TableName.IsNull() {
+		return &awstypes.Resource{
+			Table: &awstypes.TableResource{
+				CatalogId:    data.catalogID(),
+				DatabaseName: aws.String(data.DatabaseName.ValueString()),
+				Name:         aws.String(data.TableName.ValueString()),
+			},
+		}
+	}
+
+	return &awstypes.Resource{
+		Database: &awstypes.DatabaseResource{
+			CatalogId: data.catalogID(),
+			Name:      aws.String(data.DatabaseName.ValueString()),
+		},
+	}
+}
+
+func (data *resourcePermissionsExclusiveData) expandGrants(ctx context.Context) ([]permissionGrant, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	principals, d := data.Permissions.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var grants []permissionGrant
+	for _, v := range principals {
+		for _, p := range v.Permissions.Elements() {
+			grants = append(grants, permissionGrant{principal: v.Principal.ValueString(), permission: p.(fwtypes.StringEnum[awstypes.Permission]).ValueEnum()})
+		}
+	}
+
+	return grants, diags
+}
+
+func (data *resourcePermissionsExclusiveData) flattenGrants(ctx context.Context, grants []permissionGrant) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	byPrincipal := make(map[string][]attr.Value)
+	for _, v := range grants {
+		byPrincipal[v.principal] = append(byPrincipal[v.principal], fwtypes.StringEnumValue(v.permission))
+	}
+
+	principals := make([]*principalPermissionsModel, 0, len(byPrincipal))
+	for principal, permissions := range byPrincipal {
+		v, d := fwtypes.NewSetValueOf[fwtypes.StringEnum[awstypes.Permission]](ctx, permissions)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		principals = append(principals, &principalPermissionsModel{
+			Permissions: v,
+			Principal:   fwflex.StringValueToFramework(ctx, principal),
+		})
+	}
+
+	v, d := fwtypes.NewSetNestedObjectValueOfSlice(ctx, principals)
+	diags.Append(d...)
+	data.Permissions = v
+
+	return diags
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lakeformation_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lakeformation/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPermissionsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions_exclusive.test"
+	roleName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.LakeFormation) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LakeFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName, `"ALTER", "DROP"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrDatabaseName, "aws_glue_catalog_database.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "permission.*.principal", roleName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "permission.0.permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission.0.permissions.*", string(awstypes.PermissionAlter)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission.0.permissions.*", string(awstypes.PermissionDrop)),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccPermissionsExclusiveImportStateIDFunc(ctx, resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrDatabaseName,
+			},
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName, `"CREATE_TABLE"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "permission.0.permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "permission.0.permissions.*", string(awstypes.PermissionCreateTable)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionsExclusiveExists(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return create.Error(names.LakeFormation, create.ErrActionCheckingExistence, tflakeformation.ResNamePermissionsExclusive, n, errors.New("not found"))
+		}
+
+		databaseName := rs.Primary.Attributes[names.AttrDatabaseName]
+		if databaseName == "" {
+			return create.Error(names.LakeFormation, create.ErrActionCheckingExistence, tflakeformation.ResNamePermissionsExclusive, n, errors.New("not set"))
+		}
+
+		var catalogID *string
+		if v := rs.Primary.Attributes[names.AttrCatalogID]; v != "" {
+			catalogID = aws.String(v)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationClient(ctx)
+
+		output, err := tflakeformation.FindPermissionGrantsByResource(ctx, conn, catalogID, &awstypes.Resource{
+			Database: &awstypes.DatabaseResource{
+				CatalogId: catalogID,
+				Name:      aws.String(databaseName),
+			},
+		})
+		if err != nil {
+			return create.Error(names.LakeFormation, create.ErrActionCheckingExistence, tflakeformation.ResNamePermissionsExclusive, databaseName, err)
+		}
+
+		if got := len(output); got != want {
+			return create.Error(names.LakeFormation, create.ErrActionCheckingExistence, tflakeformation.ResNamePermissionsExclusive, databaseName, fmt.Errorf("unexpected permission count: got %d, want %d", got, want))
+		}
+
+		return nil
+	}
+}
+
+func testAccPermissionsExclusiveImportStateIDFunc(ctx context.Context, n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return acctest.AccountID(ctx) + "," + rs.Primary.Attributes[names.AttrDatabaseName], nil
+	}
+}
+
+func testAccPermissionsExclusiveConfig_basic(rName, permissions string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_permissions_exclusive" "test" {
+  catalog_id    = data.aws_caller_identity.current.account_id
+  database_name = aws_glue_catalog_database.test.name
+
+  permission {
+    permissions = [%[2]s]
+    principal   = aws_iam_role.test.arn
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName, permissions)
+}
@@ -25,6 +25,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_lakeformation_data_cells_filter",
 			Name:     "Data Cells Filter",
 		},
+		{
+			Factory:  newResourcePermissionsExclusive,
+			TypeName: "aws_lakeformation_permissions_exclusive",
+			Name:     "Permissions Exclusive",
+		},
 		{
 			Factory:  newResourceResourceLFTag,
 			TypeName: "aws_lakeformation_resource_lf_tag",
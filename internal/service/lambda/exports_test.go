@@ -27,6 +27,7 @@ var (
 	FindLayerVersionByTwoPartKey                 = findLayerVersionByTwoPartKey
 	FindLayerVersionPolicyByTwoPartKey           = findLayerVersionPolicyByTwoPartKey
 	FindPolicyStatementByTwoPartKey              = findPolicyStatementByTwoPartKey
+	FindPolicyStatementIDsByTwoPartKey           = findPolicyStatementIDsByTwoPartKey
 	FindProvisionedConcurrencyConfigByTwoPartKey = findProvisionedConcurrencyConfigByTwoPartKey
 	FindRuntimeManagementConfigByTwoPartKey      = findRuntimeManagementConfigByTwoPartKey
 	FunctionEventInvokeConfigParseResourceID     = functionEventInvokeConfigParseResourceID
@@ -42,3 +43,13 @@ var (
 	ValidQualifier                  = validQualifier
 	ValidPolicyStatementID          = validPolicyStatementID
 )
+
+func FunctionPackageSourceCodeHash(dir string, excludes []string) (string, error) {
+	p, err := buildFunctionPackage(dir, excludes)
+
+	if err != nil {
+		return "", err
+	}
+
+	return p.sourceCodeHash, nil
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/mitchellh/go-homedir"
+)
+
+// functionPackageModTime is the modification time recorded for every entry in a function package.
+// It is the earliest time representable in a ZIP file.
+var functionPackageModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// functionPackage is a deployment package built from a local source directory.
+type functionPackage struct {
+	zipFile []byte
+	// Base64-encoded SHA256 hash of the package, as reported in a function's CodeSha256.
+	sourceCodeHash string
+}
+
+// buildFunctionPackage builds a deterministic ZIP deployment package from the contents of the specified directory.
+// Entries are sorted, have a fixed modification time and only retain the executable bit of their permissions,
+// so the package only changes when the names or contents of the files change.
+// Files and directories matching any of the excludes glob patterns, relative to the directory, are skipped.
+func buildFunctionPackage(dir string, excludes []string) (*functionPackage, error) {
+	root, err := homedir.Expand(dir)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_dir (%s): %w", dir, err)
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	// WalkDir visits entries in lexical order.
+	err = filepath.WalkDir(root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, filePath)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+
+		// Excluding a directory excludes everything beneath it.
+		if entry.IsDir() {
+			if name != "." && functionPackageExcluded(name, excludes) {
+				return fs.SkipDir
+			}
+
+			return nil
+		}
+
+		if functionPackageExcluded(name, excludes) {
+			return nil
+		}
+
+		info, err := os.Stat(filePath)
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		mode := fs.FileMode(0o644)
+		if info.Mode()&0o111 != 0 {
+			mode = 0o755
+		}
+
+		header := &zip.FileHeader{
+			Name:     name,
+			Method:   zip.Deflate,
+			Modified: functionPackageModTime,
+		}
+		header.SetMode(mode)
+
+		fw, err := w.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(fw, file)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("packaging source_dir (%s): %w", root, err)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("packaging source_dir (%s): %w", root, err)
+	}
+
+	hash := sha256.Sum256(buf.Bytes())
+
+	return &functionPackage{
+		zipFile:        buf.Bytes(),
+		sourceCodeHash: base64.StdEncoding.EncodeToString(hash[:]),
+	}, nil
+}
+
+// functionPackageExcluded returns whether the slash-separated path, relative to the source directory, matches any of the excludes glob patterns.
+func functionPackageExcluded(name string, excludes []string) bool {
+	for _, pattern := range excludes {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// s3Key returns the S3 object key for the package under the specified prefix.
+// The key is derived from the package hash so that unchanged packages are not re-uploaded under a new name.
+func (p *functionPackage) s3Key(prefix string) string {
+	hash, _ := base64.StdEncoding.DecodeString(p.sourceCodeHash)
+
+	return fmt.Sprintf("%s%x.zip", prefix, hash)
+}
+
+// uploadFunctionPackage uploads the package to the specified S3 bucket, returning the object key.
+func uploadFunctionPackage(ctx context.Context, c *conns.AWSClient, p *functionPackage, bucket, prefix string) (string, error) {
+	conn := c.S3Client(ctx)
+
+	key := p.s3Key(prefix)
+	input := &s3.PutObjectInput{
+		Body:   bytes.NewReader(p.zipFile),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if _, err := conn.PutObject(ctx, input); err != nil {
+		return "", fmt.Errorf("uploading Lambda Function package to S3 Bucket (%s): %w", bucket, err)
+	}
+
+	return key, nil
+}
+
+// expandFunctionCodeFromSourceDir builds the deployment package for source_dir and returns its location,
+// either inline or uploaded to source_dir_s3_bucket.
+func expandFunctionCodeFromSourceDir(ctx context.Context, d *schema.ResourceData, meta interface{}) (*awstypes.FunctionCode, error) {
+	p, err := buildFunctionPackage(d.Get("source_dir").(string), flex.ExpandStringValueSet(d.Get("source_dir_excludes").(*schema.Set)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := d.Get("source_dir_s3_bucket").(string)
+
+	if bucket == "" {
+		return &awstypes.FunctionCode{
+			ZipFile: p.zipFile,
+		}, nil
+	}
+
+	key, err := uploadFunctionPackage(ctx, meta.(*conns.AWSClient), p, bucket, d.Get("source_dir_s3_key_prefix").(string))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &awstypes.FunctionCode{
+		S3Bucket: aws.String(bucket),
+		S3Key:    aws.String(key),
+	}, nil
+}
+
+// customizeDiffFunctionSourceDir plans source_code_hash from the package that will be built from source_dir.
+func customizeDiffFunctionSourceDir(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.GetRawConfig().GetAttr("source_dir").IsNull() {
+		return nil
+	}
+
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("source_dir_excludes") {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	p, err := buildFunctionPackage(d.Get("source_dir").(string), flex.ExpandStringValueSet(d.Get("source_dir_excludes").(*schema.Set)))
+
+	if err != nil {
+		return err
+	}
+
+	if d.Get("source_code_hash").(string) == p.sourceCodeHash {
+		return nil
+	}
+
+	return d.SetNew("source_code_hash", p.sourceCodeHash)
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+)
+
+func TestFunctionPackageSourceCodeHash(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"index.js":         "exports.example = async () => {};",
+		"lib/util.js":      "module.exports = {};",
+		"test/index.test":  "test",
+		"node_modules/x.d": "x",
+	}
+
+	dir1 := testFunctionPackageCreateDir(t, files, time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))
+	dir2 := testFunctionPackageCreateDir(t, files, time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC))
+
+	hash1, err := tflambda.FunctionPackageSourceCodeHash(dir1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash2, err := tflambda.FunctionPackageSourceCodeHash(dir2, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hash1 != hash2 {
+		t.Errorf("hash differs with modification times: %s != %s", hash1, hash2)
+	}
+
+	excludes := []string{"test/*", "node_modules/*"}
+
+	hash3, err := tflambda.FunctionPackageSourceCodeHash(dir1, excludes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hash3 == hash1 {
+		t.Errorf("hash unchanged by excludes: %s", hash3)
+	}
+
+	if err := os.RemoveAll(filepath.Join(dir2, "test")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.RemoveAll(filepath.Join(dir2, "node_modules")); err != nil {
+		t.Fatal(err)
+	}
+
+	hash4, err := tflambda.FunctionPackageSourceCodeHash(dir2, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hash3 != hash4 {
+		t.Errorf("excluded files changed hash: %s != %s", hash3, hash4)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir2, "index.js"), []byte("exports.example = async () => { return 1; };"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	hash5, err := tflambda.FunctionPackageSourceCodeHash(dir2, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hash5 == hash4 {
+		t.Errorf("hash unchanged by content change: %s", hash5)
+	}
+}
+
+func TestFunctionPackageSourceCodeHash_excludeNested(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"index.js": "exports.example = async () => {};",
+	}
+	modTime := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	want, err := tflambda.FunctionPackageSourceCodeHash(testFunctionPackageCreateDir(t, files, modTime), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files["node_modules/example/index.js"] = "module.exports = {};"
+	files["node_modules/example/lib/util/deep.js"] = "module.exports = {};"
+	dir := testFunctionPackageCreateDir(t, files, modTime)
+
+	for _, excludes := range [][]string{{"node_modules"}, {"node_modules/*"}, {"node_modules/example"}} {
+		got, err := tflambda.FunctionPackageSourceCodeHash(dir, excludes)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != want {
+			t.Errorf("excludes %q: nested files packaged: %s != %s", excludes, got, want)
+		}
+	}
+
+	got, err := tflambda.FunctionPackageSourceCodeHash(dir, []string{"node_modules/*/lib"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got == want {
+		t.Errorf("excludes %q: non-excluded nested files not packaged", "node_modules/*/lib")
+	}
+}
+
+func testFunctionPackageCreateDir(t *testing.T, files map[string]string, modTime time.Time) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
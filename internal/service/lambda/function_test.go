@@ -176,6 +176,67 @@ func TestAccLambdaFunction_unpublishedCodeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	resourceName := "aws_lambda_function.test"
+
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_source_dir_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_source_dir_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_source_dir_%s", rString)
+	sgName := fmt.Sprintf("tf_acc_sg_lambda_func_source_dir_%s", rString)
+
+	sourceDir := t.TempDir()
+	sourcePath := filepath.Join(sourceDir, "index.js")
+	if err := os.WriteFile(sourcePath, []byte("exports.example = async () => \"hello\";\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(sourceDir, "README.md"), []byte("excluded\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_sourceDir(funcName, policyName, roleName, sgName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "code_sha256", resourceName, "source_code_hash"),
+					resource.TestCheckResourceAttr(resourceName, "package_type", string(awstypes.PackageTypeZip)),
+				),
+			},
+			{
+				// Touching the files without changing their contents must not produce a diff.
+				PreConfig: func() {
+					now := time.Now()
+					if err := os.Chtimes(sourcePath, now, now); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccFunctionConfig_sourceDir(funcName, policyName, roleName, sgName, sourceDir),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(sourcePath, []byte("exports.example = async () => \"world\";\n"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccFunctionConfig_sourceDir(funcName, policyName, roleName, sgName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "code_sha256", resourceName, "source_code_hash"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_codeSigning(t *testing.T) {
 	ctx := acctest.Context(t)
 	if curr := acctest.Region(); !tflambda.SignerServiceIsAvailable(curr) {
@@ -2552,6 +2613,21 @@ resource "aws_lambda_function" "test" {
 `, funcName))
 }
 
+func testAccFunctionConfig_sourceDir(funcName, policyName, roleName, sgName, sourceDir string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(policyName, roleName, sgName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  source_dir          = %[2]q
+  source_dir_excludes = ["*.md"]
+  function_name       = %[1]q
+  role                = aws_iam_role.iam_for_lambda.arn
+  handler             = "index.example"
+  runtime             = "nodejs20.x"
+}
+`, funcName, sourceDir))
+}
+
 func testAccFunctionConfig_snapStartEnabled(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_lambda_permissions_exclusive", name="Permissions Exclusive")
+func newResourcePermissionsExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourcePermissionsExclusive{}, nil
+}
+
+const (
+	ResNamePermissionsExclusive = "Permissions Exclusive"
+)
+
+type resourcePermissionsExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *resourcePermissionsExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"function_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					functionNameValidator,
+				},
+			},
+			"qualifier": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"statement_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourcePermissionsExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourcePermissionsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var statementIDs []string
+	resp.Diagnostics.Append(plan.StatementIDs.ElementsAs(ctx, &statementIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncPermissions(ctx, plan.FunctionName.ValueString(), plan.Qualifier.ValueString(), statementIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Lambda, create.ErrActionCreating, ResNamePermissionsExclusive, plan.FunctionName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourcePermissionsExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LambdaClient(ctx)
+
+	var state resourcePermissionsExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findPolicyStatementIDsByTwoPartKey(ctx, conn, state.FunctionName.ValueString(), state.Qualifier.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Lambda, create.ErrActionReading, ResNamePermissionsExclusive, state.FunctionName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.StatementIDs = flex.FlattenFrameworkStringValueSetLegacy(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourcePermissionsExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourcePermissionsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.StatementIDs.Equal(state.StatementIDs) {
+		var statementIDs []string
+		resp.Diagnostics.Append(plan.StatementIDs.ElementsAs(ctx, &statementIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncPermissions(ctx, plan.FunctionName.ValueString(), plan.Qualifier.ValueString(), statementIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Lambda, create.ErrActionUpdating, ResNamePermissionsExclusive, plan.FunctionName.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncPermissions handles keeping the configured resource-based policy
+// statements in sync with the remote function.
+//
+// Statements are created by the aws_lambda_permission resource, so a
+// configured statement that does not exist is an error. Statements in the
+// function's policy but not configured on this resource will be removed.
+func (r *resourcePermissionsExclusive) syncPermissions(ctx context.Context, functionName, qualifier string, want []string) error {
+	conn := r.Meta().LambdaClient(ctx)
+
+	// There is a bug in the API (reported and acknowledged by AWS)
+	// which causes some permissions to be ignored when API calls are sent in parallel
+	// We work around this bug via mutex
+	conns.GlobalMutexKV.Lock(functionName)
+	defer conns.GlobalMutexKV.Unlock(functionName)
+
+	have, err := findPolicyStatementIDsByTwoPartKey(ctx, conn, functionName, qualifier)
+	if err != nil {
+		return err
+	}
+
+	return exclusive.Sync(ctx, have, want,
+		exclusive.AddNotSupported("Lambda permission"),
+		func(ctx context.Context, statementID string) error {
+			input := lambda.RemovePermissionInput{
+				FunctionName: aws.String(functionName),
+				StatementId:  aws.String(statementID),
+			}
+			if qualifier != "" {
+				input.Qualifier = aws.String(qualifier)
+			}
+
+			_, err := conn.RemovePermission(ctx, &input)
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				return nil
+			}
+
+			return err
+		},
+	)
+}
+
+func (r *resourcePermissionsExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is FUNCTION_NAME or FUNCTION_NAME:QUALIFIER.
+	functionName, qualifier, found := strings.Cut(req.ID, ":")
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("function_name"), functionName)...)
+	if found {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("qualifier"), qualifier)...)
+	}
+}
+
+// findPolicyStatementIDsByTwoPartKey returns the statement IDs in a function's
+// resource-based policy. A function without a policy has no statements.
+func findPolicyStatementIDsByTwoPartKey(ctx context.Context, conn *lambda.Client, functionName, qualifier string) ([]string, error) {
+	functionInput := lambda.GetFunctionInput{
+		FunctionName: aws.String(functionName),
+	}
+	if qualifier != "" {
+		functionInput.Qualifier = aws.String(qualifier)
+	}
+
+	if _, err := findFunction(ctx, conn, &functionInput); err != nil {
+		return nil, err
+	}
+
+	input := lambda.GetPolicyInput{
+		FunctionName: aws.String(functionName),
+	}
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := findPolicy(ctx, conn, &input)
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &Policy{}
+	if err := json.Unmarshal([]byte(aws.ToString(output.Policy)), policy); err != nil {
+		return nil, err
+	}
+
+	var statementIDs []string
+	for _, v := range policy.Statement {
+		statementIDs = append(statementIDs, v.Sid)
+	}
+
+	return statementIDs, nil
+}
+
+type resourcePermissionsExclusiveData struct {
+	FunctionName types.String `tfsdk:"function_name"`
+	Qualifier    types.String `tfsdk:"qualifier"`
+	StatementIDs types.Set    `tfsdk:"statement_ids"`
+}
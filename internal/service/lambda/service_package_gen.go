@@ -35,6 +35,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_lambda_function_recursion_config",
 			Name:     "Function Recursion Config",
 		},
+		{
+			Factory:  newResourcePermissionsExclusive,
+			TypeName: "aws_lambda_permissions_exclusive",
+			Name:     "Permissions Exclusive",
+		},
 		{
 			Factory:  newResourceRuntimeManagementConfig,
 			TypeName: "aws_lambda_runtime_management_config",
@@ -114,6 +119,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			ARNLookup: &types.ServicePackageResourceARNLookup{
+				Service:      "lambda",
+				ResourceType: "function",
+				Find:         lookupFunctionByARN,
+			},
 		},
 		{
 			Factory:  resourceFunctionEventInvokeConfig,
@@ -0,0 +1,679 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_lexv2models_bot_alias", name="Bot Alias")
+// @Tags(identifierAttribute="arn")
+func newResourceBotAlias(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceBotAlias{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameBotAlias = "Bot Alias"
+
+	botAliasIDPartCount = 2
+)
+
+type resourceBotAlias struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceBotAlias) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"bot_alias_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bot_alias_name": schema.StringAttribute{
+				Required: true,
+			},
+			"bot_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bot_version": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"bot_alias_locale_settings": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[botAliasLocaleSettingsData](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrEnabled: schema.BoolAttribute{
+							Required: true,
+						},
+						"locale_id": schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"code_hook_specification": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[codeHookSpecificationData](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"lambda_code_hook": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaCodeHookData](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"code_hook_interface_version": schema.StringAttribute{
+													Required: true,
+												},
+												"lambda_arn": schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"conversation_log_settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[conversationLogSettingsData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"audio_log_settings": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[audioLogSettingData](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrEnabled: schema.BoolAttribute{
+										Required: true,
+									},
+									"selective_logging_enabled": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrDestination: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[audioLogDestinationData](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												names.AttrS3Bucket: schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[s3BucketLogDestinationData](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrKMSKeyARN: schema.StringAttribute{
+																CustomType: fwtypes.ARNType,
+																Optional:   true,
+															},
+															"log_prefix": schema.StringAttribute{
+																Required: true,
+															},
+															"s3_bucket_arn": schema.StringAttribute{
+																CustomType: fwtypes.ARNType,
+																Required:   true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"text_log_settings": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[textLogSettingData](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrEnabled: schema.BoolAttribute{
+										Required: true,
+									},
+									"selective_logging_enabled": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrDestination: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[textLogDestinationData](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"cloudwatch": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[cloudWatchLogGroupLogDestinationData](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"cloudwatch_log_group_arn": schema.StringAttribute{
+																CustomType: fwtypes.ARNType,
+																Required:   true,
+															},
+															"log_prefix": schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"sentiment_analysis_settings": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sentimentAnalysisSettingsData](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"detect_sentiment": schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+var botAliasFlexOpt = flex.WithIgnoredFieldNamesAppend("BotAliasLocaleSettings")
+
+func (r *resourceBotAlias) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan resourceBotAliasData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.CreateBotAliasInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in, botAliasFlexOpt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	botAliasLocaleSettings, d := expandBotAliasLocaleSettings(ctx, plan.BotAliasLocaleSettings)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	in.BotAliasLocaleSettings = botAliasLocaleSettings
+	in.Tags = getTagsIn(ctx)
+
+	out, err := conn.CreateBotAlias(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotAlias, plan.BotAliasName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil || out.BotAliasId == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotAlias, plan.BotAliasName.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	idParts := []string{
+		aws.ToString(out.BotAliasId),
+		aws.ToString(out.BotId),
+	}
+	id, err := intflex.FlattenResourceId(idParts, botAliasIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotAlias, plan.BotAliasName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+	plan.BotAliasID = flex.StringToFramework(ctx, out.BotAliasId)
+	plan.ARN = types.StringValue(r.Meta().RegionalARN(ctx, "lex", fmt.Sprintf("bot-alias/%s/%s", aws.ToString(out.BotId), aws.ToString(out.BotAliasId))))
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	if _, err := waitBotAliasCreated(ctx, conn, plan.ID.ValueString(), createTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameBotAlias, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceBotAlias) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceBotAliasData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findBotAliasByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameBotAlias, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state, botAliasFlexOpt)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	botAliasLocaleSettings, d := flattenBotAliasLocaleSettings(ctx, out.BotAliasLocaleSettings)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.BotAliasLocaleSettings = botAliasLocaleSettings
+	state.ARN = types.StringValue(r.Meta().RegionalARN(ctx, "lex", fmt.Sprintf("bot-alias/%s/%s", aws.ToString(out.BotId), aws.ToString(out.BotAliasId))))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceBotAlias) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan, state resourceBotAliasData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if botAliasHasChanges(ctx, plan, state) {
+		in := &lexmodelsv2.UpdateBotAliasInput{}
+		resp.Diagnostics.Append(flex.Expand(ctx, plan, in, botAliasFlexOpt)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		botAliasLocaleSettings, d := expandBotAliasLocaleSettings(ctx, plan.BotAliasLocaleSettings)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		in.BotAliasLocaleSettings = botAliasLocaleSettings
+
+		_, err := conn.UpdateBotAlias(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionUpdating, ResNameBotAlias, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+		if _, err := waitBotAliasUpdated(ctx, conn, plan.ID.ValueString(), updateTimeout); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForUpdate, ResNameBotAlias, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceBotAlias) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceBotAliasData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.DeleteBotAliasInput{
+		BotAliasId: state.BotAliasID.ValueStringPointer(),
+		BotId:      state.BotID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteBotAlias(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if errs.IsAErrorMessageContains[*awstypes.PreconditionFailedException](err, "does not exist") {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionDeleting, ResNameBotAlias, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	if _, err := waitBotAliasDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForDeletion, ResNameBotAlias, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func waitBotAliasCreated(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotAliasOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.BotAliasStatusCreating),
+		Target:                    enum.Slice(awstypes.BotAliasStatusAvailable),
+		Refresh:                   statusBotAlias(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotAliasOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitBotAliasUpdated(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotAliasOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.BotAliasStatusCreating),
+		Target:                    enum.Slice(awstypes.BotAliasStatusAvailable),
+		Refresh:                   statusBotAlias(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotAliasOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitBotAliasDeleted(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotAliasOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BotAliasStatusDeleting),
+		Target:  []string{},
+		Refresh: statusBotAlias(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotAliasOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusBotAlias(ctx context.Context, conn *lexmodelsv2.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findBotAliasByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.BotAliasStatus), nil
+	}
+}
+
+func findBotAliasByID(ctx context.Context, conn *lexmodelsv2.Client, id string) (*lexmodelsv2.DescribeBotAliasOutput, error) {
+	parts, err := intflex.ExpandResourceId(id, botAliasIDPartCount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &lexmodelsv2.DescribeBotAliasInput{
+		BotAliasId: aws.String(parts[0]),
+		BotId:      aws.String(parts[1]),
+	}
+
+	out, err := conn.DescribeBotAlias(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.BotAliasId == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandBotAliasLocaleSettings(ctx context.Context, tfSet fwtypes.SetNestedObjectValueOf[botAliasLocaleSettingsData]) (map[string]awstypes.BotAliasLocaleSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if tfSet.IsNull() || tfSet.IsUnknown() {
+		return nil, diags
+	}
+
+	data, d := tfSet.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make(map[string]awstypes.BotAliasLocaleSettings, len(data))
+	for _, v := range data {
+		var apiObject awstypes.BotAliasLocaleSettings
+		diags.Append(flex.Expand(ctx, v, &apiObject)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObjects[v.LocaleID.ValueString()] = apiObject
+	}
+
+	return apiObjects, diags
+}
+
+func flattenBotAliasLocaleSettings(ctx context.Context, apiObjects map[string]awstypes.BotAliasLocaleSettings) (fwtypes.SetNestedObjectValueOf[botAliasLocaleSettingsData], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(apiObjects) == 0 {
+		return fwtypes.NewSetNestedObjectValueOfNull[botAliasLocaleSettingsData](ctx), diags
+	}
+
+	data := make([]*botAliasLocaleSettingsData, 0, len(apiObjects))
+	for k, v := range apiObjects {
+		var tfObject botAliasLocaleSettingsData
+		diags.Append(flex.Flatten(ctx, v, &tfObject)...)
+		if diags.HasError() {
+			return fwtypes.NewSetNestedObjectValueOfNull[botAliasLocaleSettingsData](ctx), diags
+		}
+		tfObject.LocaleID = types.StringValue(k)
+
+		data = append(data, &tfObject)
+	}
+
+	return fwtypes.NewSetNestedObjectValueOfSlice(ctx, data)
+}
+
+type resourceBotAliasData struct {
+	ARN                       types.String                                                   `tfsdk:"arn"`
+	BotAliasID                types.String                                                   `tfsdk:"bot_alias_id"`
+	BotAliasLocaleSettings    fwtypes.SetNestedObjectValueOf[botAliasLocaleSettingsData]     `tfsdk:"bot_alias_locale_settings"`
+	BotAliasName              types.String                                                   `tfsdk:"bot_alias_name"`
+	BotID                     types.String                                                   `tfsdk:"bot_id"`
+	BotVersion                types.String                                                   `tfsdk:"bot_version"`
+	ConversationLogSettings   fwtypes.ListNestedObjectValueOf[conversationLogSettingsData]   `tfsdk:"conversation_log_settings"`
+	Description               types.String                                                   `tfsdk:"description"`
+	ID                        types.String                                                   `tfsdk:"id"`
+	SentimentAnalysisSettings fwtypes.ListNestedObjectValueOf[sentimentAnalysisSettingsData] `tfsdk:"sentiment_analysis_settings"`
+	Tags                      tftags.Map                                                     `tfsdk:"tags"`
+	TagsAll                   tftags.Map                                                     `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                                                 `tfsdk:"timeouts"`
+}
+
+type botAliasLocaleSettingsData struct {
+	CodeHookSpecification fwtypes.ListNestedObjectValueOf[codeHookSpecificationData] `tfsdk:"code_hook_specification"`
+	Enabled               types.Bool                                                 `tfsdk:"enabled"`
+	LocaleID              types.String                                               `tfsdk:"locale_id"`
+}
+
+type codeHookSpecificationData struct {
+	LambdaCodeHook fwtypes.ListNestedObjectValueOf[lambdaCodeHookData] `tfsdk:"lambda_code_hook"`
+}
+
+type lambdaCodeHookData struct {
+	CodeHookInterfaceVersion types.String `tfsdk:"code_hook_interface_version"`
+	LambdaARN                fwtypes.ARN  `tfsdk:"lambda_arn"`
+}
+
+type conversationLogSettingsData struct {
+	AudioLogSettings fwtypes.ListNestedObjectValueOf[audioLogSettingData] `tfsdk:"audio_log_settings"`
+	TextLogSettings  fwtypes.ListNestedObjectValueOf[textLogSettingData]  `tfsdk:"text_log_settings"`
+}
+
+type audioLogSettingData struct {
+	Destination             fwtypes.ListNestedObjectValueOf[audioLogDestinationData] `tfsdk:"destination"`
+	Enabled                 types.Bool                                               `tfsdk:"enabled"`
+	SelectiveLoggingEnabled types.Bool                                               `tfsdk:"selective_logging_enabled"`
+}
+
+type audioLogDestinationData struct {
+	S3Bucket fwtypes.ListNestedObjectValueOf[s3BucketLogDestinationData] `tfsdk:"s3_bucket"`
+}
+
+type s3BucketLogDestinationData struct {
+	KMSKeyARN   fwtypes.ARN  `tfsdk:"kms_key_arn"`
+	LogPrefix   types.String `tfsdk:"log_prefix"`
+	S3BucketARN fwtypes.ARN  `tfsdk:"s3_bucket_arn"`
+}
+
+type textLogSettingData struct {
+	Destination             fwtypes.ListNestedObjectValueOf[textLogDestinationData] `tfsdk:"destination"`
+	Enabled                 types.Bool                                              `tfsdk:"enabled"`
+	SelectiveLoggingEnabled types.Bool                                              `tfsdk:"selective_logging_enabled"`
+}
+
+type textLogDestinationData struct {
+	CloudWatch fwtypes.ListNestedObjectValueOf[cloudWatchLogGroupLogDestinationData] `tfsdk:"cloudwatch"`
+}
+
+type cloudWatchLogGroupLogDestinationData struct {
+	CloudWatchLogGroupARN fwtypes.ARN  `tfsdk:"cloudwatch_log_group_arn"`
+	LogPrefix             types.String `tfsdk:"log_prefix"`
+}
+
+type sentimentAnalysisSettingsData struct {
+	DetectSentiment types.Bool `tfsdk:"detect_sentiment"`
+}
+
+func botAliasHasChanges(_ context.Context, plan, state resourceBotAliasData) bool {
+	return !plan.BotAliasName.Equal(state.BotAliasName) ||
+		!plan.BotVersion.Equal(state.BotVersion) ||
+		!plan.Description.Equal(state.Description) ||
+		!plan.BotAliasLocaleSettings.Equal(state.BotAliasLocaleSettings) ||
+		!plan.ConversationLogSettings.Equal(state.ConversationLogSettings) ||
+		!plan.SentimentAnalysisSettings.Equal(state.SentimentAnalysisSettings)
+}
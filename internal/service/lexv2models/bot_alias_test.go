@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLexV2ModelsBotAlias_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var botalias lexmodelsv2.DescribeBotAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_alias.test"
+	botResourceName := "aws_lexv2models_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotAliasConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotAliasExists(ctx, resourceName, &botalias),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "lex", regexache.MustCompile(`bot-alias/.+/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "bot_alias_id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_alias_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", botResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_version", "aws_lexv2models_bot_version.test", "bot_version"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					names.AttrTimeouts,
+				},
+			},
+			{
+				Config: testAccBotAliasConfig_basic(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotAliasExists(ctx, resourceName, &botalias),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBotAlias_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var botalias lexmodelsv2.DescribeBotAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotAliasConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotAliasExists(ctx, resourceName, &botalias),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflexv2models.ResourceBotAlias, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLexV2ModelsBotAlias_lambdaCodeHook(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var botalias lexmodelsv2.DescribeBotAliasOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lexv2models_bot_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LexV2ModelsEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LexV2ModelsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBotAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBotAliasConfig_lambdaCodeHook(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBotAliasExists(ctx, resourceName, &botalias),
+					resource.TestCheckResourceAttr(resourceName, "bot_alias_locale_settings.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "bot_alias_locale_settings.*", map[string]string{
+						names.AttrEnabled: acctest.CtTrue,
+						"locale_id":       "en_US",
+						"code_hook_specification.0.lambda_code_hook.0.code_hook_interface_version": "1.0",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "bot_alias_locale_settings.*.code_hook_specification.0.lambda_code_hook.0.lambda_arn", "aws_lambda_function.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "sentiment_analysis_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sentiment_analysis_settings.0.detect_sentiment", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					names.AttrTimeouts,
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckBotAliasDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lexv2models_bot_alias" {
+				continue
+			}
+
+			_, err := tflexv2models.FindBotAliasByID(ctx, conn, rs.Primary.ID)
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.LexV2Models, create.ErrActionCheckingDestroyed, tflexv2models.ResNameBotAlias, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBotAliasExists(ctx context.Context, name string, botalias *lexmodelsv2.DescribeBotAliasOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameBotAlias, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameBotAlias, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexV2ModelsClient(ctx)
+		resp, err := tflexv2models.FindBotAliasByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.LexV2Models, create.ErrActionCheckingExistence, tflexv2models.ResNameBotAlias, rs.Primary.ID, err)
+		}
+
+		*botalias = *resp
+
+		return nil
+	}
+}
+
+func testAccBotAliasConfigBase(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBotLocaleConfig_basic(rName, "en_US", 0.7),
+		`
+resource "aws_lexv2models_bot_version" "test" {
+  bot_id = aws_lexv2models_bot.test.id
+  locale_specification = {
+    (aws_lexv2models_bot_locale.test.locale_id) = {
+      source_bot_version = "DRAFT"
+    }
+  }
+}
+`)
+}
+
+func testAccBotAliasConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(
+		testAccBotAliasConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_lexv2models_bot_alias" "test" {
+  bot_alias_name = %[1]q
+  bot_id         = aws_lexv2models_bot.test.id
+  bot_version    = aws_lexv2models_bot_version.test.bot_version
+  description    = %[2]q
+}
+`, rName, description))
+}
+
+func testAccBotAliasConfig_lambdaCodeHook(rName string) string {
+	return acctest.ConfigCompose(
+		testAccBotAliasConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lambda.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.lambda.arn
+  handler       = "index.handler"
+  runtime       = "nodejs20.x"
+}
+
+resource "aws_lexv2models_bot_alias" "test" {
+  bot_alias_name = %[1]q
+  bot_id         = aws_lexv2models_bot.test.id
+  bot_version    = aws_lexv2models_bot_version.test.bot_version
+
+  bot_alias_locale_settings {
+    enabled   = true
+    locale_id = aws_lexv2models_bot_locale.test.locale_id
+
+    code_hook_specification {
+      lambda_code_hook {
+        code_hook_interface_version = "1.0"
+        lambda_arn                  = aws_lambda_function.test.arn
+      }
+    }
+  }
+
+  sentiment_analysis_settings {
+    detect_sentiment = false
+  }
+}
+`, rName))
+}
@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lexmodelsv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lexmodelsv2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_lexv2models_bot_locale_build", name="Bot Locale Build")
+func newResourceBotLocaleBuild(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceBotLocaleBuild{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameBotLocaleBuild = "Bot Locale Build"
+
+	// Only the draft version of a bot can be built.
+	botVersionDraft = "DRAFT"
+)
+
+type resourceBotLocaleBuild struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (r *resourceBotLocaleBuild) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"bot_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bot_locale_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BotLocaleStatus](),
+				Computed:   true,
+			},
+			"bot_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(botVersionDraft),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"last_build_submitted_date_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"locale_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceBotLocaleBuild) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var plan resourceBotLocaleBuildData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &lexmodelsv2.BuildBotLocaleInput{
+		BotId:      plan.BotID.ValueStringPointer(),
+		BotVersion: plan.BotVersion.ValueStringPointer(),
+		LocaleId:   plan.LocaleID.ValueStringPointer(),
+	}
+
+	out, err := conn.BuildBotLocale(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotLocaleBuild, plan.LocaleID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	idParts := []string{
+		aws.ToString(out.LocaleId),
+		aws.ToString(out.BotId),
+		aws.ToString(out.BotVersion),
+	}
+	id, err := intflex.FlattenResourceId(idParts, botLocaleIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionCreating, ResNameBotLocaleBuild, plan.LocaleID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(id)
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	built, err := waitBotLocaleBuilt(ctx, conn, plan.ID.ValueString(), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionWaitingForCreation, ResNameBotLocaleBuild, plan.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.BotLocaleStatus = fwtypes.StringEnumValue(built.BotLocaleStatus)
+	plan.LastBuildSubmittedDateTime = timetypes.NewRFC3339TimePointerValue(built.LastBuildSubmittedDateTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceBotLocaleBuild) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LexV2ModelsClient(ctx)
+
+	var state resourceBotLocaleBuildData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := FindBotLocaleByID(ctx, conn, state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.LexV2Models, create.ErrActionSetting, ResNameBotLocaleBuild, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.BotID = flex.StringToFramework(ctx, out.BotId)
+	state.BotLocaleStatus = fwtypes.StringEnumValue(out.BotLocaleStatus)
+	state.BotVersion = flex.StringToFramework(ctx, out.BotVersion)
+	state.LastBuildSubmittedDateTime = timetypes.NewRFC3339TimePointerValue(out.LastBuildSubmittedDateTime)
+	state.LocaleID = flex.StringToFramework(ctx, out.LocaleId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func waitBotLocaleBuilt(ctx context.Context, conn *lexmodelsv2.Client, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.BotLocaleStatusBuilding, awstypes.BotLocaleStatusNotBuilt, awstypes.BotLocaleStatusReadyExpressTesting),
+		Target:                    enum.Slice(awstypes.BotLocaleStatusBuilt),
+		Refresh:                   statusBotLocale(ctx, conn, id),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(out.FailureReasons, errors.New)...))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+type resourceBotLocaleBuildData struct {
+	BotID                      types.String                                 `tfsdk:"bot_id"`
+	BotLocaleStatus            fwtypes.StringEnum[awstypes.BotLocaleStatus] `tfsdk:"bot_locale_status"`
+	BotVersion                 types.String                                 `tfsdk:"bot_version"`
+	ID                         types.String                                 `tfsdk:"id"`
+	LastBuildSubmittedDateTime timetypes.RFC3339                            `tfsdk:"last_build_submitted_date_time"`
+	LocaleID                   types.String                                 `tfsdk:"locale_id"`
+	Triggers                   types.Map                                    `tfsdk:"triggers"`
+	Timeouts                   timeouts.Value                               `tfsdk:"timeouts"`
+}
@@ -5,14 +5,19 @@ package lexv2models
 
 // Exports for use in tests only.
 var (
-	ResourceBot        = newResourceBot
-	ResourceBotLocale  = newResourceBotLocale
-	ResourceBotVersion = newResourceBotVersion
-	ResourceIntent     = newResourceIntent
-	ResourceSlot       = newResourceSlot
-	ResourceSlotType   = newResourceSlotType
+	ResourceBot            = newResourceBot
+	ResourceBotAlias       = newResourceBotAlias
+	ResourceBotLocale      = newResourceBotLocale
+	ResourceBotLocaleBuild = newResourceBotLocaleBuild
+	ResourceBotVersion     = newResourceBotVersion
+	ResourceIntent         = newResourceIntent
+	ResourceResourcePolicy = newResourceResourcePolicy
+	ResourceSlot           = newResourceSlot
+	ResourceSlotType       = newResourceSlotType
 
-	FindSlotByID = findSlotByID
+	FindBotAliasByID        = findBotAliasByID
+	FindResourcePolicyByARN = findResourcePolicyByARN
+	FindSlotByID            = findSlotByID
 
 	IntentFlexOpt = intentFlexOpt
 )
@@ -28,11 +28,24 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  newResourceBotAlias,
+			TypeName: "aws_lexv2models_bot_alias",
+			Name:     "Bot Alias",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  newResourceBotLocale,
 			TypeName: "aws_lexv2models_bot_locale",
 			Name:     "Bot Locale",
 		},
+		{
+			Factory:  newResourceBotLocaleBuild,
+			TypeName: "aws_lexv2models_bot_locale_build",
+			Name:     "Bot Locale Build",
+		},
 		{
 			Factory:  newResourceBotVersion,
 			TypeName: "aws_lexv2models_bot_version",
@@ -43,6 +56,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_lexv2models_intent",
 			Name:     "Intent",
 		},
+		{
+			Factory:  newResourceResourcePolicy,
+			TypeName: "aws_lexv2models_resource_policy",
+			Name:     "Resource Policy",
+		},
 		{
 			Factory:  newResourceSlot,
 			TypeName: "aws_lexv2models_slot",
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/memorydb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/memorydb/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -62,13 +63,27 @@ func resourceUser() *schema.Resource {
 								Type:         schema.TypeString,
 								ValidateFunc: validation.StringLenBetween(16, 128),
 							},
-							Set:       schema.HashString,
-							Sensitive: true,
+							Set:           schema.HashString,
+							Sensitive:     true,
+							ConflictsWith: []string{"authentication_mode.0.password_wo"},
 						},
 						"password_count": {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"password_wo": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							WriteOnly:     true,
+							ValidateFunc:  validation.StringLenBetween(16, 128),
+							ConflictsWith: []string{"authentication_mode.0.passwords"},
+							RequiredWith:  []string{"authentication_mode.0.password_wo_version"},
+						},
+						"password_wo_version": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							RequiredWith: []string{"authentication_mode.0.password_wo"},
+						},
 						names.AttrType: {
 							Type:             schema.TypeString,
 							Required:         true,
@@ -108,6 +123,17 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.AuthenticationMode = expandAuthenticationMode(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	// get write-only value from configuration
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("authentication_mode").IndexInt(0).GetAttr("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if passwordWO != "" && input.AuthenticationMode != nil {
+		input.AuthenticationMode.Passwords = []string{passwordWO}
+	}
+
 	_, err := conn.CreateUser(ctx, input)
 
 	if err != nil {
@@ -139,9 +165,10 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set(names.AttrARN, user.ARN)
 	if v := user.Authentication; v != nil {
 		tfMap := map[string]interface{}{
-			"passwords":      d.Get("authentication_mode.0.passwords"),
-			"password_count": aws.ToInt32(v.PasswordCount),
-			names.AttrType:   v.Type,
+			"passwords":           d.Get("authentication_mode.0.passwords"),
+			"password_count":      aws.ToInt32(v.PasswordCount),
+			"password_wo_version": d.Get("authentication_mode.0.password_wo_version"),
+			names.AttrType:        v.Type,
 		}
 
 		if err := d.Set("authentication_mode", []interface{}{tfMap}); err != nil {
@@ -171,6 +198,17 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			input.AuthenticationMode = expandAuthenticationMode(v.([]interface{})[0].(map[string]interface{}))
 		}
 
+		// The authentication mode is always sent, so the write-only password must accompany it.
+		passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("authentication_mode").IndexInt(0).GetAttr("password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if passwordWO != "" && input.AuthenticationMode != nil {
+			input.AuthenticationMode.Passwords = []string{passwordWO}
+		}
+
 		_, err := conn.UpdateUser(ctx, input)
 
 		if err != nil {
@@ -5,6 +5,8 @@ package meta
 
 // Exports for use in tests only.
 var (
+	ARNResourceType         = arnResourceType
+	DriftStatusOf           = driftStatusOf
 	FindRegionByEC2Endpoint = findRegionByEC2Endpoint
 	FindRegionByName        = findRegionByName
 )
@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// GetResources accepts at most 100 ARNs.
+	getResourcesMaxARNs = 100
+)
+
+type driftStatus string
+
+const (
+	driftStatusDeleted      driftStatus = "deleted"
+	driftStatusError        driftStatus = "error"
+	driftStatusInSync       driftStatus = "in_sync"
+	driftStatusNotChecked   driftStatus = "not_checked"
+	driftStatusTagsMismatch driftStatus = "tags_mismatch"
+	driftStatusUntagged     driftStatus = "untagged"
+)
+
+// @FrameworkDataSource("aws_resource_drift_report", name="Resource Drift Report")
+func newResourceDriftReportDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &resourceDriftReportDataSource{}
+
+	return d, nil
+}
+
+type resourceDriftReportDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *resourceDriftReportDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expected_tags": tftags.TagsAttribute(),
+			names.AttrID:    framework.IDAttribute(),
+			"resource_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrResources: schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[driftReportResourceModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[driftReportResourceModel](ctx),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tag_filter": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[driftReportTagFilterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(50),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValues: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *resourceDriftReportDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("resource_arns"),
+			path.MatchRoot("tag_filter"),
+		),
+	}
+}
+
+func (d *resourceDriftReportDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data resourceDriftReportDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	c := d.Meta()
+	conn := c.ResourceGroupsTaggingAPIClient(ctx)
+
+	// Find the live tags of each resource.
+	var arns []string
+	var mappings []awstypes.ResourceTagMapping
+	if !data.ResourceARNs.IsNull() {
+		arns = fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceARNs)
+		slices.Sort(arns)
+
+		for chunk := range slices.Chunk(arns, getResourcesMaxARNs) {
+			input := resourcegroupstaggingapi.GetResourcesInput{
+				ResourceARNList: chunk,
+			}
+
+			output, err := findResourceTagMappings(ctx, conn, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError("reading Resource Groups Tagging API Resources", err.Error())
+
+				return
+			}
+
+			mappings = append(mappings, output...)
+		}
+	} else {
+		tagFilters, diags := data.TagFilters.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := resourcegroupstaggingapi.GetResourcesInput{}
+		for _, v := range tagFilters {
+			input.TagFilters = append(input.TagFilters, awstypes.TagFilter{
+				Key:    fwflex.StringFromFramework(ctx, v.Key),
+				Values: fwflex.ExpandFrameworkStringValueSet(ctx, v.Values),
+			})
+		}
+
+		output, err := findResourceTagMappings(ctx, conn, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError("reading Resource Groups Tagging API Resources", err.Error())
+
+			return
+		}
+
+		mappings = output
+		for _, v := range mappings {
+			arns = append(arns, aws.ToString(v.ResourceARN))
+		}
+		slices.Sort(arns)
+	}
+
+	liveTags := make(map[string]tftags.KeyValueTags, len(mappings))
+	for _, v := range mappings {
+		m := make(map[string]*string, len(v.Tags))
+		for _, tag := range v.Tags {
+			m[aws.ToString(tag.Key)] = tag.Value
+		}
+		liveTags[aws.ToString(v.ResourceARN)] = tftags.New(ctx, m)
+	}
+
+	lookups := resourceARNLookups(ctx, c)
+	expectedTags := tftags.New(ctx, data.ExpectedTags)
+	ignoreTagsConfig := c.IgnoreTagsConfig(ctx)
+
+	var resources []*driftReportResourceModel
+	for _, v := range arns {
+		tags := liveTags[v].IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+		resource := &driftReportResourceModel{
+			ARN:  fwflex.StringValueToFramework(ctx, v),
+			Tags: tftags.FlattenStringValueMap(ctx, tags.Map()),
+		}
+
+		var typeName string
+		var checked, deleted bool
+		var lookupErr error
+		if parsedARN, err := arn.Parse(v); err == nil {
+			if lookup, ok := lookups[arnLookupKey(parsedARN.Service, arnResourceType(parsedARN.Resource))]; ok {
+				typeName = lookup.typeName
+
+				// Resources in other Regions cannot be found using this provider configuration's clients.
+				if parsedARN.Region == "" || parsedARN.Region == c.Region(ctx) {
+					checked = true
+					err := lookup.Find(ctx, c, v)
+
+					switch {
+					case tfresource.NotFound(err):
+						deleted = true
+					case err != nil:
+						// Errors, e.g. access denied, are reported for the resource instead of failing the whole report.
+						lookupErr = fmt.Errorf("reading %s (%s): %w", typeName, v, err)
+						resource.Error = fwflex.StringValueToFramework(ctx, lookupErr.Error())
+					}
+				}
+			}
+		}
+		resource.TypeName = fwflex.StringValueToFramework(ctx, typeName)
+
+		// Expected tags include any provider configured default_tags.
+		expected := c.ResourceDefaultTagsConfig(ctx, typeName, false).MergeTags(expectedTags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+		mismatched := make(map[string]string)
+		for k, v := range expected.Map() {
+			if value := tags.KeyValue(k); value == nil || aws.ToString(value) != v {
+				mismatched[k] = v
+			}
+		}
+		resource.MismatchedTags = tftags.FlattenStringValueMap(ctx, mismatched)
+
+		resource.Status = fwflex.StringValueToFramework(ctx, driftStatusOf(checked, deleted, lookupErr, len(tags), len(mismatched)))
+
+		resources = append(resources, resource)
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, c.Region(ctx))
+	data.Resources = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, resources)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// driftStatusOf returns the drift status of a resource from the result of its existence check and tag comparison.
+func driftStatusOf(checked, deleted bool, lookupErr error, tagCount, mismatchedCount int) driftStatus {
+	switch {
+	// Without an existence check, a resource missing from the Resource Groups Tagging API could be either untagged or deleted.
+	case !checked:
+		return driftStatusNotChecked
+	case lookupErr != nil:
+		return driftStatusError
+	case deleted:
+		return driftStatusDeleted
+	case tagCount == 0:
+		return driftStatusUntagged
+	case mismatchedCount > 0:
+		return driftStatusTagsMismatch
+	default:
+		return driftStatusInSync
+	}
+}
+
+func findResourceTagMappings(ctx context.Context, conn *resourcegroupstaggingapi.Client, input *resourcegroupstaggingapi.GetResourcesInput) ([]awstypes.ResourceTagMapping, error) {
+	var output []awstypes.ResourceTagMapping
+
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResourceTagMappingList...)
+	}
+
+	return output, nil
+}
+
+type resourceARNLookup struct {
+	*itypes.ServicePackageResourceARNLookup
+	typeName string
+}
+
+// resourceARNLookups returns all registered resource lookup-by-ARN functions, keyed by ARN service and resource type.
+func resourceARNLookups(ctx context.Context, c *conns.AWSClient) map[string]resourceARNLookup {
+	lookups := make(map[string]resourceARNLookup)
+
+	for _, sp := range c.ServicePackages(ctx) {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.ARNLookup != nil {
+				lookups[arnLookupKey(v.ARNLookup.Service, v.ARNLookup.ResourceType)] = resourceARNLookup{
+					ServicePackageResourceARNLookup: v.ARNLookup,
+					typeName:                        v.TypeName,
+				}
+			}
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			if v.ARNLookup != nil {
+				lookups[arnLookupKey(v.ARNLookup.Service, v.ARNLookup.ResourceType)] = resourceARNLookup{
+					ServicePackageResourceARNLookup: v.ARNLookup,
+					typeName:                        v.TypeName,
+				}
+			}
+		}
+	}
+
+	return lookups
+}
+
+func arnLookupKey(service, resourceType string) string {
+	return service + "/" + resourceType
+}
+
+// arnResourceType returns the resource type from an ARN's resource, e.g. "table" for "table/example".
+// An empty string is returned for resources without a type, e.g. SNS topics.
+func arnResourceType(resource string) string {
+	if i := strings.IndexAny(resource, "/:"); i >= 0 {
+		return resource[:i]
+	}
+
+	return ""
+}
+
+type resourceDriftReportDataSourceModel struct {
+	ExpectedTags tftags.Map                                                 `tfsdk:"expected_tags"`
+	ID           types.String                                               `tfsdk:"id"`
+	ResourceARNs fwtypes.SetOfString                                        `tfsdk:"resource_arns"`
+	Resources    fwtypes.ListNestedObjectValueOf[driftReportResourceModel]  `tfsdk:"resources"`
+	TagFilters   fwtypes.ListNestedObjectValueOf[driftReportTagFilterModel] `tfsdk:"tag_filter"`
+}
+
+type driftReportResourceModel struct {
+	ARN            types.String `tfsdk:"arn"`
+	Error          types.String `tfsdk:"error"`
+	MismatchedTags tftags.Map   `tfsdk:"mismatched_tags"`
+	Status         types.String `tfsdk:"status"`
+	Tags           tftags.Map   `tfsdk:"tags"`
+	TypeName       types.String `tfsdk:"type_name"`
+}
+
+type driftReportTagFilterModel struct {
+	Key    types.String        `tfsdk:"key"`
+	Values fwtypes.SetOfString `tfsdk:"values"`
+}
@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestARNResourceType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"":                             "",
+		"example":                      "",
+		"table/example":                "table",
+		"table/example/stream/2025":    "table",
+		"function:example":             "function",
+		"function:example:1":           "function",
+		"role/path/to/example":         "role",
+		"stateMachine:example":         "stateMachine",
+		"log-group:/aws/lambda/test:*": "log-group",
+	}
+
+	for resource, want := range testCases {
+		t.Run(resource, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfmeta.ARNResourceType(resource); got != want {
+				t.Errorf("ARNResourceType(%q) = %q, want %q", resource, got, want)
+			}
+		})
+	}
+}
+
+func TestDriftStatusOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		checked         bool
+		deleted         bool
+		lookupErr       error
+		tagCount        int
+		mismatchedCount int
+		want            string
+	}{
+		"not checked": {
+			tagCount: 1,
+			want:     "not_checked",
+		},
+		"lookup error": {
+			checked:   true,
+			lookupErr: errors.New("AccessDeniedException"),
+			tagCount:  1,
+			want:      "error",
+		},
+		"deleted": {
+			checked: true,
+			deleted: true,
+			want:    "deleted",
+		},
+		"untagged": {
+			checked: true,
+			want:    "untagged",
+		},
+		"tags mismatch": {
+			checked:         true,
+			tagCount:        1,
+			mismatchedCount: 1,
+			want:            "tags_mismatch",
+		},
+		"in sync": {
+			checked:  true,
+			tagCount: 1,
+			want:     "in_sync",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfmeta.DriftStatusOf(testCase.checked, testCase.deleted, testCase.lookupErr, testCase.tagCount, testCase.mismatchedCount); string(got) != testCase.want {
+				t.Errorf("DriftStatusOf() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAccMetaResourceDriftReportDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resource_drift_report.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDriftReportDataSourceConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New(names.AttrResources), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"mismatched_tags": knownvalue.MapExact(map[string]knownvalue.Check{
+								"Owner": knownvalue.StringExact("platform"),
+							}),
+							names.AttrStatus: knownvalue.StringExact("deleted"),
+							names.AttrTags:   knownvalue.MapExact(map[string]knownvalue.Check{}),
+							"type_name":      knownvalue.StringExact("aws_sns_topic"),
+						}),
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"mismatched_tags": knownvalue.MapExact(map[string]knownvalue.Check{}),
+							names.AttrStatus:  knownvalue.StringExact("in_sync"),
+							names.AttrTags: knownvalue.MapExact(map[string]knownvalue.Check{
+								"Name":  knownvalue.StringExact(rName),
+								"Owner": knownvalue.StringExact("platform"),
+							}),
+							"type_name": knownvalue.StringExact("aws_sns_topic"),
+						}),
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"mismatched_tags": knownvalue.MapExact(map[string]knownvalue.Check{
+								"Owner": knownvalue.StringExact("platform"),
+							}),
+							names.AttrStatus: knownvalue.StringExact("untagged"),
+							names.AttrTags:   knownvalue.MapExact(map[string]knownvalue.Check{}),
+							"type_name":      knownvalue.StringExact("aws_sns_topic"),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccMetaResourceDriftReportDataSource_notChecked(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resource_drift_report.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDriftReportDataSourceConfig_notChecked(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(dataSourceName, tfjsonpath.New(names.AttrResources), knownvalue.ListExact([]knownvalue.Check{
+						// Other Region.
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"mismatched_tags": knownvalue.MapExact(map[string]knownvalue.Check{
+								"Owner": knownvalue.StringExact("platform"),
+							}),
+							names.AttrStatus: knownvalue.StringExact("not_checked"),
+							names.AttrTags:   knownvalue.MapExact(map[string]knownvalue.Check{}),
+							"type_name":      knownvalue.StringExact("aws_sns_topic"),
+						}),
+						// Resource type without lookup by ARN.
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"mismatched_tags": knownvalue.MapExact(map[string]knownvalue.Check{}),
+							names.AttrStatus:  knownvalue.StringExact("not_checked"),
+							names.AttrTags: knownvalue.MapExact(map[string]knownvalue.Check{
+								"Owner": knownvalue.StringExact("platform"),
+							}),
+							"type_name": knownvalue.StringExact(""),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccResourceDriftReportDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_sns_topic" "tagged" {
+  name = "%[1]s-a"
+
+  tags = {
+    Name  = %[1]q
+    Owner = "platform"
+  }
+}
+
+resource "aws_sns_topic" "untagged" {
+  name = "%[1]s-b"
+}
+
+data "aws_resource_drift_report" "test" {
+  resource_arns = [
+    "arn:${data.aws_partition.current.partition}:sns:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:%[1]s-0",
+    aws_sns_topic.tagged.arn,
+    aws_sns_topic.untagged.arn,
+  ]
+
+  expected_tags = {
+    Owner = "platform"
+  }
+}
+`, rName)
+}
+
+func testAccResourceDriftReportDataSourceConfig_notChecked(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  tags = {
+    Owner = "platform"
+  }
+}
+
+data "aws_resource_drift_report" "test" {
+  resource_arns = [
+    "arn:${data.aws_partition.current.partition}:sns:%[2]s:${data.aws_caller_identity.current.account_id}:%[1]s",
+    aws_sqs_queue.test.arn,
+  ]
+
+  expected_tags = {
+    Owner = "platform"
+  }
+}
+`, rName, acctest.AlternateRegion())
+}
@@ -43,6 +43,11 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			TypeName: "aws_regions",
 			Name:     "Regions",
 		},
+		{
+			Factory:  newResourceDriftReportDataSource,
+			TypeName: "aws_resource_drift_report",
+			Name:     "Resource Drift Report",
+		},
 		{
 			Factory:  newServiceDataSource,
 			TypeName: "aws_service",
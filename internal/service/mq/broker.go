@@ -6,6 +6,7 @@ package mq
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -18,6 +19,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/mq"
 	"github.com/aws/aws-sdk-go-v2/service/mq/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -222,9 +224,22 @@ func resourceBroker() *schema.Resource {
 							Optional: true,
 						},
 						"service_account_password": {
-							Type:      schema.TypeString,
-							Optional:  true,
-							Sensitive: true,
+							Type:          schema.TypeString,
+							Optional:      true,
+							Sensitive:     true,
+							ConflictsWith: []string{"ldap_server_metadata.0.service_account_password_wo"},
+						},
+						"service_account_password_wo": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							WriteOnly:     true,
+							ConflictsWith: []string{"ldap_server_metadata.0.service_account_password"},
+							RequiredWith:  []string{"ldap_server_metadata.0.service_account_password_wo_version"},
+						},
+						"service_account_password_wo_version": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							RequiredWith: []string{"ldap_server_metadata.0.service_account_password_wo"},
 						},
 						"service_account_username": {
 							Type:     schema.TypeString,
@@ -355,7 +370,7 @@ func resourceBroker() *schema.Resource {
 						},
 						names.AttrPassword: {
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: ValidBrokerPassword,
 						},
@@ -372,6 +387,18 @@ func resourceBroker() *schema.Resource {
 					},
 				},
 			},
+			"user_passwords_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				ValidateFunc: validation.StringIsJSON,
+				RequiredWith: []string{"user_passwords_wo_version"},
+			},
+			"user_passwords_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"user_passwords_wo"},
+			},
 		},
 
 		CustomizeDiff: customdiff.All(
@@ -386,6 +413,7 @@ func resourceBroker() *schema.Resource {
 
 				return nil
 			},
+			customizeDiffUserPasswords,
 		),
 	}
 }
@@ -446,6 +474,34 @@ func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.SubnetIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	// get write-only values from configuration
+	serviceAccountPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("ldap_server_metadata").IndexInt(0).GetAttr("service_account_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if serviceAccountPasswordWO != "" && input.LdapServerMetadata != nil {
+		input.LdapServerMetadata.ServiceAccountPassword = aws.String(serviceAccountPasswordWO)
+	}
+
+	userPasswordsWO, di := getUserPasswordsWO(d)
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, user := range input.Users {
+		username := aws.ToString(user.Username)
+		if v, ok := userPasswordsWO[username]; ok && aws.ToString(user.Password) == "" {
+			input.Users[i].Password = aws.String(v)
+		}
+
+		if aws.ToString(input.Users[i].Password) == "" {
+			return sdkdiag.AppendErrorf(diags, "creating MQ Broker (%s): user (%s) requires either password or an entry in user_passwords_wo", name, username)
+		}
+	}
+
 	output, err := conn.CreateBroker(ctx, input)
 
 	if err != nil {
@@ -508,7 +564,12 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta interf
 		password = v.(string)
 	}
 
-	if err := d.Set("ldap_server_metadata", flattenLDAPServerMetadata(output.LdapServerMetadata, password)); err != nil {
+	ldapServerMetadata := flattenLDAPServerMetadata(output.LdapServerMetadata, password)
+	if v, ok := d.GetOk("ldap_server_metadata.0.service_account_password_wo_version"); ok && len(ldapServerMetadata) > 0 {
+		ldapServerMetadata[0].(map[string]interface{})["service_account_password_wo_version"] = v
+	}
+
+	if err := d.Set("ldap_server_metadata", ldapServerMetadata); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting ldap_server_metadata: %s", err)
 	}
 
@@ -576,20 +637,46 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		requiresReboot = true
 	}
 
-	if d.HasChange("user") {
-		o, n := d.GetChange("user")
-		var err error
-		// d.HasChange("user") always reports a change when running resourceBrokerUpdate
-		// updateBrokerUsers needs to be called to know if changes to user are actually made
-		var usersUpdated bool
-		usersUpdated, err = updateBrokerUsers(ctx, conn, d.Id(), o.(*schema.Set).List(), n.(*schema.Set).List())
+	if d.HasChanges("user", "user_passwords_wo_version") {
+		userPasswordsWO, di := getUserPasswordsWO(d)
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s) users: %s", d.Id(), err)
+		if d.HasChange("user") {
+			o, n := d.GetChange("user")
+			var err error
+			// d.HasChange("user") always reports a change when running resourceBrokerUpdate
+			// updateBrokerUsers needs to be called to know if changes to user are actually made
+			var usersUpdated bool
+			usersUpdated, err = updateBrokerUsers(ctx, conn, d.Id(), o.(*schema.Set).List(), n.(*schema.Set).List(), userPasswordsWO)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s) users: %s", d.Id(), err)
+			}
+
+			if usersUpdated {
+				requiresReboot = true
+			}
 		}
 
-		if usersUpdated {
-			requiresReboot = true
+		if d.HasChange("user_passwords_wo_version") {
+			for username, password := range userPasswordsWO {
+				input := &mq.UpdateUserInput{
+					BrokerId: aws.String(d.Id()),
+					Password: aws.String(password),
+					Username: aws.String(username),
+				}
+
+				_, err := conn.UpdateUser(ctx, input)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s) user (%s) password: %s", d.Id(), username, err)
+				}
+
+				requiresReboot = true
+			}
 		}
 	}
 
@@ -805,7 +892,7 @@ func resourceUserHash(v interface{}) int {
 	return create.StringHashcode(buf.String())
 }
 
-func updateBrokerUsers(ctx context.Context, conn *mq.Client, id string, oldUsers, newUsers []interface{}) (bool, error) {
+func updateBrokerUsers(ctx context.Context, conn *mq.Client, id string, oldUsers, newUsers []interface{}, passwordsWO map[string]string) (bool, error) {
 	// If there are any user creates/deletes/updates, updatedUsers will be set to true
 	updatedUsers := false
 
@@ -814,6 +901,22 @@ func updateBrokerUsers(ctx context.Context, conn *mq.Client, id string, oldUsers
 		return updatedUsers, err
 	}
 
+	// Users without a password in configuration take it from the write-only argument.
+	for _, c := range createL {
+		if aws.ToString(c.Password) == "" {
+			v, ok := passwordsWO[aws.ToString(c.Username)]
+			if !ok {
+				return updatedUsers, fmt.Errorf("user (%s) requires either password or an entry in user_passwords_wo", aws.ToString(c.Username))
+			}
+			c.Password = aws.String(v)
+		}
+	}
+	for _, u := range updateL {
+		if aws.ToString(u.Password) == "" {
+			u.Password = nil
+		}
+	}
+
 	for _, c := range createL {
 		_, err := conn.CreateUser(ctx, c)
 		updatedUsers = true
@@ -839,6 +942,72 @@ func updateBrokerUsers(ctx context.Context, conn *mq.Client, id string, oldUsers
 	return updatedUsers, nil
 }
 
+// getUserPasswordsWO returns the user name to password map from the user_passwords_wo write-only argument.
+func getUserPasswordsWO(d *schema.ResourceData) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("user_passwords_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() || v == "" {
+		return nil, diags
+	}
+
+	var passwords map[string]string
+	if err := json.Unmarshal([]byte(v), &passwords); err != nil {
+		return nil, sdkdiag.AppendErrorf(diags, "parsing user_passwords_wo: %s", err)
+	}
+
+	return passwords, diags
+}
+
+// customizeDiffUserPasswords ensures at plan time that every user has either a password or an entry in user_passwords_wo.
+// Unknown values are skipped; they are checked again when the broker is created or updated.
+func customizeDiffUserPasswords(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+	if !config.IsKnown() || config.IsNull() {
+		return nil
+	}
+
+	passwordsWO := config.GetAttr("user_passwords_wo")
+	if !passwordsWO.IsKnown() {
+		return nil
+	}
+
+	var passwords map[string]string
+	if !passwordsWO.IsNull() {
+		if err := json.Unmarshal([]byte(passwordsWO.AsString()), &passwords); err != nil {
+			return fmt.Errorf("parsing user_passwords_wo: %w", err)
+		}
+	}
+
+	users := config.GetAttr("user")
+	if !users.IsKnown() || users.IsNull() {
+		return nil
+	}
+
+	for it := users.ElementIterator(); it.Next(); {
+		_, user := it.Element()
+		if !user.IsKnown() || user.IsNull() {
+			continue
+		}
+
+		username, password := user.GetAttr(names.AttrUsername), user.GetAttr(names.AttrPassword)
+		if !username.IsKnown() || username.IsNull() || !password.IsKnown() {
+			continue
+		}
+
+		if !password.IsNull() && password.AsString() != "" {
+			continue
+		}
+
+		if _, ok := passwords[username.AsString()]; !ok {
+			return fmt.Errorf("user (%s) requires either password or an entry in user_passwords_wo", username.AsString())
+		}
+	}
+
+	return nil
+}
+
 func DiffBrokerUsers(bId string, oldUsers, newUsers []interface{}) (cr []*mq.CreateUserInput, di []*mq.DeleteUserInput, ur []*mq.UpdateUserInput, e error) {
 	existingUsers := make(map[string]interface{})
 	for _, ou := range oldUsers {
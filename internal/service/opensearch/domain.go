@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/opensearch"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -158,9 +159,10 @@ func resourceDomain() *schema.Resource {
 										Optional: true,
 									},
 									"master_user_password": {
-										Type:      schema.TypeString,
-										Optional:  true,
-										Sensitive: true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										Sensitive:     true,
+										ConflictsWith: []string{"master_user_password_wo"},
 									},
 								},
 							},
@@ -513,6 +515,18 @@ func resourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"master_user_password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"advanced_security_options.0.master_user_options.0.master_user_password"},
+				RequiredWith:  []string{"master_user_password_wo_version"},
+			},
+			"master_user_password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"master_user_password_wo"},
+			},
 			"node_to_node_encryption": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -689,6 +703,17 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(v.([]interface{}))
 	}
 
+	// get write-only value from configuration
+	masterUserPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_user_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if masterUserPasswordWO != "" {
+		setMasterUserPassword(input.AdvancedSecurityOptions, masterUserPasswordWO)
+	}
+
 	if v, ok := d.GetOk("auto_tune_options"); ok && len(v.([]interface{})) > 0 {
 		input.AutoTuneOptions = expandAutoTuneOptionsInput(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -1005,8 +1030,20 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.AdvancedOptions = flex.ExpandStringValueMap(d.Get("advanced_options").(map[string]interface{}))
 		}
 
-		if d.HasChange("advanced_security_options") {
+		if d.HasChanges("advanced_security_options", "master_user_password_wo_version") {
 			input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(d.Get("advanced_security_options").([]interface{}))
+
+			if d.HasChange("master_user_password_wo_version") {
+				masterUserPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_user_password_wo"))
+				diags = append(diags, di...)
+				if diags.HasError() {
+					return diags
+				}
+
+				if masterUserPasswordWO != "" {
+					setMasterUserPassword(input.AdvancedSecurityOptions, masterUserPasswordWO)
+				}
+			}
 		}
 
 		if d.HasChange("auto_tune_options") {
@@ -54,6 +54,19 @@ func expandAdvancedSecurityOptions(m []interface{}) *awstypes.AdvancedSecurityOp
 	return &config
 }
 
+// setMasterUserPassword sets the master user password, typically from the write-only argument.
+func setMasterUserPassword(config *awstypes.AdvancedSecurityOptionsInput, password string) {
+	if config == nil || !aws.ToBool(config.Enabled) {
+		return
+	}
+
+	if config.MasterUserOptions == nil {
+		config.MasterUserOptions = &awstypes.MasterUserOptions{}
+	}
+
+	config.MasterUserOptions.MasterUserPassword = aws.String(password)
+}
+
 func expandAutoTuneOptions(tfMap map[string]interface{}) *awstypes.AutoTuneOptions {
 	if tfMap == nil {
 		return nil
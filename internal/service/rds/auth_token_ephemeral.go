@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAuthToken = "Ephemeral Resource Auth Token"
+
+	// authTokenLifetime is the validity period of an IAM database authentication token.
+	authTokenLifetime = 15 * time.Minute
+	// emptyPayloadHash is the hex-encoded SHA-256 hash of an empty request payload.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// @EphemeralResource(aws_rds_auth_token, name="Auth Token")
+func newEphemeralAuthToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAuthToken{}, nil
+}
+
+type ephemeralAuthToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAuthToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrEndpoint: schema.StringAttribute{
+				Required: true,
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrPort: schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			names.AttrRegion: schema.StringAttribute{
+				Optional: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUsername: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAuthToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAuthTokenData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	region := data.Region.ValueString()
+	if region == "" {
+		region = e.Meta().Region(ctx)
+	}
+
+	endpoint := net.JoinHostPort(data.Endpoint.ValueString(), strconv.FormatInt(data.Port.ValueInt64(), 10))
+	now := time.Now()
+
+	token, err := buildAuthToken(ctx, endpoint, region, data.Username.ValueString(), e.Meta().CredentialsProvider(ctx), now)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionReading, ERNameAuthToken, endpoint, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Expiration = timetypes.NewRFC3339TimeValue(now.Add(authTokenLifetime))
+	data.Region = fwflex.StringValueToFramework(ctx, region)
+	data.Token = fwflex.StringValueToFramework(ctx, token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+// buildAuthToken returns an IAM database authentication token.
+// The token is a SigV4 presigned "connect" request for the rds-db service, signed locally without calling AWS.
+func buildAuthToken(ctx context.Context, endpoint, region, username string, credentials aws.CredentialsProvider, signingTime time.Time) (string, error) {
+	if credentials == nil {
+		return "", errors.New("no AWS credentials available")
+	}
+
+	creds, err := credentials.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+
+	values := url.Values{
+		"Action":        []string{"connect"},
+		"DBUser":        []string{username},
+		"X-Amz-Expires": []string{strconv.Itoa(int(authTokenLifetime.Seconds()))},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+endpoint+"/?"+values.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	signedURL, _, err := v4.NewSigner().PresignHTTP(ctx, creds, req, emptyPayloadHash, "rds-db", region, signingTime)
+	if err != nil {
+		return "", fmt.Errorf("presigning request: %w", err)
+	}
+
+	return strings.TrimPrefix(signedURL, "https://"), nil
+}
+
+type epAuthTokenData struct {
+	Endpoint   types.String      `tfsdk:"endpoint"`
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	Port       types.Int64       `tfsdk:"port"`
+	Region     types.String      `tfsdk:"region"`
+	Token      types.String      `tfsdk:"token"`
+	Username   types.String      `tfsdk:"username"`
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestBuildAuthToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	creds := credentials.NewStaticCredentialsProvider("AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "")
+	signingTime := time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+	token, err := tfrds.BuildAuthToken(ctx, "mydb.123456789012.us-west-2.rds.amazonaws.com:5432", "us-west-2", "jane_doe", creds, signingTime)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := token, "mydb.123456789012.us-west-2.rds.amazonaws.com:5432/?"; !strings.HasPrefix(got, want) {
+		t.Fatalf("token %q does not start with %q", got, want)
+	}
+
+	values, err := url.ParseQuery(token[strings.Index(token, "?")+1:])
+	if err != nil {
+		t.Fatalf("parsing token query: %s", err)
+	}
+
+	for k, want := range map[string]string{
+		"Action":              "connect",
+		"DBUser":              "jane_doe",
+		"X-Amz-Algorithm":     "AWS4-HMAC-SHA256",
+		"X-Amz-Credential":    "AKIDEXAMPLE/20240102/us-west-2/rds-db/aws4_request",
+		"X-Amz-Date":          "20240102T030405Z",
+		"X-Amz-Expires":       "900",
+		"X-Amz-SignedHeaders": "host",
+	} {
+		if got := values.Get(k); got != want {
+			t.Errorf("%s: got %q, want %q", k, got, want)
+		}
+	}
+
+	if values.Get("X-Amz-Signature") == "" {
+		t.Error("X-Amz-Signature: missing")
+	}
+}
+
+func TestBuildAuthToken_noCredentials(t *testing.T) {
+	t.Parallel()
+
+	var creds aws.CredentialsProvider
+
+	if _, err := tfrds.BuildAuthToken(context.Background(), "mydb:5432", "us-west-2", "jane_doe", creds, time.Now()); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestAccRDSAuthTokenEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.RDSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthTokenEphemeralResourceConfig_basic(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrRegion), knownvalue.StringExact(acctest.Region())),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccAuthTokenEphemeralResourceConfig_basic() string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_rds_auth_token.test"),
+		`
+ephemeral "aws_rds_auth_token" "test" {
+  endpoint = "mydb.123456789012.us-west-2.rds.amazonaws.com"
+  port     = 5432
+  username = "jane_doe"
+}
+`)
+}
@@ -29,6 +29,7 @@ var (
 	FindHostedZoneDNSSECByZoneID                = findHostedZoneDNSSECByZoneID
 	FindKeySigningKeyByTwoPartKey               = findKeySigningKeyByTwoPartKey
 	FindQueryLoggingConfigByID                  = findQueryLoggingConfigByID
+	FindRecordKeysByZoneID                      = findRecordKeysByZoneID
 	FindResourceRecordSetByFourPartKey          = findResourceRecordSetByFourPartKey
 	FindTrafficPolicyByID                       = findTrafficPolicyByID
 	FindTrafficPolicyInstanceByID               = findTrafficPolicyInstanceByID
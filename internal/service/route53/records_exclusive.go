@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_route53_records_exclusive", name="Records Exclusive")
+func newResourceRecordsExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceRecordsExclusive{}, nil
+}
+
+const (
+	ResNameRecordsExclusive = "Records Exclusive"
+)
+
+type resourceRecordsExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *resourceRecordsExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"record": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[recordKeyModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"set_identifier": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.RRType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceRecordsExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceRecordsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, diags := plan.Records.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncRecords(ctx, plan.ZoneID.ValueString(), records)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionCreating, ResNameRecordsExclusive, plan.ZoneID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceRecordsExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().Route53Client(ctx)
+
+	var state resourceRecordsExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findRecordKeysByZoneID(ctx, conn, state.ZoneID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53, create.ErrActionReading, ResNameRecordsExclusive, state.ZoneID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Preserve the configured spelling of record names that are equivalent to the remote names.
+	current, diags := state.Records.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records := make([]*recordKeyModel, 0, len(out))
+	for _, v := range out {
+		if i := slices.IndexFunc(current, func(c *recordKeyModel) bool { return c.equal(v) }); i >= 0 {
+			v = current[i]
+		}
+		records = append(records, v)
+	}
+
+	state.Records, diags = fwtypes.NewSetNestedObjectValueOfSlice(ctx, records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceRecordsExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceRecordsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Records.Equal(state.Records) {
+		records, diags := plan.Records.ToSlice(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncRecords(ctx, plan.ZoneID.ValueString(), records)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Route53, create.ErrActionUpdating, ResNameRecordsExclusive, plan.ZoneID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncRecords handles keeping the configured record sets in sync with the
+// remote hosted zone.
+//
+// Record sets are created by the aws_route53_record resource, so a
+// configured record set that does not exist is an error. Record sets in the
+// hosted zone but not configured on this resource will be deleted. The zone
+// apex SOA and NS record sets are never managed.
+func (r *resourceRecordsExclusive) syncRecords(ctx context.Context, zoneID string, want []*recordKeyModel) error {
+	conn := r.Meta().Route53Client(ctx)
+
+	have, err := findRecordKeysByZoneID(ctx, conn, zoneID)
+	if err != nil {
+		return err
+	}
+
+	reconciler := exclusive.Reconciler[*recordKeyModel]{
+		Equal: func(v1, v2 *recordKeyModel) bool { return v1.equal(v2) },
+		Add: func(_ context.Context, v *recordKeyModel) error {
+			return &exclusive.NotManagedError{Kind: "Route 53 Record", ID: v.String()}
+		},
+		Remove: func(ctx context.Context, v *recordKeyModel) error {
+			return deleteResourceRecordSet(ctx, conn, zoneID, v)
+		},
+	}
+
+	return reconciler.Sync(ctx, have, want)
+}
+
+func (r *resourceRecordsExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("zone_id"), req, resp)
+}
+
+func deleteResourceRecordSet(ctx context.Context, conn *route53.Client, zoneID string, key *recordKeyModel) error {
+	rec, _, err := findResourceRecordSetByFourPartKey(ctx, conn, zoneID, key.Name.ValueString(), string(key.Type.ValueEnum()), key.SetIdentifier.ValueString())
+	if tfresource.NotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading Route 53 Record (%s): %w", key, err)
+	}
+
+	input := route53.ChangeResourceRecordSetsInput{
+		ChangeBatch: &awstypes.ChangeBatch{
+			Changes: []awstypes.Change{
+				{
+					Action:            awstypes.ChangeActionDelete,
+					ResourceRecordSet: rec,
+				},
+			},
+			Comment: aws.String("Deleted by Terraform"),
+		},
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	output, err := conn.ChangeResourceRecordSets(ctx, &input)
+	if errs.IsA[*awstypes.InvalidChangeBatch](err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("deleting Route 53 Record (%s): %w", key, err)
+	}
+
+	if output.ChangeInfo != nil {
+		if _, err := waitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id)); err != nil {
+			return fmt.Errorf("waiting for Route 53 Record (%s) synchronize: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// findRecordKeysByZoneID returns the keys of all record sets in a hosted zone,
+// excluding the zone apex SOA and NS record sets.
+func findRecordKeysByZoneID(ctx context.Context, conn *route53.Client, zoneID string) ([]*recordKeyModel, error) {
+	zone, err := findHostedZoneByID(ctx, conn, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	apex := normalizeDomainName(zone.HostedZone.Name)
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	filter := func(v *awstypes.ResourceRecordSet) bool {
+		if normalizeDomainName(v.Name) == apex && (v.Type == awstypes.RRTypeSoa || v.Type == awstypes.RRTypeNs) {
+			return false
+		}
+
+		return true
+	}
+
+	output, err := findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v awstypes.ResourceRecordSet) *recordKeyModel {
+		return &recordKeyModel{
+			Name:          fwflex.StringValueToFramework(ctx, normalizeDomainName(v.Name)),
+			SetIdentifier: fwflex.StringToFramework(ctx, v.SetIdentifier),
+			Type:          fwtypes.StringEnumValue(v.Type),
+		}
+	}), nil
+}
+
+type resourceRecordsExclusiveData struct {
+	Records fwtypes.SetNestedObjectValueOf[recordKeyModel] `tfsdk:"record"`
+	ZoneID  types.String                                   `tfsdk:"zone_id"`
+}
+
+type recordKeyModel struct {
+	Name          types.String                        `tfsdk:"name"`
+	SetIdentifier types.String                        `tfsdk:"set_identifier"`
+	Type          fwtypes.StringEnum[awstypes.RRType] `tfsdk:"type"`
+}
+
+// equal reports whether two record keys identify the same record set.
+func (m *recordKeyModel) equal(other *recordKeyModel) bool {
+	return normalizeDomainName(m.Name.ValueString()) == normalizeDomainName(other.Name.ValueString()) &&
+		strings.EqualFold(string(m.Type.ValueEnum()), string(other.Type.ValueEnum())) &&
+		m.SetIdentifier.ValueString() == other.SetIdentifier.ValueString()
+}
+
+func (m *recordKeyModel) String() string {
+	parts := []string{m.Name.ValueString(), string(m.Type.ValueEnum())}
+	if v := m.SetIdentifier.ValueString(); v != "" {
+		parts = append(parts, v)
+	}
+
+	return strings.Join(parts, "_")
+}
@@ -23,6 +23,16 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			TypeName: "aws_route53_records",
 			Name:     "Records",
 		},
+		{
+			Factory:  newZoneFileDataSource,
+			TypeName: "aws_route53_zone_file",
+			Name:     "Zone File",
+		},
+		{
+			Factory:  newZoneFileExportDataSource,
+			TypeName: "aws_route53_zone_file_export",
+			Name:     "Zone File Export",
+		},
 		{
 			Factory:  newZonesDataSource,
 			TypeName: "aws_route53_zones",
@@ -43,6 +53,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_route53_cidr_location",
 			Name:     "CIDR Location",
 		},
+		{
+			Factory:  newResourceRecordsExclusive,
+			TypeName: "aws_route53_records_exclusive",
+			Name:     "Records Exclusive",
+		},
 	}
 }
 
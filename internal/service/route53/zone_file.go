@@ -0,0 +1,594 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+)
+
+// Zone files are described in RFC 1035 section 5 (https://datatracker.ietf.org/doc/html/rfc1035#section-5)
+// and RFC 2308 section 4 (https://datatracker.ietf.org/doc/html/rfc2308#section-4).
+//
+// Escapes in zone files use three-digit decimal codes (\DDD) whereas the Route 53 API uses three-digit octal codes (\ooo).
+// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DomainNameFormat.html.
+
+const (
+	zoneFileClassIN = "IN"
+	zoneFileMaxTTL  = 2147483647
+)
+
+// zoneFileRecordSet is a resource record set parsed from a zone file.
+// Name is fully qualified without the trailing dot and Records are in the format used by the aws_route53_record resource's records argument.
+type zoneFileRecordSet struct {
+	Name    string
+	TTL     int64
+	Type    awstypes.RRType
+	Records []string
+}
+
+type zoneFileToken struct {
+	quoted bool
+	value  string
+}
+
+// zoneFileEntry is a logical line of a zone file, i.e. a physical line with any parenthesized continuation lines.
+type zoneFileEntry struct {
+	line       int
+	ownerBlank bool
+	tokens     []zoneFileToken
+}
+
+// lexZoneFile splits a zone file into entries, removing comments and joining parenthesized continuation lines.
+// Escape sequences are left as-is in token values.
+func lexZoneFile(content string) ([]zoneFileEntry, error) {
+	var (
+		entries []zoneFileEntry
+		entry   zoneFileEntry
+		depth   int
+	)
+	line, startOfLine := 1, true
+
+	for i := 0; i < len(content); {
+		ch := content[i]
+
+		if startOfLine && depth == 0 {
+			entry = zoneFileEntry{
+				line:       line,
+				ownerBlank: ch == ' ' || ch == '\t',
+			}
+		}
+		startOfLine = false
+
+		switch ch {
+		case '\n':
+			if depth == 0 && len(entry.tokens) > 0 {
+				entries = append(entries, entry)
+			}
+			line++
+			startOfLine = true
+			i++
+		case ' ', '\t', '\r':
+			i++
+		case ';':
+			for i < len(content) && content[i] != '\n' {
+				i++
+			}
+		case '(':
+			depth++
+			i++
+		case ')':
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+			}
+			depth--
+			i++
+		case '"':
+			start := line
+			var sb strings.Builder
+			for i++; ; i++ {
+				if i >= len(content) {
+					return nil, fmt.Errorf("line %d: unterminated quoted string", start)
+				}
+				if content[i] == '"' {
+					i++
+					break
+				}
+				if content[i] == '\n' {
+					line++
+				}
+				if content[i] == '\\' && i+1 < len(content) {
+					sb.WriteByte(content[i])
+					i++
+				}
+				sb.WriteByte(content[i])
+			}
+			entry.tokens = append(entry.tokens, zoneFileToken{quoted: true, value: sb.String()})
+		default:
+			var sb strings.Builder
+			for ; i < len(content) && !strings.ContainsRune(" \t\r\n;()\"", rune(content[i])); i++ {
+				if content[i] == '\\' && i+1 < len(content) {
+					sb.WriteByte(content[i])
+					i++
+				}
+				sb.WriteByte(content[i])
+			}
+			entry.tokens = append(entry.tokens, zoneFileToken{value: sb.String()})
+		}
+	}
+
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", entry.line)
+	}
+
+	if len(entry.tokens) > 0 {
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// zoneFileRDataNames are the positions of domain names in each record type's RDATA.
+var zoneFileRDataNames = map[awstypes.RRType][]int{
+	awstypes.RRTypeCname: {0},
+	awstypes.RRTypeHttps: {1},
+	awstypes.RRTypeMx:    {1},
+	awstypes.RRTypeNaptr: {5},
+	awstypes.RRTypeNs:    {0},
+	awstypes.RRTypePtr:   {0},
+	awstypes.RRTypeSoa:   {0, 1},
+	awstypes.RRTypeSrv:   {3},
+	awstypes.RRTypeSvcb:  {1},
+}
+
+// zoneFileRDataCounts are the minimum and maximum (0 for unbounded) number of fields in each record type's RDATA.
+var zoneFileRDataCounts = map[awstypes.RRType][2]int{
+	awstypes.RRTypeA:     {1, 1},
+	awstypes.RRTypeAaaa:  {1, 1},
+	awstypes.RRTypeCaa:   {3, 3},
+	awstypes.RRTypeCname: {1, 1},
+	awstypes.RRTypeDs:    {4, 0},
+	awstypes.RRTypeHttps: {2, 0},
+	awstypes.RRTypeMx:    {2, 2},
+	awstypes.RRTypeNaptr: {6, 6},
+	awstypes.RRTypeNs:    {1, 1},
+	awstypes.RRTypePtr:   {1, 1},
+	awstypes.RRTypeSoa:   {7, 7},
+	awstypes.RRTypeSpf:   {1, 0},
+	awstypes.RRTypeSrv:   {4, 4},
+	awstypes.RRTypeSshfp: {3, 3},
+	awstypes.RRTypeSvcb:  {2, 0},
+	awstypes.RRTypeTlsa:  {4, 0},
+	awstypes.RRTypeTxt:   {1, 0},
+}
+
+// parseZoneFile parses a zone file into resource record sets, in order of first appearance.
+// origin is the initial $ORIGIN and defaultTTL the TTL of records before any $TTL directive or explicit TTL; both are optional.
+// Resource records with the same name and type are merged into a single resource record set with the lowest of their TTLs.
+func parseZoneFile(content, origin string, defaultTTL *int64) ([]zoneFileRecordSet, error) {
+	entries, err := lexZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if origin != "" {
+		origin, err = qualifyZoneFileName(fqdn(origin), "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		directiveTTL, lastTTL *int64
+		owner                 string
+		recordSets            []zoneFileRecordSet
+		errs                  []error
+	)
+	indices := make(map[string]int)
+
+	for _, entry := range entries {
+		tokens := entry.tokens
+
+		if !entry.ownerBlank && !tokens[0].quoted && strings.HasPrefix(tokens[0].value, "$") {
+			directive := strings.ToUpper(tokens[0].value)
+
+			switch directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					errs = append(errs, fmt.Errorf("line %d: expected exactly one domain name after %s", entry.line, directive))
+					continue
+				}
+				v, err := qualifyZoneFileName(tokens[1].value, origin)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("line %d: %w", entry.line, err))
+					continue
+				}
+				origin = v
+			case "$TTL":
+				if len(tokens) != 2 {
+					errs = append(errs, fmt.Errorf("line %d: expected exactly one TTL after %s", entry.line, directive))
+					continue
+				}
+				v, err := parseZoneFileTTL(tokens[1].value)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("line %d: %w", entry.line, err))
+					continue
+				}
+				directiveTTL = &v
+			default:
+				errs = append(errs, fmt.Errorf("line %d: unsupported directive %s", entry.line, tokens[0].value))
+			}
+
+			continue
+		}
+
+		if !entry.ownerBlank {
+			v, err := qualifyZoneFileName(tokens[0].value, origin)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("line %d: %w", entry.line, err))
+				continue
+			}
+			owner = v
+			tokens = tokens[1:]
+		} else if owner == "" {
+			errs = append(errs, fmt.Errorf("line %d: no owner name", entry.line))
+			continue
+		}
+
+		// [<TTL>] [<class>] <type> <RDATA> or [<class>] [<TTL>] <type> <RDATA>.
+		var (
+			rrType awstypes.RRType
+			ttl    *int64
+		)
+		err := func() error {
+			for len(tokens) > 0 {
+				token := tokens[0]
+				tokens = tokens[1:]
+
+				if token.quoted || token.value == "" {
+					return fmt.Errorf("unexpected quoted string %q", token.value)
+				}
+
+				if class := strings.ToUpper(token.value); class == zoneFileClassIN {
+					continue
+				} else if slices.Contains([]string{"ANY", "CH", "CS", "HS"}, class) {
+					return fmt.Errorf("unsupported class %s", token.value)
+				}
+
+				if v := token.value[0]; v >= '0' && v <= '9' && ttl == nil {
+					v, err := parseZoneFileTTL(token.value)
+					if err != nil {
+						return err
+					}
+					ttl = &v
+					continue
+				}
+
+				rrType = awstypes.RRType(strings.ToUpper(token.value))
+				return nil
+			}
+
+			return errors.New("no record type")
+		}()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", entry.line, err))
+			continue
+		}
+
+		if !slices.Contains(enum.Values[awstypes.RRType](), string(rrType)) {
+			errs = append(errs, fmt.Errorf("line %d: unsupported record type %s", entry.line, rrType))
+			continue
+		}
+
+		if counts := zoneFileRDataCounts[rrType]; len(tokens) < counts[0] || (counts[1] > 0 && len(tokens) > counts[1]) {
+			errs = append(errs, fmt.Errorf("line %d: invalid number of %s record fields: %d", entry.line, rrType, len(tokens)))
+			continue
+		}
+
+		switch {
+		case ttl != nil:
+			lastTTL = ttl
+		case directiveTTL != nil:
+			ttl = directiveTTL
+		case lastTTL != nil:
+			ttl = lastTTL
+		case defaultTTL != nil:
+			ttl = defaultTTL
+		default:
+			errs = append(errs, fmt.Errorf("line %d: no TTL and no $TTL directive or default TTL", entry.line))
+			continue
+		}
+
+		value, err := zoneFileRData(rrType, tokens, origin)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", entry.line, err))
+			continue
+		}
+
+		name := strings.TrimSuffix(owner, ".")
+		if name == "" {
+			name = "."
+		}
+		key := name + " " + string(rrType)
+
+		if i, ok := indices[key]; ok {
+			recordSet := &recordSets[i]
+			recordSet.TTL = min(recordSet.TTL, *ttl)
+			if !slices.Contains(recordSet.Records, value) {
+				recordSet.Records = append(recordSet.Records, value)
+			}
+		} else {
+			indices[key] = len(recordSets)
+			recordSets = append(recordSets, zoneFileRecordSet{
+				Name:    name,
+				TTL:     *ttl,
+				Type:    rrType,
+				Records: []string{value},
+			})
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return recordSets, nil
+}
+
+// parseZoneFileTTL parses a TTL in seconds, optionally using BIND's time units, e.g. "1h30m".
+func parseZoneFileTTL(s string) (int64, error) {
+	var ttl, n int64
+	digits := false
+
+	for _, ch := range strings.ToLower(s) {
+		if ch >= '0' && ch <= '9' {
+			n = n*10 + int64(ch-'0')
+			digits = true
+			if n > zoneFileMaxTTL {
+				return 0, fmt.Errorf("TTL %q out of range", s)
+			}
+			continue
+		}
+
+		if !digits {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+
+		switch ch {
+		case 'w':
+			n *= 7 * 24 * 60 * 60
+		case 'd':
+			n *= 24 * 60 * 60
+		case 'h':
+			n *= 60 * 60
+		case 'm':
+			n *= 60
+		case 's':
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		ttl, n, digits = ttl+n, 0, false
+	}
+
+	if ttl += n; ttl > zoneFileMaxTTL {
+		return 0, fmt.Errorf("TTL %q out of range", s)
+	}
+
+	return ttl, nil
+}
+
+// qualifyZoneFileName returns the fully qualified form, with trailing dot, of a zone file domain name
+// in the Route 53 API's representation.
+func qualifyZoneFileName(name, origin string) (string, error) {
+	if name == "@" {
+		if origin == "" {
+			return "", errors.New("@ used without an origin")
+		}
+		return origin, nil
+	}
+
+	name, err := zoneFileNameToAPI(name)
+	if err != nil {
+		return "", err
+	}
+
+	if strings.HasSuffix(name, ".") {
+		return name, nil
+	}
+
+	if origin == "" {
+		return "", fmt.Errorf("relative domain name %q used without an origin", name)
+	}
+
+	if origin == "." {
+		return name + ".", nil
+	}
+
+	return name + "." + origin, nil
+}
+
+// zoneFileNameToAPI converts a zone file domain name into the Route 53 API's representation:
+// lower case, with any characters other than letters, digits, "-", "_" and "*" as three-digit octal codes.
+func zoneFileNameToAPI(name string) (string, error) {
+	var sb strings.Builder
+
+	for i := 0; i < len(name); i++ {
+		ch := name[i]
+
+		if ch != '\\' {
+			sb.WriteString(strings.ToLower(string(ch)))
+			continue
+		}
+
+		if i+1 >= len(name) {
+			return "", fmt.Errorf("invalid escape in domain name %q", name)
+		}
+
+		if v, ok := zoneFileDecimalEscape(name[i+1:]); ok {
+			ch = v
+			i += 3
+		} else {
+			ch = name[i+1]
+			i++
+		}
+
+		switch {
+		case ch >= '0' && ch <= '9' || ch >= 'a' && ch <= 'z' || ch == '-' || ch == '_' || ch == '*':
+			sb.WriteByte(ch)
+		case ch >= 'A' && ch <= 'Z':
+			sb.WriteByte(ch - 'A' + 'a')
+		default:
+			fmt.Fprintf(&sb, "\\%03o", ch)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// zoneFileDecimalEscape returns the character encoded by a leading three-digit decimal code.
+func zoneFileDecimalEscape(s string) (byte, bool) {
+	if len(s) < 3 {
+		return 0, false
+	}
+
+	v, err := strconv.ParseUint(s[:3], 10, 8)
+	if err != nil || s[0] < '0' || s[0] > '9' {
+		return 0, false
+	}
+
+	return byte(v), true
+}
+
+// zoneFileCharacterString converts a zone file character string into its quoted Route 53 API representation.
+func zoneFileCharacterString(s string) string {
+	var sb strings.Builder
+
+	sb.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+
+		if ch == '\\' && i+1 < len(s) {
+			if v, ok := zoneFileDecimalEscape(s[i+1:]); ok {
+				ch = v
+				i += 3
+			} else {
+				ch = s[i+1]
+				i++
+			}
+		}
+
+		switch {
+		case ch == '"' || ch == '\\':
+			sb.WriteByte('\\')
+			sb.WriteByte(ch)
+		case ch < ' ' || ch > '~':
+			fmt.Fprintf(&sb, "\\%03o", ch)
+		default:
+			sb.WriteByte(ch)
+		}
+	}
+	sb.WriteByte('"')
+
+	return sb.String()
+}
+
+// zoneFileRData returns a resource record's value in the format used by the aws_route53_record resource's records argument.
+func zoneFileRData(rrType awstypes.RRType, tokens []zoneFileToken, origin string) (string, error) {
+	fields := make([]string, len(tokens))
+
+	for i, token := range tokens {
+		switch {
+		case rrType == awstypes.RRTypeTxt || rrType == awstypes.RRTypeSpf || token.quoted:
+			fields[i] = zoneFileCharacterString(token.value)
+		case slices.Contains(zoneFileRDataNames[rrType], i) && token.value != ".":
+			v, err := qualifyZoneFileName(token.value, origin)
+			if err != nil {
+				return "", err
+			}
+			fields[i] = v
+		default:
+			fields[i] = token.value
+		}
+	}
+
+	value := strings.Join(fields, " ")
+	if rrType == awstypes.RRTypeTxt || rrType == awstypes.RRTypeSpf {
+		value = expandTxtEntry(value)
+	}
+
+	return value, nil
+}
+
+// renderZoneFile renders resource record sets, as returned by the Route 53 API, in zone file format.
+// Alias records and records using a routing policy or traffic policy cannot be represented in a zone file
+// and are rendered as comments.
+func renderZoneFile(zoneName string, recordSets []awstypes.ResourceRecordSet) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "$ORIGIN %s\n", apiToZoneFileEscapes(fqdn(zoneName), true))
+
+	for _, recordSet := range recordSets {
+		name := apiToZoneFileEscapes(fqdn(aws.ToString(recordSet.Name)), true)
+
+		if v := recordSet.AliasTarget; v != nil {
+			fmt.Fprintf(&sb, "; %s\tALIAS\t%s\t%s\t; hosted zone %s", name, recordSet.Type, fqdn(aws.ToString(v.DNSName)), aws.ToString(v.HostedZoneId))
+			if recordSet.SetIdentifier != nil {
+				fmt.Fprintf(&sb, ", set identifier %q", aws.ToString(recordSet.SetIdentifier))
+			}
+			sb.WriteByte('\n')
+			continue
+		}
+
+		var prefix, suffix string
+		switch {
+		case recordSet.TrafficPolicyInstanceId != nil:
+			prefix, suffix = "; ", fmt.Sprintf("\t; traffic policy instance %s", aws.ToString(recordSet.TrafficPolicyInstanceId))
+		case recordSet.SetIdentifier != nil:
+			prefix, suffix = "; ", fmt.Sprintf("\t; set identifier %q", aws.ToString(recordSet.SetIdentifier))
+		}
+
+		for _, v := range recordSet.ResourceRecords {
+			fmt.Fprintf(&sb, "%s%s\t%d\t%s\t%s\t%s%s\n", prefix, name, aws.ToInt64(recordSet.TTL), zoneFileClassIN, recordSet.Type, apiToZoneFileEscapes(aws.ToString(v.Value), false), suffix)
+		}
+	}
+
+	return sb.String()
+}
+
+// apiToZoneFileEscapes converts three-digit octal codes in the Route 53 API's representation into three-digit decimal codes.
+// For domain names, "*" is not escaped.
+func apiToZoneFileEscapes(s string, isName bool) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 >= len(s) {
+			sb.WriteByte(s[i])
+			continue
+		}
+
+		if v, err := strconv.ParseUint(s[i+1:min(i+4, len(s))], 8, 8); err == nil && i+4 <= len(s) {
+			if isName && v == '*' {
+				sb.WriteByte('*')
+			} else {
+				fmt.Fprintf(&sb, "\\%03d", v)
+			}
+			i += 3
+			continue
+		}
+
+		// Other escapes, e.g. \" and \\, are the same in both representations.
+		sb.WriteByte(s[i])
+		sb.WriteByte(s[i+1])
+		i++
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_route53_zone_file", name="Zone File")
+func newZoneFileDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &zoneFileDataSource{}, nil
+}
+
+type zoneFileDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *zoneFileDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrContent: schema.StringAttribute{
+				Required: true,
+			},
+			"default_ttl": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, zoneFileMaxTTL),
+				},
+			},
+			"origin": schema.StringAttribute{
+				Optional: true,
+			},
+			"records": framework.DataSourceComputedListOfObjectAttribute[zoneFileRecordModel](ctx),
+		},
+	}
+}
+
+func (d *zoneFileDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data zoneFileDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	recordSets, err := parseZoneFile(data.Content.ValueString(), data.Origin.ValueString(), fwflex.Int64FromFramework(ctx, data.DefaultTTL))
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing Route 53 zone file", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(
+		ctx,
+		struct {
+			Records []zoneFileRecordSet
+		}{
+			Records: recordSets,
+		},
+		&data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type zoneFileDataSourceModel struct {
+	Content    types.String                                         `tfsdk:"content"`
+	DefaultTTL types.Int64                                          `tfsdk:"default_ttl"`
+	Origin     types.String                                         `tfsdk:"origin"`
+	Records    fwtypes.ListNestedObjectValueOf[zoneFileRecordModel] `tfsdk:"records"`
+}
+
+type zoneFileRecordModel struct {
+	Name    types.String                        `tfsdk:"name"`
+	Records fwtypes.ListOfString                `tfsdk:"records"`
+	TTL     types.Int64                         `tfsdk:"ttl"`
+	Type    fwtypes.StringEnum[awstypes.RRType] `tfsdk:"type"`
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ZoneFileDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_zone_file.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "records.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.name", "example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.type", "MX"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.ttl", "3600"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.records.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.records.0", "10 mail.example.com."),
+					resource.TestCheckResourceAttr(dataSourceName, "records.1.name", "example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.1.type", "TXT"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.1.records.0", "v=spf1 mx -all"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.2.name", "mail.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.2.type", "A"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.2.ttl", "300"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.2.records.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.2.records.0", "192.0.2.1"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.2.records.1", "192.0.2.2"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.3.name", "www.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.3.type", "CNAME"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.3.records.0", "mail.example.com."),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneFileDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccZoneFileDataSourceConfig_invalid,
+				ExpectError: regexache.MustCompile(`line 2: unsupported record type HINFO`),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneFileDataSource_records(t *testing.T) {
+	ctx := acctest.Context(t)
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileDataSourceConfig_records(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf(`aws_route53_record.test["%s TXT"]`, zoneName), "records.#", "1"),
+					resource.TestCheckTypeSetElemAttr(fmt.Sprintf(`aws_route53_record.test["%s TXT"]`, zoneName), "records.*", "v=spf1 mx -all"),
+					resource.TestCheckResourceAttr(fmt.Sprintf(`aws_route53_record.test["%s A"]`, zoneName.Subdomain("mail")), "records.#", "2"),
+					resource.TestCheckResourceAttr(fmt.Sprintf(`aws_route53_record.test["%s CNAME"]`, zoneName.Subdomain("www")), "ttl", "300"),
+				),
+			},
+		},
+	})
+}
+
+const testAccZoneFileDataSourceConfig_basic = `
+data "aws_route53_zone_file" "test" {
+  origin = "example.com"
+
+  content = <<-EOT
+    $TTL 1h
+    @     MX     10 mail
+    @     TXT    "v=spf1 mx -all"
+    mail  300 IN A 192.0.2.1
+    mail  300 IN A 192.0.2.2
+    www   CNAME  mail ; Web server
+  EOT
+}
+`
+
+const testAccZoneFileDataSourceConfig_invalid = `
+data "aws_route53_zone_file" "test" {
+  origin      = "example.com"
+  default_ttl = 300
+
+  content = <<-EOT
+    @    A      192.0.2.1
+    @    HINFO  "PC" "Linux"
+  EOT
+}
+`
+
+func testAccZoneFileDataSourceConfig_records(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+data "aws_route53_zone_file" "test" {
+  origin      = %[1]q
+  default_ttl = 300
+
+  content = <<-EOT
+    @     TXT    "v=spf1 mx -all"
+    mail  A      192.0.2.1
+    mail  A      192.0.2.2
+    www   CNAME  mail
+  EOT
+}
+
+resource "aws_route53_record" "test" {
+  for_each = {
+    for r in data.aws_route53_zone_file.test.records : "${r.name} ${r.type}" => r
+  }
+
+  zone_id = aws_route53_zone.test.zone_id
+  name    = each.value.name
+  type    = each.value.type
+  ttl     = each.value.ttl
+  records = each.value.records
+}
+`, zoneName)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_route53_zone_file_export", name="Zone File Export")
+func newZoneFileExportDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &zoneFileExportDataSource{}, nil
+}
+
+type zoneFileExportDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *zoneFileExportDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrContent: schema.StringAttribute{
+				Computed: true,
+			},
+			"name_regex": schema.StringAttribute{
+				CustomType: fwtypes.RegexpType,
+				Optional:   true,
+			},
+			"zone_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *zoneFileExportDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data zoneFileExportDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().Route53Client(ctx)
+
+	hostedZoneID := fwflex.StringValueFromFramework(ctx, data.ZoneID)
+	hostedZone, err := findHostedZoneByID(ctx, conn, hostedZoneID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Hosted Zone (%s)", hostedZoneID), err.Error())
+
+		return
+	}
+
+	input := route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(hostedZoneID),
+	}
+	filter := tfslices.PredicateTrue[*awstypes.ResourceRecordSet]()
+	if !data.NameRegex.IsNull() {
+		filter = func(v *awstypes.ResourceRecordSet) bool {
+			return data.NameRegex.ValueRegexp().MatchString(aws.ToString(v.Name))
+		}
+	}
+
+	output, err := findResourceRecordSets(ctx, conn, &input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), filter)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing Route 53 Records (%s)", hostedZoneID), err.Error())
+
+		return
+	}
+
+	data.Content = types.StringValue(renderZoneFile(aws.ToString(hostedZone.HostedZone.Name), output))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type zoneFileExportDataSourceModel struct {
+	Content   types.String   `tfsdk:"content"`
+	NameRegex fwtypes.Regexp `tfsdk:"name_regex"`
+	ZoneID    types.String   `tfsdk:"zone_id"`
+}
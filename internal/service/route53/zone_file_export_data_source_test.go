@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ZoneFileExportDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_zone_file_export.test"
+	zoneName := acctest.RandomDomain()
+	recordName := zoneName.Subdomain("www")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileExportDataSourceConfig_basic(zoneName.String(), recordName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrContent, regexache.MustCompile(fmt.Sprintf(`^\$ORIGIN %s\n`, regexp.QuoteMeta(zoneName.FQDN().String())))),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrContent, regexache.MustCompile(fmt.Sprintf(`\n%s\t\d+\tIN\tSOA\t`, regexp.QuoteMeta(zoneName.FQDN().String())))),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrContent, regexache.MustCompile(fmt.Sprintf(`\n%s\t30\tIN\tA\t192\.0\.2\.1\n`, regexp.QuoteMeta(recordName.FQDN().String())))),
+					resource.TestMatchResourceAttr(dataSourceName, names.AttrContent, regexache.MustCompile(fmt.Sprintf(`\n%s\t30\tIN\tTXT\t"v=spf1 -all"\n`, regexp.QuoteMeta(recordName.FQDN().String())))),
+				),
+			},
+		},
+	})
+}
+
+func testAccZoneFileExportDataSourceConfig_basic(zName, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = "%[1]s."
+}
+
+resource "aws_route53_record" "a" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = %[2]q
+  type    = "A"
+  ttl     = "30"
+  records = ["192.0.2.1"]
+}
+
+resource "aws_route53_record" "txt" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = %[2]q
+  type    = "TXT"
+  ttl     = "30"
+  records = ["v=spf1 -all"]
+}
+
+data "aws_route53_zone_file_export" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  depends_on = [aws_route53_record.a, aws_route53_record.txt]
+}
+`, zName, rName)
+}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content    string
+		origin     string
+		defaultTTL *int64
+		expected   []zoneFileRecordSet
+		wantErr    string
+	}{
+		"empty": {},
+		"basic": {
+			content: `
+$ORIGIN Example.com.
+$TTL 1h
+@	IN	SOA	ns1 hostmaster (
+		2024010101 ; serial
+		7200       ; refresh
+		3600 1209600 300 )
+		IN	NS	ns1
+		IN	NS	ns2.example.net.
+@	300	IN	MX	10 mail
+www		CNAME	@
+mail	IN	300	A	192.0.2.1
+mail		A	192.0.2.2
+*.app	60	AAAA	2001:db8::1
+_sip._tcp	SRV	10 60 5060 sip
+`,
+			expected: []zoneFileRecordSet{
+				{Name: "example.com", TTL: 3600, Type: awstypes.RRTypeSoa, Records: []string{"ns1.example.com. hostmaster.example.com. 2024010101 7200 3600 1209600 300"}},
+				{Name: "example.com", TTL: 3600, Type: awstypes.RRTypeNs, Records: []string{"ns1.example.com.", "ns2.example.net."}},
+				{Name: "example.com", TTL: 300, Type: awstypes.RRTypeMx, Records: []string{"10 mail.example.com."}},
+				{Name: "www.example.com", TTL: 3600, Type: awstypes.RRTypeCname, Records: []string{"example.com."}},
+				{Name: "mail.example.com", TTL: 300, Type: awstypes.RRTypeA, Records: []string{"192.0.2.1", "192.0.2.2"}},
+				{Name: "*.app.example.com", TTL: 60, Type: awstypes.RRTypeAaaa, Records: []string{"2001:db8::1"}},
+				{Name: "_sip._tcp.example.com", TTL: 3600, Type: awstypes.RRTypeSrv, Records: []string{"10 60 5060 sip.example.com."}},
+			},
+		},
+		"origin argument": {
+			content: "www A 192.0.2.1\n",
+			origin:  "example.com",
+			expected: []zoneFileRecordSet{
+				{Name: "www.example.com", TTL: 300, Type: awstypes.RRTypeA, Records: []string{"192.0.2.1"}},
+			},
+			defaultTTL: aws.Int64(300),
+		},
+		"last explicit TTL": {
+			content: "a.example.com. 120 A 192.0.2.1\nb.example.com. A 192.0.2.2\n",
+			expected: []zoneFileRecordSet{
+				{Name: "a.example.com", TTL: 120, Type: awstypes.RRTypeA, Records: []string{"192.0.2.1"}},
+				{Name: "b.example.com", TTL: 120, Type: awstypes.RRTypeA, Records: []string{"192.0.2.2"}},
+			},
+		},
+		"lowest TTL and duplicate values": {
+			content: "a.example.com. 120 A 192.0.2.1\na.example.com. 60 A 192.0.2.2\na.example.com. 60 A 192.0.2.1\n",
+			expected: []zoneFileRecordSet{
+				{Name: "a.example.com", TTL: 60, Type: awstypes.RRTypeA, Records: []string{"192.0.2.1", "192.0.2.2"}},
+			},
+		},
+		"TXT": {
+			content: `$ORIGIN example.com.
+$TTL 300
+@	TXT	"v=spf1 include:_spf.example.net ~all"
+long	TXT	( "part one "
+		"part two" )
+quote	TXT	"say \"hi\"" unquoted
+esc	TXT	"caf\195\169;"
+`,
+			expected: []zoneFileRecordSet{
+				{Name: "example.com", TTL: 300, Type: awstypes.RRTypeTxt, Records: []string{"v=spf1 include:_spf.example.net ~all"}},
+				{Name: "long.example.com", TTL: 300, Type: awstypes.RRTypeTxt, Records: []string{`part one " "part two`}},
+				{Name: "quote.example.com", TTL: 300, Type: awstypes.RRTypeTxt, Records: []string{`say \"hi\"" "unquoted`}},
+				{Name: "esc.example.com", TTL: 300, Type: awstypes.RRTypeTxt, Records: []string{`caf\303\251;`}},
+			},
+		},
+		"CAA and escaped names": {
+			content: "$TTL 1d\nexample.com. CAA 0 issue \"letsencrypt.org\"\na\\.b\\047c.example.com. A 192.0.2.1\n",
+			expected: []zoneFileRecordSet{
+				{Name: "example.com", TTL: 86400, Type: awstypes.RRTypeCaa, Records: []string{`0 issue "letsencrypt.org"`}},
+				{Name: `a\056b\057c.example.com`, TTL: 86400, Type: awstypes.RRTypeA, Records: []string{"192.0.2.1"}},
+			},
+		},
+		"no origin": {
+			content: "$TTL 300\nwww A 192.0.2.1\n",
+			wantErr: `line 2: relative domain name "www" used without an origin`,
+		},
+		"no TTL": {
+			content: "www.example.com. A 192.0.2.1\n",
+			wantErr: "line 1: no TTL",
+		},
+		"unsupported type": {
+			content: "$TTL 300\nwww.example.com. HINFO \"PC\" \"Linux\"\n",
+			wantErr: "line 2: unsupported record type HINFO",
+		},
+		"unsupported directive": {
+			content: "$INCLUDE other.zone\n",
+			wantErr: "line 1: unsupported directive $INCLUDE",
+		},
+		"unsupported class": {
+			content: "www.example.com. 300 CH A 192.0.2.1\n",
+			wantErr: "line 1: unsupported class CH",
+		},
+		"invalid field count": {
+			content: "www.example.com. 300 MX mail.example.com.\n",
+			wantErr: "line 1: invalid number of MX record fields: 1",
+		},
+		"unbalanced parentheses": {
+			content: "$TTL 300\nexample.com. SOA ns1.example.com. hostmaster.example.com. ( 1 2 3 4 5\n",
+			wantErr: "line 2: unbalanced parentheses",
+		},
+		"unterminated quoted string": {
+			content: "$TTL 300\nexample.com. TXT \"abc\n",
+			wantErr: "line 2: unterminated quoted string",
+		},
+		"invalid TTL": {
+			content: "$TTL 5x\n",
+			wantErr: `line 1: invalid TTL "5x"`,
+		},
+		"multiple errors": {
+			content: "www.example.com. 300 A\nftp.example.com. 300 A 192.0.2.1 192.0.2.2\n",
+			wantErr: "line 1: invalid number of A record fields: 0\nline 2: invalid number of A record fields: 2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseZoneFile(testCase.content, testCase.origin, testCase.defaultTTL)
+
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expected int64
+		wantErr  bool
+	}{
+		"0":          {expected: 0},
+		"3600":       {expected: 3600},
+		"1h30m":      {expected: 5400},
+		"1W2D":       {expected: 777600},
+		"90s":        {expected: 90},
+		"2147483647": {expected: 2147483647},
+		"2147483648": {wantErr: true},
+		"h":          {wantErr: true},
+		"1y":         {wantErr: true},
+	}
+
+	for input, testCase := range testCases {
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseZoneFileTTL(input)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("parseZoneFileTTL(%q) err %t, want %t", input, got, want)
+			}
+			if got != testCase.expected {
+				t.Errorf("parseZoneFileTTL(%q) = %d, want %d", input, got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestRenderZoneFile(t *testing.T) {
+	t.Parallel()
+
+	recordSets := []awstypes.ResourceRecordSet{
+		{
+			Name:            aws.String("example.com."),
+			ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("ns-1.awsdns-01.org.")}, {Value: aws.String("ns-2.awsdns-02.com.")}},
+			TTL:             aws.Int64(172800),
+			Type:            awstypes.RRTypeNs,
+		},
+		{
+			Name:            aws.String("example.com."),
+			ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String(`"v=spf1 -all"`)}, {Value: aws.String(`"caf\303\251"`)}},
+			TTL:             aws.Int64(300),
+			Type:            awstypes.RRTypeTxt,
+		},
+		{
+			Name: aws.String("cdn.example.com."),
+			AliasTarget: &awstypes.AliasTarget{
+				DNSName:      aws.String("d111111abcdef8.cloudfront.net."),
+				HostedZoneId: aws.String("Z2FDTNDATAQYW2"),
+			},
+			Type: awstypes.RRTypeA,
+		},
+		{
+			Name:            aws.String(`\052.example.com.`),
+			ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("192.0.2.1")}},
+			TTL:             aws.Int64(60),
+			Type:            awstypes.RRTypeA,
+		},
+		{
+			Name:            aws.String("www.example.com."),
+			ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("192.0.2.2")}},
+			SetIdentifier:   aws.String("blue"),
+			TTL:             aws.Int64(60),
+			Type:            awstypes.RRTypeA,
+			Weight:          aws.Int64(10),
+		},
+	}
+
+	got := renderZoneFile("example.com.", recordSets)
+	expected := `$ORIGIN example.com.
+example.com.	172800	IN	NS	ns-1.awsdns-01.org.
+example.com.	172800	IN	NS	ns-2.awsdns-02.com.
+example.com.	300	IN	TXT	"v=spf1 -all"
+example.com.	300	IN	TXT	"caf\195\169"
+; cdn.example.com.	ALIAS	A	d111111abcdef8.cloudfront.net.	; hosted zone Z2FDTNDATAQYW2
+*.example.com.	60	IN	A	192.0.2.1
+; www.example.com.	60	IN	A	192.0.2.2	; set identifier "blue"
+`
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// The rendered zone file can be parsed back.
+	parsed, err := parseZoneFile(got, "", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedRecordSets := []zoneFileRecordSet{
+		{Name: "example.com", TTL: 172800, Type: awstypes.RRTypeNs, Records: []string{"ns-1.awsdns-01.org.", "ns-2.awsdns-02.com."}},
+		{Name: "example.com", TTL: 300, Type: awstypes.RRTypeTxt, Records: []string{"v=spf1 -all", `caf\303\251`}},
+		{Name: "*.example.com", TTL: 60, Type: awstypes.RRTypeA, Records: []string{"192.0.2.1"}},
+	}
+
+	if diff := cmp.Diff(parsed, expectedRecordSets); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
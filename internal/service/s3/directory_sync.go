@@ -0,0 +1,578 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"maps"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @SDKResource("aws_s3_directory_sync", name="Directory Sync")
+func resourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySyncCreate,
+		ReadWithoutTimeout:   resourceDirectorySyncRead,
+		UpdateWithoutTimeout: resourceDirectorySyncUpdate,
+		DeleteWithoutTimeout: resourceDirectorySyncDelete,
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"delete_extraneous": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"include": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "",
+			},
+			"objects": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			names.AttrSource: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket, prefix := d.Get(names.AttrBucket).(string), d.Get("key_prefix").(string)
+
+	if err := directorySync(ctx, d, meta, nil, true); err != nil {
+		return sdkdiag.AppendErrorf(diags, "synchronizing S3 Directory Sync (%s) to Bucket (%s): %s", d.Get(names.AttrSource).(string), bucket, err)
+	}
+
+	d.SetId(directorySyncCreateResourceID(bucket, prefix))
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, optFns := directorySyncClient(ctx, d, meta)
+
+	bucket, prefix := d.Get(names.AttrBucket).(string), d.Get("key_prefix").(string)
+	objects, err := findDirectorySyncObjects(ctx, conn, bucket, prefix, optFns...)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Directory Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	// Only report objects not managed by this resource if they are to be deleted.
+	if !d.Get("delete_extraneous").(bool) {
+		managed := d.Get("objects").(map[string]interface{})
+		maps.DeleteFunc(objects, func(k, _ string) bool {
+			_, ok := managed[k]
+			return !ok
+		})
+	}
+
+	d.Set("objects", objects)
+
+	return diags
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	o, _ := d.GetChange("objects")
+	managed := flex.ExpandStringValueMap(o.(map[string]interface{}))
+
+	// Object metadata is not reflected in the ETag, so re-upload everything if it may have changed.
+	force := d.HasChanges("cache_control_rule", "content_types")
+
+	if err := directorySync(ctx, d, meta, managed, force); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn, optFns := directorySyncClient(ctx, d, meta)
+
+	keys := slices.Sorted(maps.Keys(d.Get("objects").(map[string]interface{})))
+
+	log.Printf("[INFO] Deleting S3 Directory Sync: %s", d.Id())
+	if err := deleteDirectorySyncObjects(ctx, conn, d.Get(names.AttrBucket).(string), keys, optFns...); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(names.AttrSource) || !d.NewValueKnown("include") || !d.NewValueKnown("exclude") {
+		return d.SetNewComputed("objects")
+	}
+
+	files, err := directorySyncLocalFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	o := d.Get("objects").(map[string]interface{})
+	n := make(map[string]interface{}, len(files))
+	for _, file := range files {
+		n[file.key] = file.etag
+	}
+
+	if maps.EqualFunc(o, n, func(v1, v2 interface{}) bool { return v1 == v2 }) {
+		return nil
+	}
+
+	return d.SetNew("objects", n)
+}
+
+type directorySyncFile struct {
+	cacheControl string
+	contentType  string
+	etag         string
+	key          string
+	path         string
+}
+
+// directorySync uploads local files that differ from the objects in the bucket and removes objects no longer present locally.
+// managed contains the keys of objects previously uploaded. If force is true every local file is uploaded.
+func directorySync(ctx context.Context, d *schema.ResourceData, meta interface{}, managed map[string]string, force bool) error {
+	conn, optFns := directorySyncClient(ctx, d, meta)
+	bucket, prefix := d.Get(names.AttrBucket).(string), d.Get("key_prefix").(string)
+
+	files, err := directorySyncLocalFiles(d)
+
+	if err != nil {
+		return err
+	}
+
+	remote, err := findDirectorySyncObjects(ctx, conn, bucket, prefix, optFns...)
+
+	if err != nil {
+		return err
+	}
+
+	var uploads []directorySyncFile
+	local := make(map[string]struct{}, len(files))
+	for _, file := range files {
+		local[file.key] = struct{}{}
+
+		if etag, ok := remote[file.key]; !force && ok && etag == file.etag {
+			continue
+		}
+
+		uploads = append(uploads, file)
+	}
+
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	if err := directorySyncUpload(ctx, uploader, bucket, uploads, d.Get("parallelism").(int)); err != nil {
+		return err
+	}
+
+	var deletes []string
+	for key := range remote {
+		if _, ok := local[key]; ok {
+			continue
+		}
+
+		if _, ok := managed[key]; ok || d.Get("delete_extraneous").(bool) {
+			deletes = append(deletes, key)
+		}
+	}
+	slices.Sort(deletes)
+
+	return deleteDirectorySyncObjects(ctx, conn, bucket, deletes, optFns...)
+}
+
+func directorySyncClient(ctx context.Context, d *schema.ResourceData, meta interface{}) (*s3.Client, []func(*s3.Options)) {
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	var optFns []func(*s3.Options)
+	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
+	if arn.IsARN(bucket) && conn.Options().Region == endpoints.AwsGlobalRegionID {
+		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	}
+
+	return conn, optFns
+}
+
+// directorySyncLocalFiles walks the source directory and returns the files to be synchronized.
+func directorySyncLocalFiles(d sdkv2.ResourceDiffer) ([]directorySyncFile, error) {
+	source := d.Get(names.AttrSource).(string)
+	root, err := homedir.Expand(source)
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+	}
+
+	prefix := d.Get("key_prefix").(string)
+	includes := flex.ExpandStringValueSet(d.Get("include").(*schema.Set))
+	excludes := flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set))
+	contentTypes := flex.ExpandStringValueMap(d.Get("content_types").(map[string]interface{}))
+	var cacheControlRules [][2]string
+	for _, tfMapRaw := range d.Get("cache_control_rule").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		cacheControlRules = append(cacheControlRules, [2]string{tfMap["pattern"].(string), tfMap[names.AttrValue].(string)})
+	}
+
+	var files []directorySyncFile
+	err = filepath.WalkDir(root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, filePath)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if len(includes) > 0 && !directorySyncMatch(includes, rel) {
+			return nil
+		}
+
+		if directorySyncMatch(excludes, rel) {
+			return nil
+		}
+
+		etag, err := directorySyncFileETag(filePath)
+		if err != nil {
+			return err
+		}
+
+		file := directorySyncFile{
+			etag: etag,
+			key:  prefix + rel,
+			path: filePath,
+		}
+
+		ext := path.Ext(rel)
+		if v, ok := contentTypes[ext]; ok {
+			file.contentType = v
+		} else {
+			file.contentType = mime.TypeByExtension(ext)
+		}
+
+		for _, rule := range cacheControlRules {
+			if directorySyncMatch([]string{rule[0]}, rel) {
+				file.cacheControl = rule[1]
+				break
+			}
+		}
+
+		files = append(files, file)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", root, err)
+	}
+
+	return files, nil
+}
+
+// directorySyncMatch returns whether the slash-separated relative path matches any of the glob patterns.
+// Patterns without a slash are matched against the file name, otherwise against the relative path.
+func directorySyncMatch(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		name := rel
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(rel)
+		}
+
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// directorySyncPartSize returns the part size the uploader uses for an object of the specified size.
+func directorySyncPartSize(size int64) int64 {
+	partSize := manager.DefaultUploadPartSize
+
+	if size/partSize >= int64(manager.MaxUploadParts) {
+		partSize = (size / int64(manager.MaxUploadParts)) + 1
+	}
+
+	return partSize
+}
+
+// directorySyncFileETag returns the ETag S3 calculates for an unencrypted or SSE-S3 encrypted object uploaded from the specified file.
+// Objects uploaded in a single part have an MD5 digest ETag, multipart uploads have an ETag
+// of the MD5 digest of the concatenated part digests suffixed with the number of parts.
+func directorySyncFileETag(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	partSize := directorySyncPartSize(info.Size())
+
+	if info.Size() <= partSize {
+		h := md5.New()
+		if _, err := io.Copy(h, file); err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%x", h.Sum(nil)), nil
+	}
+
+	var digests []byte
+	var nParts int
+	for {
+		h := md5.New()
+		n, err := io.CopyN(h, file, partSize)
+
+		if n > 0 {
+			digests = append(digests, h.Sum(nil)...)
+			nParts++
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return fmt.Sprintf("%x-%d", md5.Sum(digests), nParts), nil
+}
+
+// directorySyncUpload uploads files using at most parallelism concurrent uploads.
+func directorySyncUpload(ctx context.Context, uploader *manager.Uploader, bucket string, files []directorySyncFile, parallelism int) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+
+	sem := make(chan struct{}, parallelism)
+	for _, file := range files {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := directorySyncUploadFile(ctx, uploader, bucket, file); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func directorySyncUploadFile(ctx context.Context, uploader *manager.Uploader, bucket string, file directorySyncFile) error {
+	body, err := os.Open(file.path)
+	if err != nil {
+		return fmt.Errorf("opening S3 object source (%s): %w", file.path, err)
+	}
+	defer func() {
+		err := body.Close()
+		if err != nil {
+			log.Printf("[WARN] Error closing S3 object source (%s): %s", file.path, err)
+		}
+	}()
+
+	input := &s3.PutObjectInput{
+		Body:   body,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(file.key),
+	}
+
+	if file.cacheControl != "" {
+		input.CacheControl = aws.String(file.cacheControl)
+	}
+
+	if file.contentType != "" {
+		input.ContentType = aws.String(file.contentType)
+	}
+
+	if _, err := uploader.Upload(ctx, input); err != nil {
+		return fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", file.key, bucket, err)
+	}
+
+	return nil
+}
+
+// findDirectorySyncObjects returns the ETags of the objects in the bucket with the specified key prefix.
+func findDirectorySyncObjects(ctx context.Context, conn *s3.Client, bucket, prefix string, optFns ...func(*s3.Options)) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket:       aws.String(bucket),
+		EncodingType: types.EncodingTypeUrl,
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	objects := make(map[string]string)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			// Reverse URL-encoding from requested EncodingType: "url"
+			key, err := url.QueryUnescape(aws.ToString(v.Key))
+			if err != nil {
+				return nil, fmt.Errorf("unescaping object key: %w", err)
+			}
+
+			objects[key] = strings.Trim(aws.ToString(v.ETag), `"`)
+		}
+	}
+
+	return objects, nil
+}
+
+func deleteDirectorySyncObjects(ctx context.Context, conn *s3.Client, bucket string, keys []string, optFns ...func(*s3.Options)) error {
+	// DeleteObjects accepts at most 1000 keys.
+	for chunk := range slices.Chunk(keys, 1000) {
+		toDelete := make([]types.ObjectIdentifier, 0, len(chunk))
+		for _, key := range chunk {
+			toDelete = append(toDelete, types.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		if _, err := deletePage(ctx, conn, bucket, false, toDelete, optFns...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func directorySyncCreateResourceID(bucket, prefix string) string {
+	if prefix == "" {
+		return bucket
+	}
+
+	return strings.Join([]string{bucket, prefix}, resourceIDSeparator)
+}
@@ -0,0 +1,443 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDirectorySyncMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		patterns []string
+		rel      string
+		want     bool
+	}{
+		"no patterns": {
+			rel: "a.txt",
+		},
+		"file name": {
+			patterns: []string{"*.txt"},
+			rel:      "dir/a.txt",
+			want:     true,
+		},
+		"file name no match": {
+			patterns: []string{"*.txt"},
+			rel:      "dir/a.html",
+		},
+		"relative path": {
+			patterns: []string{"dir/*.txt"},
+			rel:      "dir/a.txt",
+			want:     true,
+		},
+		"relative path other directory": {
+			patterns: []string{"dir/*.txt"},
+			rel:      "other/a.txt",
+		},
+		"relative path does not match subdirectory": {
+			patterns: []string{"dir/*.txt"},
+			rel:      "dir/sub/a.txt",
+		},
+		"any pattern": {
+			patterns: []string{"*.html", "*.txt"},
+			rel:      "a.txt",
+			want:     true,
+		},
+		"invalid pattern": {
+			patterns: []string{"["},
+			rel:      "[",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.DirectorySyncMatch(testCase.patterns, testCase.rel), testCase.want; got != want {
+				t.Errorf("DirectorySyncMatch(%q, %q) = %t, want %t", testCase.patterns, testCase.rel, got, want)
+			}
+		})
+	}
+}
+
+func TestDirectorySyncPartSize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		size int64
+		want int64
+	}{
+		"empty": {
+			size: 0,
+			want: manager.DefaultUploadPartSize,
+		},
+		"single part": {
+			size: manager.DefaultUploadPartSize,
+			want: manager.DefaultUploadPartSize,
+		},
+		"below maximum parts": {
+			size: manager.DefaultUploadPartSize*int64(manager.MaxUploadParts) - 1,
+			want: manager.DefaultUploadPartSize,
+		},
+		"maximum parts": {
+			size: manager.DefaultUploadPartSize * int64(manager.MaxUploadParts),
+			want: manager.DefaultUploadPartSize + 1,
+		},
+		"above maximum parts": {
+			size: 2 * manager.DefaultUploadPartSize * int64(manager.MaxUploadParts),
+			want: 2*manager.DefaultUploadPartSize + 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.DirectorySyncPartSize(testCase.size), testCase.want; got != want {
+				t.Errorf("DirectorySyncPartSize(%d) = %d, want %d", testCase.size, got, want)
+			}
+		})
+	}
+}
+
+func TestDirectorySyncFileETag(t *testing.T) {
+	t.Parallel()
+
+	partSize := int(manager.DefaultUploadPartSize)
+	multipart := bytes.Repeat([]byte("a"), partSize+1)
+	part1, part2 := md5.Sum(multipart[:partSize]), md5.Sum(multipart[partSize:])
+
+	testCases := map[string]struct {
+		content []byte
+		want    string
+	}{
+		"empty": {
+			content: []byte{},
+			want:    "d41d8cd98f00b204e9800998ecf8427e",
+		},
+		"single part": {
+			content: []byte("hello"),
+			want:    "5d41402abc4b2a76b9719d911017c592",
+		},
+		"multipart": {
+			content: multipart,
+			want:    fmt.Sprintf("%x-2", md5.Sum(append(part1[:], part2[:]...))),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			filePath := filepath.Join(t.TempDir(), "file")
+			if err := os.WriteFile(filePath, testCase.content, 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tfs3.DirectorySyncFileETag(filePath)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if want := testCase.want; got != want {
+				t.Errorf("DirectorySyncFileETag() = %q, want %q", got, want)
+			}
+		})
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := tfs3.DirectorySyncFileETag(filepath.Join(t.TempDir(), "missing")); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjectCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "2"),
+					// MD5 of "<html></html>".
+					resource.TestCheckResourceAttr(resourceName, "objects.site/index.html", "c83301425b2ad1d496473a5ff3d9ecca"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_includeExclude(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html":       "<html></html>",
+		"error.html":       "<html>error</html>",
+		"css/site.css":     "body {}",
+		"drafts/page.html": "<html>draft</html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_includeExclude(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjectCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/error.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjectCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(source, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.WriteFile(filepath.Join(source, "about.html"), []byte("<html>about</html>"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjectCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/about.html"),
+					resource.TestCheckNoResourceAttr(resourceName, "objects.site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteExtraneous(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncCreateTempDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjectCount(ctx, resourceName, 1),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+					input := &s3.PutObjectInput{
+						Body:   strings.NewReader("extraneous"),
+						Bucket: aws.String(rName),
+						Key:    aws.String("site/extraneous.txt"),
+					}
+
+					if _, err := conn.PutObject(ctx, input); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_deleteExtraneous(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjectCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectorySyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_sync" {
+				continue
+			}
+
+			objects, err := tfs3.FindDirectorySyncObjects(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["key_prefix"])
+
+			if err != nil {
+				// The bucket may already have been destroyed.
+				continue
+			}
+
+			for key := range objects {
+				if _, ok := rs.Primary.Attributes["objects."+key]; ok {
+					return fmt.Errorf("S3 Directory Sync %s object %s still exists", rs.Primary.ID, key)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		objects, err := tfs3.FindDirectorySyncObjects(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["key_prefix"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(objects); got != want {
+			return fmt.Errorf("S3 Directory Sync %s has %d objects, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectorySyncCreateTempDir(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func testAccDirectorySyncConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccDirectorySyncConfig_basic(rName, source string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source     = %[1]q
+
+  cache_control_rule {
+    pattern = "*.html"
+    value   = "no-cache"
+  }
+
+  content_types = {
+    ".css" = "text/css; charset=utf-8"
+  }
+}
+`, source))
+}
+
+func testAccDirectorySyncConfig_includeExclude(rName, source string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source     = %[1]q
+
+  include = ["*.html"]
+  exclude = ["drafts/*"]
+}
+`, source))
+}
+
+func testAccDirectorySyncConfig_deleteExtraneous(rName, source string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source     = %[1]q
+
+  delete_extraneous = true
+}
+`, source))
+}
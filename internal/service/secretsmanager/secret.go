@@ -34,6 +34,7 @@ import (
 
 // @SDKResource("aws_secretsmanager_secret", name="Secret")
 // @Tags(identifierAttribute="arn")
+// @ARNLookup(service="secretsmanager", resourceType="secret", finder="lookupSecretByARN")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/secretsmanager;secretsmanager.DescribeSecretOutput")
 // @Testing(importIgnore="force_overwrite_replica_secret;recovery_window_in_days")
 func resourceSecret() *schema.Resource {
@@ -488,6 +489,13 @@ func findSecret(ctx context.Context, conn *secretsmanager.Client, input *secrets
 	return output, nil
 }
 
+// lookupSecretByARN finds the secret with the specified ARN for lookup-by-ARN.
+func lookupSecretByARN(ctx context.Context, meta any, arn string) error {
+	_, err := findSecretByID(ctx, meta.(*conns.AWSClient).SecretsManagerClient(ctx), arn)
+
+	return err
+}
+
 func findSecretByID(ctx context.Context, conn *secretsmanager.Client, id string) (*secretsmanager.DescribeSecretOutput, error) {
 	input := &secretsmanager.DescribeSecretInput{
 		SecretId: aws.String(id),
@@ -85,6 +85,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			ARNLookup: &types.ServicePackageResourceARNLookup{
+				Service:      "secretsmanager",
+				ResourceType: "secret",
+				Find:         lookupSecretByARN,
+			},
 		},
 		{
 			Factory:  resourceSecretPolicy,
@@ -15,7 +15,13 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newStateMachineDefinitionDocumentDataSource,
+			TypeName: "aws_sfn_state_machine_definition_document",
+			Name:     "State Machine Definition Document",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -69,6 +75,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			ARNLookup: &types.ServicePackageResourceARNLookup{
+				Service:      "states",
+				ResourceType: "stateMachine",
+				Find:         lookupStateMachineByARN,
+			},
 		},
 	}
 }
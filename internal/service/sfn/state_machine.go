@@ -35,6 +35,7 @@ import (
 
 // @SDKResource("aws_sfn_state_machine", name="State Machine")
 // @Tags(identifierAttribute="id")
+// @ARNLookup(service="states", resourceType="stateMachine", finder="lookupStateMachineByARN")
 func resourceStateMachine() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceStateMachineCreate,
@@ -409,6 +410,13 @@ func resourceStateMachineDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+// lookupStateMachineByARN finds the state machine with the specified ARN for lookup-by-ARN.
+func lookupStateMachineByARN(ctx context.Context, meta any, arn string) error {
+	_, err := findStateMachineByARN(ctx, meta.(*conns.AWSClient).SFNClient(ctx), arn)
+
+	return err
+}
+
 func findStateMachineByARN(ctx context.Context, conn *sfn.Client, arn string) (*sfn.DescribeStateMachineOutput, error) {
 	input := &sfn.DescribeStateMachineInput{
 		StateMachineArn: aws.String(arn),
@@ -0,0 +1,807 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+const (
+	queryLanguageJSONata  = "JSONata"
+	queryLanguageJSONPath = "JSONPath"
+)
+
+func queryLanguage_Values() []string {
+	return []string{
+		queryLanguageJSONata,
+		queryLanguageJSONPath,
+	}
+}
+
+const (
+	stateTypeChoice   = "Choice"
+	stateTypeFail     = "Fail"
+	stateTypeMap      = "Map"
+	stateTypeParallel = "Parallel"
+	stateTypePass     = "Pass"
+	stateTypeSucceed  = "Succeed"
+	stateTypeTask     = "Task"
+	stateTypeWait     = "Wait"
+)
+
+func stateType_Values() []string {
+	return []string{
+		stateTypeChoice,
+		stateTypeFail,
+		stateTypeMap,
+		stateTypeParallel,
+		stateTypePass,
+		stateTypeSucceed,
+		stateTypeTask,
+		stateTypeWait,
+	}
+}
+
+// stateFields is the set of fields valid in each type of state, in addition to Type, Comment and QueryLanguage.
+var stateFields = map[string][]string{
+	stateTypeChoice: {
+		"Assign", "Choices", "Default", "InputPath", "Output", "OutputPath",
+	},
+	stateTypeFail: {
+		"Cause", "CausePath", "Error", "ErrorPath",
+	},
+	stateTypeMap: {
+		"Assign", "Catch", "End", "InputPath", "ItemBatcher", "ItemProcessor", "ItemReader", "ItemSelector", "Items", "ItemsPath", "Iterator", "Label",
+		"MaxConcurrency", "MaxConcurrencyPath", "Next", "Output", "OutputPath", "Parameters", "ResultPath", "ResultSelector", "ResultWriter", "Retry",
+		"ToleratedFailureCount", "ToleratedFailureCountPath", "ToleratedFailurePercentage", "ToleratedFailurePercentagePath",
+	},
+	stateTypeParallel: {
+		"Arguments", "Assign", "Branches", "Catch", "End", "InputPath", "Next", "Output", "OutputPath", "Parameters", "ResultPath", "ResultSelector", "Retry",
+	},
+	stateTypePass: {
+		"Assign", "End", "InputPath", "Next", "Output", "OutputPath", "Parameters", "Result", "ResultPath",
+	},
+	stateTypeSucceed: {
+		"InputPath", "Output", "OutputPath",
+	},
+	stateTypeTask: {
+		"Arguments", "Assign", "Catch", "Credentials", "End", "HeartbeatSeconds", "HeartbeatSecondsPath", "InputPath", "Next", "Output", "OutputPath",
+		"Parameters", "Resource", "ResultPath", "ResultSelector", "Retry", "TimeoutSeconds", "TimeoutSecondsPath",
+	},
+	stateTypeWait: {
+		"Assign", "End", "InputPath", "Next", "Output", "OutputPath", "Seconds", "SecondsPath", "Timestamp", "TimestampPath",
+	},
+}
+
+var (
+	// jsonPathOnlyFields are the fields only valid in states using JSONPath.
+	jsonPathOnlyFields = []string{
+		"CausePath", "ErrorPath", "HeartbeatSecondsPath", "InputPath", "ItemsPath", "MaxConcurrencyPath", "OutputPath", "Parameters", "ResultPath",
+		"ResultSelector", "SecondsPath", "TimeoutSecondsPath", "TimestampPath", "ToleratedFailureCountPath", "ToleratedFailurePercentagePath",
+	}
+	// jsonataOnlyFields are the fields only valid in states using JSONata.
+	jsonataOnlyFields = []string{
+		"Arguments", "Items", "Output",
+	}
+	// jsonPathFields are the fields whose value is a path.
+	jsonPathFields = []string{
+		"CausePath", "ErrorPath", "HeartbeatSecondsPath", "InputPath", "ItemsPath", "MaxConcurrencyPath", "OutputPath", "ResultPath",
+		"SecondsPath", "TimeoutSecondsPath", "TimestampPath", "ToleratedFailureCountPath", "ToleratedFailurePercentagePath",
+	}
+	// payloadTemplateFields are the fields whose value is a payload template.
+	payloadTemplateFields = []string{
+		"ItemSelector", "Parameters", "ResultSelector",
+	}
+)
+
+var (
+	intrinsicFunctions = []string{
+		"States.Array", "States.ArrayContains", "States.ArrayGetItem", "States.ArrayLength", "States.ArrayPartition", "States.ArrayRange",
+		"States.ArrayUnique", "States.Base64Decode", "States.Base64Encode", "States.Format", "States.Hash", "States.JsonMerge",
+		"States.JsonToString", "States.MathAdd", "States.MathRandom", "States.StringSplit", "States.StringToJson", "States.UUID",
+	}
+	intrinsicFunctionRegexp = regexache.MustCompile(`^(States\.[A-Za-z0-9]+)\((.*)\)$`)
+	jsonPathSliceRegexp     = regexache.MustCompile(`^-?[0-9]*:-?[0-9]*(:-?[0-9]*)?$`)
+	jsonPathUnionRegexp     = regexache.MustCompile(`^(-?[0-9]+|'[^']*'|"[^"]*")(\s*,\s*(-?[0-9]+|'[^']*'|"[^"]*"))*$`)
+	jsonPathVariableRegexp  = regexache.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*`)
+)
+
+// validateStateMachineDefinition performs local structural validation of an Amazon States Language document.
+// It catches the errors most commonly surfaced by CreateStateMachine without calling the API:
+// missing or dangling transitions, unreachable states, missing terminal states, fields that are not valid
+// for a state's type or query language and malformed JSONPath and JSONata expressions.
+func validateStateMachineDefinition(definition string) error {
+	var doc map[string]any
+
+	if err := json.Unmarshal([]byte(definition), &doc); err != nil {
+		return fmt.Errorf("decoding definition: %w", err)
+	}
+
+	v := &definitionValidator{}
+	v.validateDefinition("", doc, queryLanguageJSONPath)
+
+	return errors.Join(v.errs...)
+}
+
+type definitionValidator struct {
+	errs []error
+}
+
+func (v *definitionValidator) errorf(path, format string, a ...any) {
+	if path != "" {
+		format = "%s: " + format
+		a = append([]any{path}, a...)
+	}
+
+	v.errs = append(v.errs, fmt.Errorf(format, a...))
+}
+
+// validateDefinition validates a state machine, or a branch or item processor nested in one.
+func (v *definitionValidator) validateDefinition(path string, doc map[string]any, defaultQueryLanguage string) {
+	queryLanguage := defaultQueryLanguage
+
+	if s, ok := doc["QueryLanguage"]; ok {
+		s, ok := s.(string)
+		if !ok || !slices.Contains(queryLanguage_Values(), s) {
+			v.errorf(joinPath(path, "QueryLanguage"), "must be one of %s", strings.Join(queryLanguage_Values(), ", "))
+		} else {
+			queryLanguage = s
+		}
+	}
+
+	startAt, ok := doc["StartAt"].(string)
+	if !ok || startAt == "" {
+		v.errorf(joinPath(path, "StartAt"), "is required")
+	}
+
+	states, ok := doc["States"].(map[string]any)
+	if !ok || len(states) == 0 {
+		v.errorf(joinPath(path, "States"), "must contain at least one state")
+		return
+	}
+
+	if startAt != "" {
+		if _, ok := states[startAt]; !ok {
+			v.errorf(joinPath(path, "StartAt"), "state %q does not exist", startAt)
+		}
+	}
+
+	names := tfmaps.Keys(states)
+	slices.Sort(names)
+
+	terminal := false
+	transitions := make(map[string][]string, len(states))
+
+	for _, name := range names {
+		statePath := joinPath(path, "States", name)
+
+		if n := len(name); n == 0 || n > 80 {
+			v.errorf(statePath, "state name must be between 1 and 80 characters")
+		}
+
+		state, ok := states[name].(map[string]any)
+		if !ok {
+			v.errorf(statePath, "must be an object")
+			continue
+		}
+
+		next, isTerminal := v.validateState(statePath, state, queryLanguage)
+
+		for _, target := range next {
+			if _, ok := states[target.name]; !ok {
+				v.errorf(target.path, "state %q does not exist", target.name)
+				continue
+			}
+
+			transitions[name] = append(transitions[name], target.name)
+		}
+
+		terminal = terminal || isTerminal
+	}
+
+	if !terminal {
+		v.errorf(path, "no terminal state: at least one state must be a Succeed or Fail state or have End set to true")
+	}
+
+	if _, ok := states[startAt]; !ok {
+		return
+	}
+
+	// Find the states reachable from StartAt.
+	reachable := map[string]bool{startAt: true}
+	queue := []string{startAt}
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		for _, target := range transitions[name] {
+			if !reachable[target] {
+				reachable[target] = true
+				queue = append(queue, target)
+			}
+		}
+	}
+
+	for _, name := range names {
+		if !reachable[name] {
+			v.errorf(joinPath(path, "States", name), "state is not reachable from %q", startAt)
+		}
+	}
+}
+
+type transition struct {
+	name string
+	path string
+}
+
+// validateState validates a single state, returning its transitions and whether it can end the execution.
+func (v *definitionValidator) validateState(path string, state map[string]any, defaultQueryLanguage string) ([]transition, bool) {
+	stateType, _ := state["Type"].(string)
+	fields, ok := stateFields[stateType]
+
+	if !ok {
+		v.errorf(joinPath(path, "Type"), "must be one of %s", strings.Join(stateType_Values(), ", "))
+		return nil, false
+	}
+
+	queryLanguage := defaultQueryLanguage
+
+	if s, ok := state["QueryLanguage"]; ok {
+		s, ok := s.(string)
+
+		switch {
+		case !ok || !slices.Contains(queryLanguage_Values(), s):
+			v.errorf(joinPath(path, "QueryLanguage"), "must be one of %s", strings.Join(queryLanguage_Values(), ", "))
+		case s == queryLanguageJSONPath && defaultQueryLanguage == queryLanguageJSONata:
+			v.errorf(joinPath(path, "QueryLanguage"), "cannot be %s when the state machine uses %s", queryLanguageJSONPath, queryLanguageJSONata)
+		default:
+			queryLanguage = s
+		}
+	}
+
+	keys := tfmaps.Keys(state)
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		switch {
+		case k == "Type" || k == "Comment" || k == "QueryLanguage":
+		case !slices.Contains(fields, k):
+			v.errorf(joinPath(path, k), "field is not valid for a %s state", stateType)
+		case queryLanguage == queryLanguageJSONata && slices.Contains(jsonPathOnlyFields, k):
+			v.errorf(joinPath(path, k), "field is not valid in a state using %s", queryLanguageJSONata)
+		case queryLanguage == queryLanguageJSONPath && slices.Contains(jsonataOnlyFields, k):
+			v.errorf(joinPath(path, k), "field is not valid in a state using %s", queryLanguageJSONPath)
+		}
+	}
+
+	if queryLanguage == queryLanguageJSONPath {
+		for _, k := range jsonPathFields {
+			if s, ok := state[k].(string); ok {
+				if err := validateJSONPath(s); err != nil {
+					v.errorf(joinPath(path, k), "%s", err)
+				}
+			}
+		}
+
+		for _, k := range payloadTemplateFields {
+			if t, ok := state[k]; ok {
+				v.validatePayloadTemplate(joinPath(path, k), t)
+			}
+		}
+	} else {
+		for _, k := range keys {
+			v.validateJSONataExpressions(joinPath(path, k), state[k])
+		}
+	}
+
+	var next []transition
+	terminal := false
+
+	switch stateType {
+	case stateTypeChoice:
+		if _, ok := state["Next"]; ok {
+			v.errorf(joinPath(path, "Next"), "field is not valid for a %s state", stateType)
+		}
+		if _, ok := state["End"]; ok {
+			v.errorf(joinPath(path, "End"), "field is not valid for a %s state", stateType)
+		}
+
+		choices, _ := state["Choices"].([]any)
+
+		if len(choices) == 0 {
+			v.errorf(joinPath(path, "Choices"), "must contain at least one choice rule")
+		}
+
+		for i, choice := range choices {
+			choicePath := joinPath(path, fmt.Sprintf("Choices[%d]", i))
+			choice, ok := choice.(map[string]any)
+
+			if !ok {
+				v.errorf(choicePath, "must be an object")
+				continue
+			}
+
+			if s, ok := choice["Next"].(string); ok && s != "" {
+				next = append(next, transition{name: s, path: joinPath(choicePath, "Next")})
+			} else {
+				v.errorf(joinPath(choicePath, "Next"), "is required")
+			}
+
+			v.validateChoiceRule(choicePath, choice, queryLanguage, true)
+		}
+
+		if s, ok := state["Default"].(string); ok {
+			next = append(next, transition{name: s, path: joinPath(path, "Default")})
+		}
+	case stateTypeFail, stateTypeSucceed:
+		terminal = true
+	default:
+		s, hasNext := state["Next"].(string)
+		end, _ := state["End"].(bool)
+
+		switch {
+		case hasNext && end:
+			v.errorf(path, "only one of Next or End can be specified")
+		case hasNext:
+			next = append(next, transition{name: s, path: joinPath(path, "Next")})
+		case end:
+			terminal = true
+		default:
+			v.errorf(path, "one of Next or End must be specified")
+		}
+	}
+
+	switch stateType {
+	case stateTypeMap:
+		processor, ok := state["ItemProcessor"].(map[string]any)
+		processorPath := joinPath(path, "ItemProcessor")
+
+		if !ok {
+			processor, ok = state["Iterator"].(map[string]any)
+			processorPath = joinPath(path, "Iterator")
+		}
+
+		if !ok {
+			v.errorf(path, "ItemProcessor is required")
+		} else {
+			v.validateDefinition(processorPath, processor, queryLanguage)
+		}
+	case stateTypeParallel:
+		branches, _ := state["Branches"].([]any)
+
+		if len(branches) == 0 {
+			v.errorf(joinPath(path, "Branches"), "must contain at least one branch")
+		}
+
+		for i, branch := range branches {
+			branchPath := joinPath(path, fmt.Sprintf("Branches[%d]", i))
+
+			if branch, ok := branch.(map[string]any); ok {
+				v.validateDefinition(branchPath, branch, queryLanguage)
+			} else {
+				v.errorf(branchPath, "must be an object")
+			}
+		}
+	case stateTypeTask:
+		if _, ok := state["Resource"].(string); !ok {
+			v.errorf(joinPath(path, "Resource"), "is required")
+		}
+	case stateTypeWait:
+		n := 0
+		for _, k := range []string{"Seconds", "SecondsPath", "Timestamp", "TimestampPath"} {
+			if _, ok := state[k]; ok {
+				n++
+			}
+		}
+
+		if n != 1 {
+			v.errorf(path, "exactly one of Seconds, SecondsPath, Timestamp or TimestampPath must be specified")
+		}
+	}
+
+	if catchers, ok := state["Catch"].([]any); ok {
+		for i, catcher := range catchers {
+			catcherPath := joinPath(path, fmt.Sprintf("Catch[%d]", i))
+			catcher, ok := catcher.(map[string]any)
+
+			if !ok {
+				v.errorf(catcherPath, "must be an object")
+				continue
+			}
+
+			if errorEquals, _ := catcher["ErrorEquals"].([]any); len(errorEquals) == 0 {
+				v.errorf(joinPath(catcherPath, "ErrorEquals"), "must contain at least one error name")
+			}
+
+			if s, ok := catcher["Next"].(string); ok && s != "" {
+				next = append(next, transition{name: s, path: joinPath(catcherPath, "Next")})
+			} else {
+				v.errorf(joinPath(catcherPath, "Next"), "is required")
+			}
+
+			if s, ok := catcher["ResultPath"].(string); ok {
+				if queryLanguage == queryLanguageJSONata {
+					v.errorf(joinPath(catcherPath, "ResultPath"), "field is not valid in a state using %s", queryLanguageJSONata)
+				} else if err := validateJSONPath(s); err != nil {
+					v.errorf(joinPath(catcherPath, "ResultPath"), "%s", err)
+				}
+			}
+		}
+	}
+
+	if retriers, ok := state["Retry"].([]any); ok {
+		for i, retrier := range retriers {
+			retrierPath := joinPath(path, fmt.Sprintf("Retry[%d]", i))
+
+			if retrier, ok := retrier.(map[string]any); !ok {
+				v.errorf(retrierPath, "must be an object")
+			} else if errorEquals, _ := retrier["ErrorEquals"].([]any); len(errorEquals) == 0 {
+				v.errorf(joinPath(retrierPath, "ErrorEquals"), "must contain at least one error name")
+			}
+		}
+	}
+
+	return next, terminal
+}
+
+// validateChoiceRule validates a choice rule.
+// Top-level rules of a JSONata Choice state consist of a Condition; JSONPath rules compare a Variable or combine other rules.
+func (v *definitionValidator) validateChoiceRule(path string, rule map[string]any, queryLanguage string, topLevel bool) {
+	if queryLanguage == queryLanguageJSONata {
+		if !topLevel {
+			return
+		}
+
+		s, ok := rule["Condition"].(string)
+
+		if !ok || !isJSONataExpression(s) {
+			v.errorf(joinPath(path, "Condition"), "must be a JSONata expression")
+		}
+
+		return
+	}
+
+	if _, ok := rule["Condition"]; ok {
+		v.errorf(joinPath(path, "Condition"), "field is not valid in a state using %s", queryLanguageJSONPath)
+		return
+	}
+
+	switch {
+	case rule["And"] != nil || rule["Or"] != nil:
+		k := "And"
+		if rule[k] == nil {
+			k = "Or"
+		}
+
+		rules, _ := rule[k].([]any)
+
+		if len(rules) == 0 {
+			v.errorf(joinPath(path, k), "must contain at least one choice rule")
+		}
+
+		for i, r := range rules {
+			rulePath := joinPath(path, fmt.Sprintf("%s[%d]", k, i))
+
+			if r, ok := r.(map[string]any); ok {
+				v.validateChoiceRule(rulePath, r, queryLanguage, false)
+			} else {
+				v.errorf(rulePath, "must be an object")
+			}
+		}
+	case rule["Not"] != nil:
+		if r, ok := rule["Not"].(map[string]any); ok {
+			v.validateChoiceRule(joinPath(path, "Not"), r, queryLanguage, false)
+		} else {
+			v.errorf(joinPath(path, "Not"), "must be an object")
+		}
+	default:
+		s, ok := rule["Variable"].(string)
+
+		if !ok {
+			v.errorf(joinPath(path, "Variable"), "is required")
+			return
+		}
+
+		if err := validateJSONPath(s); err != nil {
+			v.errorf(joinPath(path, "Variable"), "%s", err)
+		}
+
+		for k, val := range rule {
+			if strings.HasSuffix(k, "Path") && k != "Variable" {
+				if s, ok := val.(string); ok {
+					if err := validateJSONPath(s); err != nil {
+						v.errorf(joinPath(path, k), "%s", err)
+					}
+				}
+			}
+		}
+	}
+}
+
+// validatePayloadTemplate validates that the values of fields whose name ends in ".$" are paths or intrinsic functions.
+func (v *definitionValidator) validatePayloadTemplate(path string, template any) {
+	switch template := template.(type) {
+	case map[string]any:
+		for k, val := range template {
+			if !strings.HasSuffix(k, ".$") {
+				v.validatePayloadTemplate(joinPath(path, k), val)
+				continue
+			}
+
+			s, ok := val.(string)
+
+			if !ok {
+				v.errorf(joinPath(path, k), "must be a path or intrinsic function")
+				continue
+			}
+
+			var err error
+			if strings.HasPrefix(s, "States.") {
+				err = validateIntrinsicFunction(s)
+			} else {
+				err = validateJSONPath(s)
+			}
+
+			if err != nil {
+				v.errorf(joinPath(path, k), "%s", err)
+			}
+		}
+	case []any:
+		for i, val := range template {
+			v.validatePayloadTemplate(fmt.Sprintf("%s[%d]", path, i), val)
+		}
+	}
+}
+
+// validateJSONataExpressions validates the syntax of any JSONata expressions in the specified value.
+func (v *definitionValidator) validateJSONataExpressions(path string, val any) {
+	switch val := val.(type) {
+	case string:
+		if strings.HasPrefix(val, "{%") || strings.HasSuffix(val, "%}") {
+			if err := validateJSONataExpression(val); err != nil {
+				v.errorf(path, "%s", err)
+			}
+		}
+	case map[string]any:
+		// Nested definitions are validated separately.
+		if _, ok := val["States"]; ok {
+			return
+		}
+
+		for k, val := range val {
+			v.validateJSONataExpressions(joinPath(path, k), val)
+		}
+	case []any:
+		for i, val := range val {
+			v.validateJSONataExpressions(fmt.Sprintf("%s[%d]", path, i), val)
+		}
+	}
+}
+
+func isJSONataExpression(s string) bool {
+	return validateJSONataExpression(s) == nil
+}
+
+// validateJSONataExpression validates that the specified string is a "{% ... %}" JSONata expression with
+// balanced brackets and terminated string literals.
+func validateJSONataExpression(s string) error {
+	if !strings.HasPrefix(s, "{%") || !strings.HasSuffix(s, "%}") || len(s) < 4 {
+		return fmt.Errorf("JSONata expression (%s) must be enclosed in {%% and %%}", s)
+	}
+
+	expr := strings.TrimSpace(s[2 : len(s)-2])
+
+	if expr == "" {
+		return fmt.Errorf("JSONata expression (%s) is empty", s)
+	}
+
+	if err := checkBalanced(expr, "\"'`"); err != nil {
+		return fmt.Errorf("JSONata expression (%s): %w", s, err)
+	}
+
+	return nil
+}
+
+// validateJSONPath validates that the specified string is a JSONPath, context object path or variable reference.
+func validateJSONPath(s string) error {
+	if !strings.HasPrefix(s, "$") {
+		return fmt.Errorf("path (%s) must begin with $", s)
+	}
+
+	i := 1
+	switch {
+	case strings.HasPrefix(s, "$$"):
+		i = 2
+	case len(s) > 1:
+		// Variable reference, e.g. $myVariable.field.
+		i += len(jsonPathVariableRegexp.FindString(s[1:]))
+	}
+
+	for i < len(s) {
+		switch s[i] {
+		case '.':
+			i++
+			if i < len(s) && s[i] == '.' {
+				i++
+			}
+
+			if i >= len(s) {
+				return fmt.Errorf("path (%s) must not end with .", s)
+			}
+
+			switch s[i] {
+			case '*':
+				i++
+			case '[':
+			default:
+				j := i
+				for i < len(s) && s[i] != '.' && s[i] != '[' {
+					i++
+				}
+
+				if i == j {
+					return fmt.Errorf("path (%s) contains an empty field name", s)
+				}
+			}
+		case '[':
+			j, err := matchingBracket(s, i)
+
+			if err != nil {
+				return fmt.Errorf("path (%s): %w", s, err)
+			}
+
+			if err := validateJSONPathSubscript(s[i+1 : j]); err != nil {
+				return fmt.Errorf("path (%s): %w", s, err)
+			}
+
+			i = j + 1
+		default:
+			return fmt.Errorf("path (%s) contains unexpected character %q at offset %d", s, s[i], i)
+		}
+	}
+
+	return nil
+}
+
+func validateJSONPathSubscript(s string) error {
+	s = strings.TrimSpace(s)
+
+	switch {
+	case s == "":
+		return errors.New("empty subscript")
+	case s == "*":
+	case jsonPathSliceRegexp.MatchString(s):
+	case jsonPathUnionRegexp.MatchString(s):
+	case strings.HasPrefix(s, "?(") && strings.HasSuffix(s, ")"), strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")"):
+		return checkBalanced(s, "'\"")
+	default:
+		return fmt.Errorf("invalid subscript [%s]", s)
+	}
+
+	return nil
+}
+
+// validateIntrinsicFunction validates a call to an intrinsic function, e.g. States.Format('{}', $.name).
+func validateIntrinsicFunction(s string) error {
+	m := intrinsicFunctionRegexp.FindStringSubmatch(s)
+
+	if m == nil {
+		return fmt.Errorf("intrinsic function (%s) is malformed", s)
+	}
+
+	if !slices.Contains(intrinsicFunctions, m[1]) {
+		return fmt.Errorf("intrinsic function (%s) is not one of %s", m[1], strings.Join(intrinsicFunctions, ", "))
+	}
+
+	if err := checkBalanced(m[2], "'"); err != nil {
+		return fmt.Errorf("intrinsic function (%s): %w", s, err)
+	}
+
+	return nil
+}
+
+// matchingBracket returns the index of the ']' closing the '[' at index i.
+func matchingBracket(s string, i int) (int, error) {
+	depth := 0
+	var quote byte
+
+	for j := i; j < len(s); j++ {
+		c := s[j]
+
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				j++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth == 0 {
+				return j, nil
+			}
+		}
+	}
+
+	return 0, errors.New("unterminated [")
+}
+
+var closingBrackets = map[rune]rune{
+	'(': ')',
+	'[': ']',
+	'{': '}',
+}
+
+// checkBalanced checks that the brackets in s are balanced and that string literals, delimited by any of quotes, are terminated.
+func checkBalanced(s, quotes string) error {
+	var stack []rune
+	var quote rune
+	escaped := false
+
+	for _, c := range s {
+		if quote != 0 {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == quote:
+				quote = 0
+			}
+
+			continue
+		}
+
+		switch {
+		case strings.ContainsRune(quotes, c):
+			quote = c
+		case closingBrackets[c] != 0:
+			stack = append(stack, closingBrackets[c])
+		case c == ')' || c == ']' || c == '}':
+			if len(stack) == 0 || stack[len(stack)-1] != c {
+				return fmt.Errorf("unexpected %q", c)
+			}
+
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if quote != 0 {
+		return fmt.Errorf("unterminated string literal")
+	}
+
+	if len(stack) > 0 {
+		return fmt.Errorf("missing %q", stack[len(stack)-1])
+	}
+
+	return nil
+}
+
+func joinPath(path string, elems ...string) string {
+	var sb strings.Builder
+
+	sb.WriteString(path)
+
+	for _, elem := range elems {
+		if sb.Len() > 0 && !strings.HasPrefix(elem, "[") {
+			sb.WriteByte('.')
+		}
+
+		sb.WriteString(elem)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,623 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_sfn_state_machine_definition_document", name="State Machine Definition Document")
+func newStateMachineDefinitionDocumentDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &stateMachineDefinitionDocumentDataSource{}, nil
+}
+
+type stateMachineDefinitionDocumentDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *stateMachineDefinitionDocumentDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	jsonAttribute := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				fwvalidators.JSON(),
+			},
+		}
+	}
+	errorEqualsAttribute := schema.ListAttribute{
+		CustomType:  fwtypes.ListOfStringType,
+		ElementType: types.StringType,
+		Required:    true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrJSON: schema.StringAttribute{
+				Computed: true,
+			},
+			"query_language": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(queryLanguage_Values()...),
+				},
+			},
+			"start_at": schema.StringAttribute{
+				Required: true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("1.0"),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrState: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[stateMachineDefinitionDocumentState](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"arguments": jsonAttribute(),
+						"assign":    jsonAttribute(),
+						"branch": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(fwvalidators.JSON()),
+							},
+						},
+						"cause": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrComment: schema.StringAttribute{
+							Optional: true,
+						},
+						"default": schema.StringAttribute{
+							Optional: true,
+						},
+						"end": schema.BoolAttribute{
+							Optional: true,
+						},
+						"error": schema.StringAttribute{
+							Optional: true,
+						},
+						"heartbeat_seconds": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"input_path": schema.StringAttribute{
+							Optional: true,
+						},
+						"item_processor": jsonAttribute(),
+						"item_processor_execution_type": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("EXPRESS", "STANDARD"),
+							},
+						},
+						"item_processor_mode": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("DISTRIBUTED", "INLINE"),
+							},
+						},
+						"item_selector": jsonAttribute(),
+						"items":         jsonAttribute(),
+						"items_path": schema.StringAttribute{
+							Optional: true,
+						},
+						"max_concurrency": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 80),
+							},
+						},
+						"next": schema.StringAttribute{
+							Optional: true,
+						},
+						"output": jsonAttribute(),
+						"output_path": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrParameters: jsonAttribute(),
+						"query_language": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(queryLanguage_Values()...),
+							},
+						},
+						"resource": schema.StringAttribute{
+							Optional: true,
+						},
+						"result": jsonAttribute(),
+						"result_path": schema.StringAttribute{
+							Optional: true,
+						},
+						"result_selector": jsonAttribute(),
+						"seconds": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"seconds_path": schema.StringAttribute{
+							Optional: true,
+						},
+						"timeout_seconds": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"timestamp": schema.StringAttribute{
+							Optional: true,
+						},
+						"timestamp_path": schema.StringAttribute{
+							Optional: true,
+						},
+						"tolerated_failure_count": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"tolerated_failure_percentage": schema.Float64Attribute{
+							Optional: true,
+							Validators: []validator.Float64{
+								float64validator.Between(0, 100),
+							},
+						},
+						names.AttrType: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(stateType_Values()...),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"catch": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[stateMachineDefinitionDocumentCatcher](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"assign":       jsonAttribute(),
+									"error_equals": errorEqualsAttribute,
+									"next": schema.StringAttribute{
+										Required: true,
+									},
+									"output": jsonAttribute(),
+									"result_path": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"choice": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[stateMachineDefinitionDocumentChoice](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"assign": jsonAttribute(),
+									names.AttrComment: schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrCondition: schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName(names.AttrRule)),
+										},
+									},
+									"next": schema.StringAttribute{
+										Required: true,
+									},
+									"output":       jsonAttribute(),
+									names.AttrRule: jsonAttribute(),
+								},
+							},
+						},
+						"retry": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[stateMachineDefinitionDocumentRetrier](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"backoff_rate": schema.Float64Attribute{
+										Optional: true,
+										Validators: []validator.Float64{
+											float64validator.AtLeast(1),
+										},
+									},
+									"error_equals": errorEqualsAttribute,
+									"interval_seconds": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+									"jitter_strategy": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("FULL", "NONE"),
+										},
+									},
+									"max_attempts": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+									},
+									"max_delay_seconds": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *stateMachineDefinitionDocumentDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data stateMachineDefinitionDocumentDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &stateMachineDefinition{
+		Comment:        fwflex.StringValueFromFramework(ctx, data.Comment),
+		QueryLanguage:  fwflex.StringValueFromFramework(ctx, data.QueryLanguage),
+		StartAt:        fwflex.StringValueFromFramework(ctx, data.StartAt),
+		States:         make(map[string]map[string]any),
+		TimeoutSeconds: fwflex.Int64FromFramework(ctx, data.TimeoutSeconds),
+		Version:        fwflex.StringValueFromFramework(ctx, data.Version),
+	}
+
+	states, diags := data.States.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for i, v := range states {
+		name := v.Name.ValueString()
+
+		if _, ok := input.States[name]; ok {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrState).AtListIndex(i).AtName(names.AttrName), "Duplicate state name", fmt.Sprintf("State %q is defined more than once.", name))
+			return
+		}
+
+		state, diags := v.expand(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.States[name] = state
+	}
+
+	bytes, err := json.MarshalIndent(input, "", "  ")
+
+	if err != nil {
+		response.Diagnostics.AddError("Marshalling state machine definition to JSON", err.Error())
+		return
+	}
+
+	if err := validateStateMachineDefinition(string(bytes)); err != nil {
+		response.Diagnostics.AddError("Invalid Step Functions State Machine definition", err.Error())
+		return
+	}
+
+	data.JSON = types.StringValue(string(bytes))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// stateMachineDefinition is an Amazon States Language document.
+type stateMachineDefinition struct {
+	Comment        string                    `json:",omitempty"`
+	QueryLanguage  string                    `json:",omitempty"`
+	StartAt        string                    `json:",omitempty"`
+	States         map[string]map[string]any `json:",omitempty"`
+	TimeoutSeconds *int64                    `json:",omitempty"`
+	Version        string                    `json:",omitempty"`
+}
+
+type stateMachineDefinitionDocumentDataSourceModel struct {
+	Comment        types.String                                                         `tfsdk:"comment"`
+	JSON           types.String                                                         `tfsdk:"json"`
+	QueryLanguage  types.String                                                         `tfsdk:"query_language"`
+	StartAt        types.String                                                         `tfsdk:"start_at"`
+	States         fwtypes.ListNestedObjectValueOf[stateMachineDefinitionDocumentState] `tfsdk:"state"`
+	TimeoutSeconds types.Int64                                                          `tfsdk:"timeout_seconds"`
+	Version        types.String                                                         `tfsdk:"version"`
+}
+
+type stateMachineDefinitionDocumentState struct {
+	Arguments                  types.String                                                           `tfsdk:"arguments"`
+	Assign                     types.String                                                           `tfsdk:"assign"`
+	Branches                   fwtypes.ListValueOf[types.String]                                      `tfsdk:"branch"`
+	Catch                      fwtypes.ListNestedObjectValueOf[stateMachineDefinitionDocumentCatcher] `tfsdk:"catch"`
+	Cause                      types.String                                                           `tfsdk:"cause"`
+	Choices                    fwtypes.ListNestedObjectValueOf[stateMachineDefinitionDocumentChoice]  `tfsdk:"choice"`
+	Comment                    types.String                                                           `tfsdk:"comment"`
+	Default                    types.String                                                           `tfsdk:"default"`
+	End                        types.Bool                                                             `tfsdk:"end"`
+	Error                      types.String                                                           `tfsdk:"error"`
+	HeartbeatSeconds           types.Int64                                                            `tfsdk:"heartbeat_seconds"`
+	InputPath                  types.String                                                           `tfsdk:"input_path"`
+	ItemProcessor              types.String                                                           `tfsdk:"item_processor"`
+	ItemProcessorExecutionType types.String                                                           `tfsdk:"item_processor_execution_type"`
+	ItemProcessorMode          types.String                                                           `tfsdk:"item_processor_mode"`
+	ItemSelector               types.String                                                           `tfsdk:"item_selector"`
+	Items                      types.String                                                           `tfsdk:"items"`
+	ItemsPath                  types.String                                                           `tfsdk:"items_path"`
+	MaxConcurrency             types.Int64                                                            `tfsdk:"max_concurrency"`
+	Name                       types.String                                                           `tfsdk:"name"`
+	Next                       types.String                                                           `tfsdk:"next"`
+	Output                     types.String                                                           `tfsdk:"output"`
+	OutputPath                 types.String                                                           `tfsdk:"output_path"`
+	Parameters                 types.String                                                           `tfsdk:"parameters"`
+	QueryLanguage              types.String                                                           `tfsdk:"query_language"`
+	Resource                   types.String                                                           `tfsdk:"resource"`
+	Result                     types.String                                                           `tfsdk:"result"`
+	ResultPath                 types.String                                                           `tfsdk:"result_path"`
+	ResultSelector             types.String                                                           `tfsdk:"result_selector"`
+	Retry                      fwtypes.ListNestedObjectValueOf[stateMachineDefinitionDocumentRetrier] `tfsdk:"retry"`
+	Seconds                    types.Int64                                                            `tfsdk:"seconds"`
+	SecondsPath                types.String                                                           `tfsdk:"seconds_path"`
+	TimeoutSeconds             types.Int64                                                            `tfsdk:"timeout_seconds"`
+	Timestamp                  types.String                                                           `tfsdk:"timestamp"`
+	TimestampPath              types.String                                                           `tfsdk:"timestamp_path"`
+	ToleratedFailureCount      types.Int64                                                            `tfsdk:"tolerated_failure_count"`
+	ToleratedFailurePercentage types.Float64                                                          `tfsdk:"tolerated_failure_percentage"`
+	Type                       types.String                                                           `tfsdk:"type"`
+}
+
+func (m *stateMachineDefinitionDocumentState) expand(ctx context.Context) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	state := map[string]any{
+		"Type": m.Type.ValueString(),
+	}
+
+	setDefinitionString(state, "Cause", m.Cause)
+	setDefinitionString(state, "Comment", m.Comment)
+	setDefinitionString(state, "Default", m.Default)
+	setDefinitionString(state, "Error", m.Error)
+	setDefinitionString(state, "InputPath", m.InputPath)
+	setDefinitionString(state, "ItemsPath", m.ItemsPath)
+	setDefinitionString(state, "Next", m.Next)
+	setDefinitionString(state, "OutputPath", m.OutputPath)
+	setDefinitionString(state, "QueryLanguage", m.QueryLanguage)
+	setDefinitionString(state, "Resource", m.Resource)
+	setDefinitionString(state, "ResultPath", m.ResultPath)
+	setDefinitionString(state, "SecondsPath", m.SecondsPath)
+	setDefinitionString(state, "Timestamp", m.Timestamp)
+	setDefinitionString(state, "TimestampPath", m.TimestampPath)
+
+	setDefinitionJSON(state, "Arguments", m.Arguments)
+	setDefinitionJSON(state, "Assign", m.Assign)
+	setDefinitionJSON(state, "ItemSelector", m.ItemSelector)
+	setDefinitionJSON(state, "Items", m.Items)
+	setDefinitionJSON(state, "Output", m.Output)
+	setDefinitionJSON(state, "Parameters", m.Parameters)
+	setDefinitionJSON(state, "Result", m.Result)
+	setDefinitionJSON(state, "ResultSelector", m.ResultSelector)
+
+	setDefinitionInt64(state, "HeartbeatSeconds", m.HeartbeatSeconds)
+	setDefinitionInt64(state, "MaxConcurrency", m.MaxConcurrency)
+	setDefinitionInt64(state, "Seconds", m.Seconds)
+	setDefinitionInt64(state, "TimeoutSeconds", m.TimeoutSeconds)
+	setDefinitionInt64(state, "ToleratedFailureCount", m.ToleratedFailureCount)
+
+	if v := m.End; !v.IsNull() && v.ValueBool() {
+		state["End"] = true
+	}
+
+	if v := m.ToleratedFailurePercentage; !v.IsNull() {
+		state["ToleratedFailurePercentage"] = v.ValueFloat64()
+	}
+
+	if !m.Branches.IsNull() {
+		var branches []json.RawMessage
+
+		for _, v := range fwflex.ExpandFrameworkStringValueList(ctx, m.Branches) {
+			branches = append(branches, json.RawMessage(v))
+		}
+
+		state["Branches"] = branches
+	}
+
+	if v := m.ItemProcessor; !v.IsNull() {
+		var processor map[string]any
+
+		if err := json.Unmarshal([]byte(v.ValueString()), &processor); err != nil {
+			diags.AddError("Decoding item_processor", err.Error())
+			return nil, diags
+		}
+
+		if !m.ItemProcessorMode.IsNull() || !m.ItemProcessorExecutionType.IsNull() {
+			config := map[string]any{}
+			setDefinitionString(config, "ExecutionType", m.ItemProcessorExecutionType)
+			setDefinitionString(config, "Mode", m.ItemProcessorMode)
+			processor["ProcessorConfig"] = config
+		}
+
+		state["ItemProcessor"] = processor
+	}
+
+	if !m.Catch.IsNull() {
+		catchers, d := m.Catch.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObjects []map[string]any
+
+		for _, v := range catchers {
+			apiObject := map[string]any{
+				"ErrorEquals": fwflex.ExpandFrameworkStringValueList(ctx, v.ErrorEquals),
+				"Next":        v.Next.ValueString(),
+			}
+
+			setDefinitionJSON(apiObject, "Assign", v.Assign)
+			setDefinitionJSON(apiObject, "Output", v.Output)
+			setDefinitionString(apiObject, "ResultPath", v.ResultPath)
+
+			apiObjects = append(apiObjects, apiObject)
+		}
+
+		state["Catch"] = apiObjects
+	}
+
+	if !m.Choices.IsNull() {
+		choices, d := m.Choices.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObjects []map[string]any
+
+		for _, v := range choices {
+			apiObject := map[string]any{}
+
+			// The comparison of a JSONPath choice rule is specified as a JSON object whose fields are merged into the rule.
+			if v := v.Rule; !v.IsNull() {
+				if err := json.Unmarshal([]byte(v.ValueString()), &apiObject); err != nil {
+					diags.AddError("Decoding choice rule", err.Error())
+					return nil, diags
+				}
+			}
+
+			setDefinitionJSON(apiObject, "Assign", v.Assign)
+			setDefinitionString(apiObject, "Comment", v.Comment)
+			setDefinitionString(apiObject, "Condition", v.Condition)
+			setDefinitionString(apiObject, "Next", v.Next)
+			setDefinitionJSON(apiObject, "Output", v.Output)
+
+			apiObjects = append(apiObjects, apiObject)
+		}
+
+		state["Choices"] = apiObjects
+	}
+
+	if !m.Retry.IsNull() {
+		retriers, d := m.Retry.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObjects []map[string]any
+
+		for _, v := range retriers {
+			apiObject := map[string]any{
+				"ErrorEquals": fwflex.ExpandFrameworkStringValueList(ctx, v.ErrorEquals),
+			}
+
+			if v := v.BackoffRate; !v.IsNull() {
+				apiObject["BackoffRate"] = v.ValueFloat64()
+			}
+			setDefinitionInt64(apiObject, "IntervalSeconds", v.IntervalSeconds)
+			setDefinitionString(apiObject, "JitterStrategy", v.JitterStrategy)
+			setDefinitionInt64(apiObject, "MaxAttempts", v.MaxAttempts)
+			setDefinitionInt64(apiObject, "MaxDelaySeconds", v.MaxDelaySeconds)
+
+			apiObjects = append(apiObjects, apiObject)
+		}
+
+		state["Retry"] = apiObjects
+	}
+
+	return state, diags
+}
+
+type stateMachineDefinitionDocumentCatcher struct {
+	Assign      types.String                      `tfsdk:"assign"`
+	ErrorEquals fwtypes.ListValueOf[types.String] `tfsdk:"error_equals"`
+	Next        types.String                      `tfsdk:"next"`
+	Output      types.String                      `tfsdk:"output"`
+	ResultPath  types.String                      `tfsdk:"result_path"`
+}
+
+type stateMachineDefinitionDocumentChoice struct {
+	Assign    types.String `tfsdk:"assign"`
+	Comment   types.String `tfsdk:"comment"`
+	Condition types.String `tfsdk:"condition"`
+	Next      types.String `tfsdk:"next"`
+	Output    types.String `tfsdk:"output"`
+	Rule      types.String `tfsdk:"rule"`
+}
+
+type stateMachineDefinitionDocumentRetrier struct {
+	BackoffRate     types.Float64                     `tfsdk:"backoff_rate"`
+	ErrorEquals     fwtypes.ListValueOf[types.String] `tfsdk:"error_equals"`
+	IntervalSeconds types.Int64                       `tfsdk:"interval_seconds"`
+	JitterStrategy  types.String                      `tfsdk:"jitter_strategy"`
+	MaxAttempts     types.Int64                       `tfsdk:"max_attempts"`
+	MaxDelaySeconds types.Int64                       `tfsdk:"max_delay_seconds"`
+}
+
+func setDefinitionString(apiObject map[string]any, k string, v types.String) {
+	if !v.IsNull() {
+		apiObject[k] = v.ValueString()
+	}
+}
+
+func setDefinitionInt64(apiObject map[string]any, k string, v types.Int64) {
+	if !v.IsNull() {
+		apiObject[k] = v.ValueInt64()
+	}
+}
+
+func setDefinitionJSON(apiObject map[string]any, k string, v types.String) {
+	if !v.IsNull() {
+		apiObject[k] = json.RawMessage(v.ValueString())
+	}
+}
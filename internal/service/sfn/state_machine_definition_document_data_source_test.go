@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccStateMachineDefinitionDocumentExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_jsonata(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDocumentDataSourceConfig_jsonata,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccStateMachineDefinitionDocumentExpectedJSON_jsonata),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineDefinitionDocumentDataSourceConfig_danglingNext,
+				ExpectError: regexache.MustCompile(`States.Start.Next: state "Missing" does not exist`),
+			},
+			{
+				Config:      testAccStateMachineDefinitionDocumentDataSourceConfig_unreachable,
+				ExpectError: regexache.MustCompile(`States.Orphan: state is not reachable from "Start"`),
+			},
+			{
+				Config:      testAccStateMachineDefinitionDocumentDataSourceConfig_duplicateName,
+				ExpectError: regexache.MustCompile(`Duplicate state name`),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_stateMachine(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sfn_state_machine.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDocumentDataSourceConfig_stateMachine(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_basic = `
+data "aws_sfn_state_machine_definition_document" "branch" {
+  start_at = "Log"
+
+  state {
+    name = "Log"
+    type = "Pass"
+    end  = true
+  }
+}
+
+data "aws_sfn_state_machine_definition_document" "test" {
+  comment  = "Example"
+  start_at = "Choose"
+
+  state {
+    name    = "Choose"
+    type    = "Choice"
+    default = "Done"
+
+    choice {
+      rule = jsonencode({ Variable = "$.count", NumericGreaterThan = 10 })
+      next = "Process"
+    }
+  }
+
+  state {
+    name        = "Process"
+    type        = "Task"
+    resource    = "arn:aws:states:::lambda:invoke"
+    parameters  = jsonencode({ "Payload.$" = "$" })
+    result_path = "$.result"
+    next        = "Fan"
+
+    retry {
+      error_equals = ["States.ALL"]
+      max_attempts = 2
+      backoff_rate = 2
+    }
+
+    catch {
+      error_equals = ["States.ALL"]
+      next         = "Failed"
+    }
+  }
+
+  state {
+    name   = "Fan"
+    type   = "Parallel"
+    branch = [data.aws_sfn_state_machine_definition_document.branch.json]
+    next   = "Done"
+  }
+
+  state {
+    name = "Done"
+    type = "Succeed"
+  }
+
+  state {
+    name  = "Failed"
+    type  = "Fail"
+    error = "ProcessFailed"
+  }
+}
+`
+
+const testAccStateMachineDefinitionDocumentExpectedJSON_basic = `{
+  "Comment": "Example",
+  "StartAt": "Choose",
+  "States": {
+    "Choose": {
+      "Type": "Choice",
+      "Choices": [{"Variable": "$.count", "NumericGreaterThan": 10, "Next": "Process"}],
+      "Default": "Done"
+    },
+    "Process": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {"Payload.$": "$"},
+      "ResultPath": "$.result",
+      "Retry": [{"ErrorEquals": ["States.ALL"], "MaxAttempts": 2, "BackoffRate": 2}],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Failed"}],
+      "Next": "Fan"
+    },
+    "Fan": {
+      "Type": "Parallel",
+      "Branches": [{"StartAt": "Log", "States": {"Log": {"Type": "Pass", "End": true}}}],
+      "Next": "Done"
+    },
+    "Done": {"Type": "Succeed"},
+    "Failed": {"Type": "Fail", "Error": "ProcessFailed"}
+  }
+}`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_jsonata = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  query_language = "JSONata"
+  start_at       = "Check"
+
+  state {
+    name    = "Check"
+    type    = "Choice"
+    default = "Done"
+
+    choice {
+      condition = "{% $states.input.count > 10 %}"
+      next      = "Call"
+    }
+  }
+
+  state {
+    name      = "Call"
+    type      = "Task"
+    resource  = "arn:aws:states:::lambda:invoke"
+    arguments = jsonencode({ FunctionName = "example", Payload = "{% $states.input %}" })
+    output    = jsonencode("{% $states.result.Payload %}")
+    next      = "Done"
+  }
+
+  state {
+    name = "Done"
+    type = "Succeed"
+  }
+}
+`
+
+const testAccStateMachineDefinitionDocumentExpectedJSON_jsonata = `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "Check",
+  "States": {
+    "Check": {
+      "Type": "Choice",
+      "Choices": [{"Condition": "{% $states.input.count > 10 %}", "Next": "Call"}],
+      "Default": "Done"
+    },
+    "Call": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Arguments": {"FunctionName": "example", "Payload": "{% $states.input %}"},
+      "Output": "{% $states.result.Payload %}",
+      "Next": "Done"
+    },
+    "Done": {"Type": "Succeed"}
+  }
+}`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_danglingNext = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  start_at = "Start"
+
+  state {
+    name = "Start"
+    type = "Pass"
+    next = "Missing"
+  }
+}
+`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_unreachable = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  start_at = "Start"
+
+  state {
+    name = "Start"
+    type = "Succeed"
+  }
+
+  state {
+    name = "Orphan"
+    type = "Succeed"
+  }
+}
+`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_duplicateName = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  start_at = "Start"
+
+  state {
+    name = "Start"
+    type = "Succeed"
+  }
+
+  state {
+    name = "Start"
+    type = "Succeed"
+  }
+}
+`
+
+func testAccStateMachineDefinitionDocumentDataSourceConfig_stateMachine(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Action    = "sts:AssumeRole"
+      Principal = { Service = "states.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+data "aws_sfn_state_machine_definition_document" "test" {
+  start_at = "Wait"
+
+  state {
+    name    = "Wait"
+    type    = "Wait"
+    seconds = 1
+    next    = "Done"
+  }
+
+  state {
+    name = "Done"
+    type = "Succeed"
+  }
+}
+
+resource "aws_sfn_state_machine" "test" {
+  name       = %[1]q
+  role_arn   = aws_iam_role.test.arn
+  definition = data.aws_sfn_state_machine_definition_document.test.json
+}
+`, rName)
+}
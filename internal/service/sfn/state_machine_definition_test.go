@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"strings"
+	"testing"
+
+	tfsfn "github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
+)
+
+func TestValidateStateMachineDefinition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition string
+		wantErrs   []string
+	}{
+		"valid": {
+			definition: `{
+  "StartAt": "Choose",
+  "States": {
+    "Choose": {
+      "Type": "Choice",
+      "Choices": [
+        {"Variable": "$.count", "NumericGreaterThan": 10, "Next": "Many"},
+        {"And": [{"Variable": "$.items[0].id", "IsPresent": true}, {"Variable": "$$.Execution.Id", "IsString": true}], "Next": "Process"}
+      ],
+      "Default": "Done"
+    },
+    "Many": {
+      "Type": "Wait",
+      "SecondsPath": "$.delay",
+      "Next": "Process"
+    },
+    "Process": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {"Payload.$": "$", "Message.$": "States.Format('Hello {}', $.name)"},
+      "ResultPath": "$.result",
+      "Retry": [{"ErrorEquals": ["States.ALL"], "MaxAttempts": 2}],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Failed", "ResultPath": "$.error"}],
+      "Next": "Fan"
+    },
+    "Fan": {
+      "Type": "Parallel",
+      "Branches": [{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}],
+      "Next": "Each"
+    },
+    "Each": {
+      "Type": "Map",
+      "ItemsPath": "$.items[*]",
+      "ItemProcessor": {"ProcessorConfig": {"Mode": "INLINE"}, "StartAt": "B", "States": {"B": {"Type": "Succeed"}}},
+      "End": true
+    },
+    "Done": {"Type": "Succeed"},
+    "Failed": {"Type": "Fail", "Error": "Failed"}
+  }
+}`,
+		},
+		"valid JSONata": {
+			definition: `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "Check",
+  "States": {
+    "Check": {
+      "Type": "Choice",
+      "Choices": [{"Condition": "{% $states.input.count > 10 and ($states.input.name = 'x') %}", "Next": "Call"}],
+      "Default": "Done"
+    },
+    "Call": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Arguments": {"FunctionName": "f", "Payload": "{% $states.input %}"},
+      "Output": "{% $states.result.Payload %}",
+      "Next": "Done"
+    },
+    "Done": {"Type": "Succeed"}
+  }
+}`,
+		},
+		"invalid JSON": {
+			definition: `{`,
+			wantErrs:   []string{"decoding definition"},
+		},
+		"missing StartAt state": {
+			definition: `{"StartAt": "Missing", "States": {"A": {"Type": "Succeed"}}}`,
+			wantErrs: []string{
+				`StartAt: state "Missing" does not exist`,
+			},
+		},
+		"dangling Next": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}, "C": {"Type": "Succeed"}}}`,
+			wantErrs: []string{
+				`States.A.Next: state "B" does not exist`,
+				`States.C: state is not reachable from "A"`,
+			},
+		},
+		"no terminal state": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}, "B": {"Type": "Pass", "Next": "A"}}}`,
+			wantErrs: []string{
+				"no terminal state",
+			},
+		},
+		"missing Next and End": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn:aws:states:::lambda:invoke"}, "B": {"Type": "Pass", "Next": "A", "End": true}}}`,
+			wantErrs: []string{
+				"States.A: one of Next or End must be specified",
+				"States.B: only one of Next or End can be specified",
+			},
+		},
+		"invalid fields": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Succeed", "Resource": "x", "Next": "A"}}}`,
+			wantErrs: []string{
+				"States.A.Next: field is not valid for a Succeed state",
+				"States.A.Resource: field is not valid for a Succeed state",
+			},
+		},
+		"invalid JSONPath": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "InputPath": "input", "ResultPath": "$.a..", "Parameters": {"b.$": "$.c[", "d.$": "States.Nope()"}, "End": true}}}`,
+			wantErrs: []string{
+				"States.A.InputPath: path (input) must begin with $",
+				"States.A.ResultPath: path ($.a..) must not end with .",
+				"States.A.Parameters.b.$: path ($.c[): unterminated [",
+				"States.A.Parameters.d.$: intrinsic function (States.Nope) is not one of",
+			},
+		},
+		"invalid JSONata": {
+			definition: `{"QueryLanguage": "JSONata", "StartAt": "A", "States": {"A": {"Type": "Pass", "InputPath": "$", "Output": "{% $states.input.( %}", "End": true}}}`,
+			wantErrs: []string{
+				"States.A.InputPath: field is not valid in a state using JSONata",
+				`States.A.Output: JSONata expression ({% $states.input.( %}): missing ')'`,
+			},
+		},
+		"JSONPath state in JSONata state machine": {
+			definition: `{"QueryLanguage": "JSONata", "StartAt": "A", "States": {"A": {"Type": "Succeed", "QueryLanguage": "JSONPath"}}}`,
+			wantErrs: []string{
+				"States.A.QueryLanguage: cannot be JSONPath when the state machine uses JSONata",
+			},
+		},
+		"invalid Wait": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Wait", "Seconds": 1, "Timestamp": "2024-01-01T00:00:00Z", "End": true}}}`,
+			wantErrs: []string{
+				"States.A: exactly one of Seconds, SecondsPath, Timestamp or TimestampPath must be specified",
+			},
+		},
+		"invalid branch": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Parallel", "Branches": [{"StartAt": "B", "States": {"B": {"Type": "Pass", "Next": "C"}}}], "End": true}}}`,
+			wantErrs: []string{
+				`States.A.Branches[0].States.B.Next: state "C" does not exist`,
+				"States.A.Branches[0]: no terminal state",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfsfn.ValidateStateMachineDefinition(testCase.definition)
+
+			if len(testCase.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			for _, want := range testCase.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
@@ -19,7 +19,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory:  newResourceTopicSubscriptionsExclusive,
+			TypeName: "aws_sns_topic_subscriptions_exclusive",
+			Name:     "Topic Subscriptions Exclusive",
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
@@ -54,6 +60,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			ARNLookup: &types.ServicePackageResourceARNLookup{
+				Service: "sns",
+				Find:    lookupTopicByARN,
+			},
 		},
 		{
 			Factory:  resourceTopicDataProtectionPolicy,
@@ -239,6 +239,7 @@ var (
 
 // @SDKResource("aws_sns_topic", name="Topic")
 // @Tags(identifierAttribute="arn")
+// @ARNLookup(service="sns", finder="lookupTopicByARN")
 // @Testing(existsType="map[string]string")
 func resourceTopic() *schema.Resource {
 	return &schema.Resource{
@@ -504,6 +505,13 @@ func findTopicAttributesWithValidAWSPrincipalsByARN(ctx context.Context, conn *s
 	return attributes, err
 }
 
+// lookupTopicByARN finds the SNS topic with the specified ARN for lookup-by-ARN.
+func lookupTopicByARN(ctx context.Context, meta any, arn string) error {
+	_, err := findTopicAttributesByARN(ctx, meta.(*conns.AWSClient).SNSClient(ctx), arn)
+
+	return err
+}
+
 func findTopicAttributesByARN(ctx context.Context, conn *sns.Client, arn string) (map[string]string, error) {
 	input := &sns.GetTopicAttributesInput{
 		TopicArn: aws.String(arn),
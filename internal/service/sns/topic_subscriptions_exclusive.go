@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_sns_topic_subscriptions_exclusive", name="Topic Subscriptions Exclusive")
+func newResourceTopicSubscriptionsExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceTopicSubscriptionsExclusive{}, nil
+}
+
+const (
+	ResNameTopicSubscriptionsExclusive = "Topic Subscriptions Exclusive"
+
+	// subscriptionARNPendingConfirmation is returned in place of the ARN of an unconfirmed subscription.
+	subscriptionARNPendingConfirmation = "PendingConfirmation"
+)
+
+type resourceTopicSubscriptionsExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+}
+
+func (r *resourceTopicSubscriptionsExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"subscription_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			names.AttrTopicARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceTopicSubscriptionsExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceTopicSubscriptionsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var subscriptionARNs []string
+	resp.Diagnostics.Append(plan.SubscriptionARNs.ElementsAs(ctx, &subscriptionARNs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncSubscriptions(ctx, plan.TopicARN.ValueString(), subscriptionARNs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SNS, create.ErrActionCreating, ResNameTopicSubscriptionsExclusive, plan.TopicARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceTopicSubscriptionsExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().SNSClient(ctx)
+
+	var state resourceTopicSubscriptionsExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findSubscriptionARNsByTopicARN(ctx, conn, state.TopicARN.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SNS, create.ErrActionReading, ResNameTopicSubscriptionsExclusive, state.TopicARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.SubscriptionARNs = flex.FlattenFrameworkStringValueSetLegacy(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceTopicSubscriptionsExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceTopicSubscriptionsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.SubscriptionARNs.Equal(state.SubscriptionARNs) {
+		var subscriptionARNs []string
+		resp.Diagnostics.Append(plan.SubscriptionARNs.ElementsAs(ctx, &subscriptionARNs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncSubscriptions(ctx, plan.TopicARN.ValueString(), subscriptionARNs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SNS, create.ErrActionUpdating, ResNameTopicSubscriptionsExclusive, plan.TopicARN.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncSubscriptions handles keeping the configured subscriptions in sync
+// with the remote topic.
+//
+// Subscriptions are created by the aws_sns_topic_subscription resource, so a
+// configured subscription that does not exist is an error. Confirmed
+// subscriptions to the topic but not configured on this resource will be
+// removed. Subscriptions pending confirmation cannot be removed and are ignored.
+func (r *resourceTopicSubscriptionsExclusive) syncSubscriptions(ctx context.Context, topicARN string, want []string) error {
+	conn := r.Meta().SNSClient(ctx)
+
+	have, err := findSubscriptionARNsByTopicARN(ctx, conn, topicARN)
+	if err != nil {
+		return err
+	}
+
+	return exclusive.Sync(ctx, have, want,
+		exclusive.AddNotSupported("SNS Topic Subscription"),
+		func(ctx context.Context, arn string) error {
+			input := sns.UnsubscribeInput{
+				SubscriptionArn: aws.String(arn),
+			}
+
+			_, err := conn.Unsubscribe(ctx, &input)
+			if errs.IsA[*awstypes.NotFoundException](err) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("deleting SNS Topic Subscription (%s): %w", arn, err)
+			}
+
+			if _, err := waitSubscriptionDeleted(ctx, conn, arn, subscriptionDeleteTimeout); err != nil {
+				return fmt.Errorf("waiting for SNS Topic Subscription (%s) delete: %w", arn, err)
+			}
+
+			return nil
+		},
+	)
+}
+
+func (r *resourceTopicSubscriptionsExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrTopicARN), req, resp)
+}
+
+// findSubscriptionARNsByTopicARN returns the ARNs of the topic's confirmed subscriptions.
+func findSubscriptionARNsByTopicARN(ctx context.Context, conn *sns.Client, topicARN string) ([]string, error) {
+	input := &sns.ListSubscriptionsByTopicInput{
+		TopicArn: aws.String(topicARN),
+	}
+
+	var subscriptionARNs []string
+	paginator := sns.NewListSubscriptionsByTopicPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Subscriptions {
+			if arn := aws.ToString(v.SubscriptionArn); arn != "" && arn != subscriptionARNPendingConfirmation {
+				subscriptionARNs = append(subscriptionARNs, arn)
+			}
+		}
+	}
+
+	return subscriptionARNs, nil
+}
+
+type resourceTopicSubscriptionsExclusiveData struct {
+	SubscriptionARNs types.Set   `tfsdk:"subscription_arns"`
+	TopicARN         fwtypes.ARN `tfsdk:"topic_arn"`
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSNSTopicSubscriptionsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var attributes map[string]string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sns_topic_subscriptions_exclusive.test"
+	subscriptionResourceName := "aws_sns_topic_subscription.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicSubscriptionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTopicSubscriptionExists(ctx, subscriptionResourceName, &attributes),
+					testAccCheckTopicSubscriptionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTopicARN, "aws_sns_topic.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "subscription_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "subscription_arns.*", subscriptionResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrTopicARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrTopicARN,
+			},
+		},
+	})
+}
+
+// A subscription added out of band should be removed.
+func TestAccSNSTopicSubscriptionsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var attributes map[string]string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sns_topic_subscriptions_exclusive.test"
+	subscriptionResourceName := "aws_sns_topic_subscription.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicSubscriptionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicSubscriptionExists(ctx, subscriptionResourceName, &attributes),
+					testAccCheckTopicSubscriptionsExclusiveExists(ctx, resourceName),
+					testAccCheckTopicSubscriptionsExclusiveSubscribe(ctx, resourceName, "aws_sqs_queue.other"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTopicSubscriptionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicSubscriptionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subscription_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTopicSubscriptionsExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return create.Error(names.SNS, create.ErrActionCheckingExistence, tfsns.ResNameTopicSubscriptionsExclusive, n, errors.New("not found"))
+		}
+
+		topicARN := rs.Primary.Attributes[names.AttrTopicARN]
+		if topicARN == "" {
+			return create.Error(names.SNS, create.ErrActionCheckingExistence, tfsns.ResNameTopicSubscriptionsExclusive, n, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSClient(ctx)
+
+		output, err := tfsns.FindSubscriptionARNsByTopicARN(ctx, conn, topicARN)
+		if err != nil {
+			return create.Error(names.SNS, create.ErrActionCheckingExistence, tfsns.ResNameTopicSubscriptionsExclusive, topicARN, err)
+		}
+
+		if got, want := rs.Primary.Attributes["subscription_arns.#"], strconv.Itoa(len(output)); got != want {
+			return create.Error(names.SNS, create.ErrActionCheckingExistence, tfsns.ResNameTopicSubscriptionsExclusive, topicARN, fmt.Errorf("unexpected subscription_arns count: got %s, want %s", got, want))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTopicSubscriptionsExclusiveSubscribe(ctx context.Context, n, queueResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		queue, ok := s.RootModule().Resources[queueResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", queueResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSClient(ctx)
+
+		input := sns.SubscribeInput{
+			Endpoint: aws.String(queue.Primary.Attributes[names.AttrARN]),
+			Protocol: aws.String("sqs"),
+			TopicArn: aws.String(rs.Primary.Attributes[names.AttrTopicARN]),
+		}
+
+		_, err := conn.Subscribe(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccTopicSubscriptionsExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTopicSubscriptionConfig_basic(rName), fmt.Sprintf(`
+resource "aws_sqs_queue" "other" {
+  name = "%[1]s-other"
+
+  sqs_managed_sse_enabled = true
+}
+
+resource "aws_sns_topic_subscriptions_exclusive" "test" {
+  topic_arn         = aws_sns_topic.test.arn
+  subscription_arns = [aws_sns_topic_subscription.test.arn]
+}
+`, rName))
+}
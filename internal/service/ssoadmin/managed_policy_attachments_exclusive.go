@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ssoadmin_managed_policy_attachments_exclusive", name="Managed Policy Attachments Exclusive")
+func newResourceManagedPolicyAttachmentsExclusive(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceManagedPolicyAttachmentsExclusive{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameManagedPolicyAttachmentsExclusive = "Managed Policy Attachments Exclusive"
+
+	managedPolicyAttachmentsExclusiveIDPartCount = 2
+)
+
+type resourceManagedPolicyAttachmentsExclusive struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (r *resourceManagedPolicyAttachmentsExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"instance_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"managed_policy_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"permission_set_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceManagedPolicyAttachmentsExclusive) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan resourceManagedPolicyAttachmentsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var policyARNs []string
+	resp.Diagnostics.Append(plan.ManagedPolicyARNs.ElementsAs(ctx, &policyARNs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncAttachments(ctx, plan.PermissionSetARN.ValueString(), plan.InstanceARN.ValueString(), policyARNs, r.CreateTimeout(ctx, plan.Timeouts))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionCreating, ResNameManagedPolicyAttachmentsExclusive, plan.PermissionSetARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceManagedPolicyAttachmentsExclusive) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	var state resourceManagedPolicyAttachmentsExclusiveData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findManagedPolicyARNsByTwoPartKey(ctx, conn, state.PermissionSetARN.ValueString(), state.InstanceARN.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionReading, ResNameManagedPolicyAttachmentsExclusive, state.PermissionSetARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.ManagedPolicyARNs = flex.FlattenFrameworkStringValueSetLegacy(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceManagedPolicyAttachmentsExclusive) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state resourceManagedPolicyAttachmentsExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ManagedPolicyARNs.Equal(state.ManagedPolicyARNs) {
+		var policyARNs []string
+		resp.Diagnostics.Append(plan.ManagedPolicyARNs.ElementsAs(ctx, &policyARNs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncAttachments(ctx, plan.PermissionSetARN.ValueString(), plan.InstanceARN.ValueString(), policyARNs, r.UpdateTimeout(ctx, plan.Timeouts))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SSOAdmin, create.ErrActionUpdating, ResNameManagedPolicyAttachmentsExclusive, plan.PermissionSetARN.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncAttachments handles keeping the configured AWS managed policy
+// attachments in sync with the remote permission set.
+//
+// Managed policies defined on this resource but not attached to the
+// permission set will be added. Policies attached to the permission set
+// but not configured on this resource will be removed. If anything
+// changed, the permission set is re-provisioned to all accounts.
+func (r *resourceManagedPolicyAttachmentsExclusive) syncAttachments(ctx context.Context, permissionSetARN, instanceARN string, want []string, timeout time.Duration) error {
+	conn := r.Meta().SSOAdminClient(ctx)
+
+	have, err := findManagedPolicyARNsByTwoPartKey(ctx, conn, permissionSetARN, instanceARN)
+	if err != nil {
+		return err
+	}
+
+	var changed bool
+	err = exclusive.Sync(ctx, have, want,
+		func(ctx context.Context, arn string) error {
+			input := ssoadmin.AttachManagedPolicyToPermissionSetInput{
+				InstanceArn:      aws.String(instanceARN),
+				ManagedPolicyArn: aws.String(arn),
+				PermissionSetArn: aws.String(permissionSetARN),
+			}
+
+			if _, err := conn.AttachManagedPolicyToPermissionSet(ctx, &input); err != nil {
+				return fmt.Errorf("attaching Managed Policy (%s): %w", arn, err)
+			}
+			changed = true
+
+			return nil
+		},
+		func(ctx context.Context, arn string) error {
+			input := ssoadmin.DetachManagedPolicyFromPermissionSetInput{
+				InstanceArn:      aws.String(instanceARN),
+				ManagedPolicyArn: aws.String(arn),
+				PermissionSetArn: aws.String(permissionSetARN),
+			}
+
+			if _, err := conn.DetachManagedPolicyFromPermissionSet(ctx, &input); err != nil {
+				return fmt.Errorf("detaching Managed Policy (%s): %w", arn, err)
+			}
+			changed = true
+
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !changed {
+		return nil
+	}
+
+	// Provision ALL accounts after changing the managed policy attachments.
+	return provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, timeout)
+}
+
+func (r *resourceManagedPolicyAttachmentsExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(req.ID, managedPolicyAttachmentsExclusiveIDPartCount, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: PERMISSION_SET_ARN,INSTANCE_ARN. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission_set_arn"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_arn"), parts[1])...)
+}
+
+func findManagedPolicyARNsByTwoPartKey(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN string) ([]string, error) {
+	input := &ssoadmin.ListManagedPoliciesInPermissionSetInput{
+		InstanceArn:      aws.String(instanceARN),
+		PermissionSetArn: aws.String(permissionSetARN),
+	}
+
+	policies, err := findAttachedManagedPolicies(ctx, conn, input, tfslices.PredicateTrue[awstypes.AttachedManagedPolicy]())
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(policies, func(v awstypes.AttachedManagedPolicy) string {
+		return aws.ToString(v.Arn)
+	}), nil
+}
+
+type resourceManagedPolicyAttachmentsExclusiveData struct {
+	InstanceARN       fwtypes.ARN    `tfsdk:"instance_arn"`
+	ManagedPolicyARNs types.Set      `tfsdk:"managed_policy_arns"`
+	PermissionSetARN  fwtypes.ARN    `tfsdk:"permission_set_arn"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
@@ -67,6 +67,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_ssoadmin_application_assignment_configuration",
 			Name:     "Application Assignment Configuration",
 		},
+		{
+			Factory:  newResourceManagedPolicyAttachmentsExclusive,
+			TypeName: "aws_ssoadmin_managed_policy_attachments_exclusive",
+			Name:     "Managed Policy Attachments Exclusive",
+		},
 		{
 			Factory:  newResourceTrustedTokenIssuer,
 			TypeName: "aws_ssoadmin_trusted_token_issuer",
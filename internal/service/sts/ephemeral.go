@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+)
+
+const (
+	// credentialsPrivateDataKey is the private data key under which temporary credentials metadata is stored.
+	credentialsPrivateDataKey = "credentials"
+	// credentialsRenewWindow is how long before expiration Terraform is asked to renew temporary credentials.
+	credentialsRenewWindow = 5 * time.Minute
+)
+
+type credentialsPrivateData struct {
+	Expiration time.Time `json:"expiration"`
+}
+
+type privateDataSetter interface {
+	SetKey(context.Context, string, []byte) diag.Diagnostics
+}
+
+// setCredentialsRenewal records the credentials' expiration in private data and schedules renewal shortly before it.
+func setCredentialsRenewal(ctx context.Context, response *ephemeral.OpenResponse, expiration time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if expiration.IsZero() {
+		return diags
+	}
+
+	diags.Append(setCredentialsPrivateData(ctx, response.Private, expiration)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	response.RenewAt = expiration.Add(-credentialsRenewWindow)
+
+	return diags
+}
+
+// renewCredentials is called by Terraform when temporary credentials are about to expire.
+// STS credentials cannot be extended and an ephemeral resource's result cannot change after Open,
+// so a warning is returned while the credentials are still valid and an error once they have expired.
+func renewCredentials(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	v, diags := request.Private.GetKey(ctx, credentialsPrivateDataKey)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || v == nil {
+		return
+	}
+
+	var data credentialsPrivateData
+	if err := json.Unmarshal(v, &data); err != nil {
+		response.Diagnostics.AddError("reading temporary credentials private data", err.Error())
+		return
+	}
+
+	if now := time.Now(); now.Before(data.Expiration) {
+		response.Diagnostics.AddWarning(
+			"Temporary credentials expiring",
+			fmt.Sprintf("The temporary credentials expire at %s and cannot be renewed. Increase duration_seconds if operations take longer.", data.Expiration.Format(time.RFC3339)),
+		)
+
+		// Check again at expiration.
+		response.RenewAt = data.Expiration
+		response.Diagnostics.Append(setCredentialsPrivateData(ctx, response.Private, data.Expiration)...)
+
+		return
+	}
+
+	response.Diagnostics.AddError(
+		"Temporary credentials expired",
+		fmt.Sprintf("The temporary credentials expired at %s. Increase duration_seconds if operations take longer.", data.Expiration.Format(time.RFC3339)),
+	)
+}
+
+func setCredentialsPrivateData(ctx context.Context, private privateDataSetter, expiration time.Time) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, err := json.Marshal(credentialsPrivateData{Expiration: expiration})
+	if err != nil {
+		diags.AddError("writing temporary credentials private data", err.Error())
+		return diags
+	}
+
+	diags.Append(private.SetKey(ctx, credentialsPrivateDataKey, v)...)
+
+	return diags
+}
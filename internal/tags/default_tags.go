@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"github.com/YakDriver/regexache"
+)
+
+const (
+	// SkipDefaultTagsAttributeName is the name of the resource attribute that, when `true`,
+	// excludes provider-level default tags from the resource.
+	SkipDefaultTagsAttributeName = "skip_default_tags"
+)
+
+// Variables that can be referenced in default tag values, e.g. `${resource_type}`.
+const (
+	DefaultTagsTemplateVariableAccountID    = "account_id"
+	DefaultTagsTemplateVariablePartition    = "partition"
+	DefaultTagsTemplateVariableRegion       = "region"
+	DefaultTagsTemplateVariableResourceType = "resource_type"
+)
+
+var defaultTagsTemplateVariableRegex = regexache.MustCompile(`\$\{([0-9a-z_]+)\}`)
+
+// ForResource returns the default tags configuration for a single resource.
+// If skip is true, nil is returned and no default tags are applied to the resource.
+// Otherwise any template variables in tag values are replaced by the corresponding entries in values.
+// Unknown template variables are left unchanged.
+func (dc *DefaultConfig) ForResource(skip bool, values map[string]string) *DefaultConfig {
+	if dc == nil || skip {
+		return nil
+	}
+
+	if dc.Tags == nil {
+		return dc
+	}
+
+	tags := make(KeyValueTags, len(dc.Tags))
+	for k, v := range dc.Tags {
+		if v == nil || v.Value == nil {
+			tags[k] = v
+			continue
+		}
+
+		value := defaultTagsTemplateVariableRegex.ReplaceAllStringFunc(*v.Value, func(s string) string {
+			name := defaultTagsTemplateVariableRegex.FindStringSubmatch(s)[1]
+			if v, ok := values[name]; ok {
+				return v
+			}
+
+			return s
+		})
+
+		tagData := *v
+		tagData.Value = &value
+		tags[k] = &tagData
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	values := map[string]string{
+		DefaultTagsTemplateVariableAccountID:    "123456789012",
+		DefaultTagsTemplateVariableRegion:       "us-west-2",
+		DefaultTagsTemplateVariableResourceType: "aws_vpc",
+	}
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		skip          bool
+		wantNil       bool
+		want          map[string]string
+	}{
+		{
+			name:    "nil config",
+			wantNil: true,
+		},
+		{
+			name: "skip",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			skip:    true,
+			wantNil: true,
+		},
+		{
+			name:          "empty config",
+			defaultConfig: &DefaultConfig{},
+			want:          map[string]string{},
+		},
+		{
+			name: "static values",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+					"key2": "",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "",
+			},
+		},
+		{
+			name: "templated values",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"ResourceType": "${resource_type}",
+					"Location":     "${account_id}/${region}",
+					"Unknown":      "${partition}-${other}",
+					"Literal":      "$resource_type",
+				}),
+			},
+			want: map[string]string{
+				"ResourceType": "aws_vpc",
+				"Location":     "123456789012/us-west-2",
+				"Unknown":      "${partition}-${other}",
+				"Literal":      "$resource_type",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.skip, values)
+
+			if testCase.wantNil {
+				if got != nil {
+					t.Fatalf("expected nil, got %v", got.Tags)
+				}
+				return
+			}
+
+			testKeyValueTagsVerifyMap(t, got.GetTags().Map(), testCase.want)
+		})
+	}
+}
+
+func TestDefaultConfigForResourceDoesNotModifyReceiver(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"ResourceType": "${resource_type}",
+		}),
+	}
+
+	defaultConfig.ForResource(false, map[string]string{
+		DefaultTagsTemplateVariableResourceType: "aws_vpc",
+	})
+
+	if got, want := defaultConfig.Tags.KeyValue("ResourceType"), "${resource_type}"; got == nil || *got != want {
+		t.Errorf("ResourceType = %v, want %q", got, want)
+	}
+}
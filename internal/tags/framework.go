@@ -35,6 +35,15 @@ func TagsAttributeRequired() schema.Attribute {
 	}
 }
 
+// SkipDefaultTagsAttribute returns the schema to use for a resource's `skip_default_tags` attribute.
+// Resources that include this attribute can opt out of the provider's default_tags.
+func SkipDefaultTagsAttribute() schema.Attribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Description: "Whether to exclude the provider's default_tags from this resource.",
+	}
+}
+
 var (
 	Unknown = types.MapUnknown(types.StringType)
 )
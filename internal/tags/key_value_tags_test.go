@@ -5,8 +5,10 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -564,6 +566,42 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexes",
+			tags: New(ctx, map[string]string{
+				"key1":       "value1",
+				"key2":       "value2",
+				"team:owner": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^key[13]$`),
+					regexache.MustCompile(`:owner$`),
+				},
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys key prefixes and key regexes",
+			tags: New(ctx, map[string]string{
+				"key1":   "value1",
+				"key2":   "value2",
+				"prefix": "value3",
+				"other":  "value4",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New(ctx, []string{"key1"}),
+				KeyPrefixes: New(ctx, []string{"pre"}),
+				KeyRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`2$`),
+				},
+			},
+			want: map[string]string{
+				"other": "value4",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -732,6 +770,89 @@ func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreRegexes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name             string
+		tags             KeyValueTags
+		ignoreTagRegexes []*regexp.Regexp
+		want             map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(ctx, map[string]string{}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexache.MustCompile(`.*`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "no regexes",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "all",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexache.MustCompile(`^key\d$`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(ctx, map[string]string{
+				"key1":          "value1",
+				"Key2":          "value2",
+				"kubernetes.io": "value3",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexache.MustCompile(`(?i)^key2$`),
+				regexache.MustCompile(`\.io$`),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "none",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexache.MustCompile(`^key$`),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.IgnoreRegexes(testCase.ignoreTagRegexes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreSystem(t *testing.T) {
 	t.Parallel()
 
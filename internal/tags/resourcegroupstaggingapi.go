@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+)
+
+// resourceGroupsTaggingAPIClienter is implemented by the provider's meta (*conns.AWSClient).
+type resourceGroupsTaggingAPIClienter interface {
+	ResourceGroupsTaggingAPIClient(context.Context) *resourcegroupstaggingapi.Client
+}
+
+// ResourceGroupsTaggingAPI is a generic ServiceTagLister and ServiceTagUpdater backed by the Resource Groups Tagging API.
+// It can be used for any resource whose identifier is an ARN, regardless of the resource's service API.
+type ResourceGroupsTaggingAPI struct{}
+
+var (
+	_ ServiceTagLister  = ResourceGroupsTaggingAPI{}
+	_ ServiceTagUpdater = ResourceGroupsTaggingAPI{}
+)
+
+// ListTags lists the tags of the resource with the specified ARN and sets them in Context.
+func (ResourceGroupsTaggingAPI) ListTags(ctx context.Context, meta any, identifier string) error {
+	conn, err := resourceGroupsTaggingAPIConn(ctx, meta)
+	if err != nil {
+		return err
+	}
+
+	input := resourcegroupstaggingapi.GetResourcesInput{
+		ResourceARNList: []string{identifier},
+	}
+
+	// A resource that has never been tagged is not returned.
+	// GetResources can return empty pages with a pagination token.
+	tags := New(ctx, nil)
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return fmt.Errorf("listing tags for resource (%s): %w", identifier, err)
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			if aws.ToString(v.ResourceARN) != identifier {
+				continue
+			}
+
+			m := make(map[string]*string, len(v.Tags))
+			for _, tag := range v.Tags {
+				m[aws.ToString(tag.Key)] = tag.Value
+			}
+			tags = New(ctx, m)
+		}
+	}
+
+	if inContext, ok := FromContext(ctx); ok {
+		inContext.TagsOut = option.Some(tags)
+	}
+
+	return nil
+}
+
+// UpdateTags updates the tags of the resource with the specified ARN.
+func (ResourceGroupsTaggingAPI) UpdateTags(ctx context.Context, meta any, identifier string, oldTagsMap, newTagsMap any) error {
+	conn, err := resourceGroupsTaggingAPIConn(ctx, meta)
+	if err != nil {
+		return err
+	}
+
+	oldTags := New(ctx, oldTagsMap)
+	newTags := New(ctx, newTagsMap)
+
+	ctx = tflog.SetField(ctx, logging.KeyResourceId, identifier)
+
+	if removedTags := oldTags.Removed(newTags).IgnoreAWS(); len(removedTags) > 0 {
+		input := resourcegroupstaggingapi.UntagResourcesInput{
+			ResourceARNList: []string{identifier},
+			TagKeys:         removedTags.Keys(),
+		}
+
+		output, err := conn.UntagResources(ctx, &input)
+
+		if err == nil {
+			err = failedResourcesError(output.FailedResourcesMap)
+		}
+
+		if err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags).IgnoreAWS(); len(updatedTags) > 0 {
+		input := resourcegroupstaggingapi.TagResourcesInput{
+			ResourceARNList: []string{identifier},
+			Tags:            updatedTags.Map(),
+		}
+
+		output, err := conn.TagResources(ctx, &input)
+
+		if err == nil {
+			err = failedResourcesError(output.FailedResourcesMap)
+		}
+
+		if err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceGroupsTaggingAPIConn(ctx context.Context, meta any) (*resourcegroupstaggingapi.Client, error) {
+	v, ok := meta.(resourceGroupsTaggingAPIClienter)
+	if !ok {
+		return nil, fmt.Errorf("unexpected meta type: %T", meta)
+	}
+
+	return v.ResourceGroupsTaggingAPIClient(ctx), nil
+}
+
+// failedResourcesError returns an error for each resource that the Resource Groups Tagging API failed to tag or untag.
+// TagResources and UntagResources report per-resource failures in the response instead of returning an error.
+func failedResourcesError(apiObjects map[string]awstypes.FailureInfo) error {
+	arns := make([]string, 0, len(apiObjects))
+	for arn := range apiObjects {
+		arns = append(arns, arn)
+	}
+	slices.Sort(arns)
+
+	var errs []error
+	for _, arn := range arns {
+		apiObject := apiObjects[arn]
+		errs = append(errs, fmt.Errorf("%s: %s: %s", arn, apiObject.ErrorCode, aws.ToString(apiObject.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
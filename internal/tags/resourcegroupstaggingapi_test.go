@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+const testResourceARN = "arn:aws:codebuild:us-west-2:123456789012:report-group/example" //lintignore:AWSAT003,AWSAT005
+
+// mockResourceGroupsTaggingAPI returns canned Resource Groups Tagging API responses, in order, and records the operations called.
+type mockResourceGroupsTaggingAPI struct {
+	operations []string
+	responses  []string
+}
+
+func (m *mockResourceGroupsTaggingAPI) Do(request *http.Request) (*http.Response, error) {
+	_, operation, _ := strings.Cut(request.Header.Get("X-Amz-Target"), ".")
+	m.operations = append(m.operations, operation)
+
+	if len(m.responses) == 0 {
+		return nil, fmt.Errorf("unexpected %s request", operation)
+	}
+
+	body := m.responses[0]
+	m.responses = m.responses[1:]
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/x-amz-json-1.1"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    request,
+	}, nil
+}
+
+func (m *mockResourceGroupsTaggingAPI) ResourceGroupsTaggingAPIClient(context.Context) *resourcegroupstaggingapi.Client {
+	return resourcegroupstaggingapi.New(resourcegroupstaggingapi.Options{
+		Credentials:      aws.AnonymousCredentials{},
+		HTTPClient:       m,
+		Region:           "us-west-2", //lintignore:AWSAT003
+		RetryMaxAttempts: 1,
+	})
+}
+
+func TestResourceGroupsTaggingAPIListTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		responses      []string
+		wantOperations []string
+		wantTags       map[string]string
+		wantErr        bool
+	}{
+		"untagged": {
+			responses: []string{
+				`{"ResourceTagMappingList": []}`,
+			},
+			wantOperations: []string{"GetResources"},
+			wantTags:       map[string]string{},
+		},
+		"tagged": {
+			responses: []string{
+				fmt.Sprintf(`{"ResourceTagMappingList": [{"ResourceARN": %q, "Tags": [{"Key": "key1", "Value": "value1"}, {"Key": "key2", "Value": ""}]}]}`, testResourceARN),
+			},
+			wantOperations: []string{"GetResources"},
+			wantTags: map[string]string{
+				"key1": "value1",
+				"key2": "",
+			},
+		},
+		"multiple pages": {
+			responses: []string{
+				`{"PaginationToken": "page2", "ResourceTagMappingList": []}`,
+				fmt.Sprintf(`{"ResourceTagMappingList": [{"ResourceARN": %q, "Tags": [{"Key": "key1", "Value": "value1"}]}]}`, testResourceARN),
+			},
+			wantOperations: []string{"GetResources", "GetResources"},
+			wantTags: map[string]string{
+				"key1": "value1",
+			},
+		},
+		"other resource": {
+			responses: []string{
+				`{"ResourceTagMappingList": [{"ResourceARN": "arn:aws:codebuild:us-west-2:123456789012:report-group/other", "Tags": [{"Key": "key1", "Value": "value1"}]}]}`, //lintignore:AWSAT003,AWSAT005
+			},
+			wantOperations: []string{"GetResources"},
+			wantTags:       map[string]string{},
+		},
+		"error on second page": {
+			responses: []string{
+				`{"PaginationToken": "page2", "ResourceTagMappingList": []}`,
+			},
+			wantOperations: []string{"GetResources", "GetResources"},
+			wantErr:        true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewContext(context.Background(), nil, nil)
+			meta := &mockResourceGroupsTaggingAPI{responses: testCase.responses}
+
+			err := ResourceGroupsTaggingAPI{}.ListTags(ctx, meta, testResourceARN)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("ListTags() err %t, want %t: %v", got, want, err)
+			}
+
+			if diff := cmp.Diff(meta.operations, testCase.wantOperations); diff != "" {
+				t.Errorf("unexpected operations difference: %s", diff)
+			}
+
+			if err != nil {
+				return
+			}
+
+			inContext, _ := FromContext(ctx)
+			if diff := cmp.Diff(inContext.TagsOut.UnwrapOrDefault().Map(), testCase.wantTags); diff != "" {
+				t.Errorf("unexpected tags difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceGroupsTaggingAPIUpdateTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oldTags        map[string]string
+		newTags        map[string]string
+		responses      []string
+		wantOperations []string
+		wantErr        string
+	}{
+		"no changes": {
+			oldTags: map[string]string{"key1": "value1"},
+			newTags: map[string]string{"key1": "value1"},
+		},
+		"add": {
+			newTags: map[string]string{"key1": "value1"},
+			responses: []string{
+				`{"FailedResourcesMap": {}}`,
+			},
+			wantOperations: []string{"TagResources"},
+		},
+		"add, update and remove": {
+			oldTags: map[string]string{"key1": "value1", "key2": "value2"},
+			newTags: map[string]string{"key2": "value2updated", "key3": "value3"},
+			responses: []string{
+				`{"FailedResourcesMap": {}}`,
+				`{"FailedResourcesMap": {}}`,
+			},
+			wantOperations: []string{"UntagResources", "TagResources"},
+		},
+		"ignores AWS tags": {
+			oldTags: map[string]string{"aws:cloudformation:stack-name": "example"},
+		},
+		"untag failure": {
+			oldTags: map[string]string{"key1": "value1"},
+			newTags: map[string]string{"key2": "value2"},
+			responses: []string{
+				fmt.Sprintf(`{"FailedResourcesMap": {%q: {"ErrorCode": "InvalidParameterException", "ErrorMessage": "invalid", "StatusCode": 400}}}`, testResourceARN),
+			},
+			wantOperations: []string{"UntagResources"},
+			wantErr:        fmt.Sprintf("untagging resource (%[1]s): %[1]s: InvalidParameterException: invalid", testResourceARN),
+		},
+		"tag failure": {
+			newTags: map[string]string{"key1": "value1"},
+			responses: []string{
+				fmt.Sprintf(`{"FailedResourcesMap": {%q: {"ErrorCode": "InternalServiceException", "ErrorMessage": "internal", "StatusCode": 500}}}`, testResourceARN),
+			},
+			wantOperations: []string{"TagResources"},
+			wantErr:        fmt.Sprintf("tagging resource (%[1]s): %[1]s: InternalServiceException: internal", testResourceARN),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			meta := &mockResourceGroupsTaggingAPI{responses: slices.Clone(testCase.responses)}
+
+			err := ResourceGroupsTaggingAPI{}.UpdateTags(ctx, meta, testResourceARN, testCase.oldTags, testCase.newTags)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				if got, want := err.Error(), testCase.wantErr; got != want {
+					t.Errorf("error = %q, want %q", got, want)
+				}
+			}
+
+			if diff := cmp.Diff(meta.operations, testCase.wantOperations); diff != "" {
+				t.Errorf("unexpected operations difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFailedResourcesError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiObjects map[string]awstypes.FailureInfo
+		want       string
+	}{
+		"nil": {},
+		"empty": {
+			apiObjects: map[string]awstypes.FailureInfo{},
+		},
+		"failures": {
+			apiObjects: map[string]awstypes.FailureInfo{
+				"arn:aws:sqs:us-west-2:123456789012:b": {
+					ErrorCode:    awstypes.ErrorCodeInternalServiceException,
+					ErrorMessage: aws.String("internal"),
+				},
+				"arn:aws:sqs:us-west-2:123456789012:a": {
+					ErrorCode:    awstypes.ErrorCodeInvalidParameterException,
+					ErrorMessage: aws.String("invalid"),
+				},
+			},
+			want: "arn:aws:sqs:us-west-2:123456789012:a: InvalidParameterException: invalid\narn:aws:sqs:us-west-2:123456789012:b: InternalServiceException: internal",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := failedResourcesError(testCase.apiObjects)
+
+			if testCase.want == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			if got, want := err.Error(), testCase.want; got != want {
+				t.Errorf("error = %q, want %q", got, want)
+			}
+		})
+	}
+}
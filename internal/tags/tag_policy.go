@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// Environment variable specifying the severity with which tag policy violations are reported
+	//
+	// Setting this environment variable enables tag policy compliance checking when the
+	// provider configuration does not contain a `tag_policy_compliance` block.
+	TagPolicyComplianceEnvVar = "TF_AWS_TAG_POLICY_COMPLIANCE"
+)
+
+// TagPolicySeverity is the severity with which tag policy violations are reported.
+type TagPolicySeverity string
+
+const (
+	TagPolicySeverityError   TagPolicySeverity = "error"
+	TagPolicySeverityWarning TagPolicySeverity = "warning"
+)
+
+func (TagPolicySeverity) Values() []TagPolicySeverity {
+	return []TagPolicySeverity{
+		TagPolicySeverityError,
+		TagPolicySeverityWarning,
+	}
+}
+
+// TagPolicyConfig contains options for checking resource tags against an AWS Organizations tag policy.
+type TagPolicyConfig struct {
+	// PolicyFile is the path to a local file containing the effective tag policy.
+	// If empty, the effective tag policy is read from AWS Organizations.
+	PolicyFile string
+	Severity   TagPolicySeverity
+}
+
+// TagPolicy is a parsed effective AWS Organizations tag policy.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html.
+type TagPolicy struct {
+	// rules is keyed by the lowercased tag key.
+	rules map[string]tagPolicyRule
+}
+
+type tagPolicyRule struct {
+	key         string
+	values      []string
+	enforcedFor []string
+}
+
+type tagPolicyDocument struct {
+	Tags map[string]tagPolicyDocumentTag `json:"tags"`
+}
+
+type tagPolicyDocumentTag struct {
+	TagKey      *tagPolicyDocumentValue[string]   `json:"tag_key"`
+	TagValue    *tagPolicyDocumentValue[[]string] `json:"tag_value"`
+	EnforcedFor *tagPolicyDocumentValue[[]string] `json:"enforced_for"`
+}
+
+// tagPolicyDocumentValue is a tag policy value.
+// Effective policies, as returned by DescribeEffectivePolicy, contain plain values, e.g. `"tag_key": "CostCenter"`.
+// Policies as written contain value-setting operators, e.g. `"tag_key": {"@@assign": "CostCenter"}`; only `@@assign` is supported.
+type tagPolicyDocumentValue[T any] struct {
+	value T
+}
+
+func (v *tagPolicyDocumentValue[T]) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &v.value); err == nil {
+		return nil
+	}
+
+	var assign struct {
+		Assign *T `json:"@@assign"`
+	}
+	if err := json.Unmarshal(b, &assign); err != nil {
+		return err
+	}
+	if assign.Assign == nil {
+		return fmt.Errorf("unsupported tag policy value: %s", b)
+	}
+	v.value = *assign.Assign
+
+	return nil
+}
+
+// ParseTagPolicy parses an effective tag policy document.
+func ParseTagPolicy(content string) (*TagPolicy, error) {
+	var doc tagPolicyDocument
+
+	if err := json.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, fmt.Errorf("parsing tag policy: %w", err)
+	}
+
+	policy := &TagPolicy{
+		rules: make(map[string]tagPolicyRule, len(doc.Tags)),
+	}
+
+	for k, v := range doc.Tags {
+		rule := tagPolicyRule{}
+
+		if v.TagKey != nil {
+			rule.key = v.TagKey.value
+		}
+		if v.TagValue != nil {
+			rule.values = v.TagValue.value
+		}
+		if v.EnforcedFor != nil {
+			rule.enforcedFor = v.EnforcedFor.value
+		}
+
+		policy.rules[strings.ToLower(k)] = rule
+	}
+
+	return policy, nil
+}
+
+// Check returns a description of each of the tags that does not comply with the tag policy.
+// Tags whose keys are not governed by the tag policy are compliant.
+// If the policy enforces a tag for some resource types, AWS rejects non-compliant tagging of those types, and the description says so.
+func (p *TagPolicy) Check(tags KeyValueTags) []string {
+	if p == nil {
+		return nil
+	}
+
+	var violations []string
+
+	for _, k := range tags.IgnoreAWS().Keys() {
+		rule, ok := p.rules[strings.ToLower(k)]
+		if !ok {
+			continue
+		}
+
+		if rule.key != "" && k != rule.key {
+			violations = append(violations, fmt.Sprintf("tag key %q does not match the capitalization %q required by tag policy", k, rule.key)+rule.enforcement())
+		}
+
+		if len(rule.values) > 0 {
+			value := tags.KeyTagData(k).ValueString()
+			if !slices.ContainsFunc(rule.values, func(pattern string) bool { return tagPolicyValueMatches(pattern, value) }) {
+				violations = append(violations, fmt.Sprintf("tag %q value %q is not one of the values allowed by tag policy: %s", k, value, strings.Join(rule.values, ", "))+rule.enforcement())
+			}
+		}
+	}
+
+	slices.Sort(violations)
+
+	return violations
+}
+
+// enforcement describes the resource types for which the rule is enforced.
+func (r tagPolicyRule) enforcement() string {
+	if len(r.enforcedFor) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(" (enforced for %s)", strings.Join(r.enforcedFor, ", "))
+}
+
+// tagPolicyValueMatches returns whether value matches a tag policy value pattern.
+// A trailing asterisk matches any sequence of characters.
+func tagPolicyValueMatches(pattern, value string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(value, prefix)
+	}
+
+	return pattern == value
+}
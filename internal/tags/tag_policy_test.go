@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testTagPolicyContent = `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {
+        "@@assign": "CostCenter"
+      },
+      "tag_value": {
+        "@@assign": ["100", "200", "300*"]
+      },
+      "enforced_for": {
+        "@@assign": ["secretsmanager:*"]
+      }
+    },
+    "project": {
+      "tag_key": {
+        "@@assign": "Project"
+      }
+    }
+  }
+}`
+
+// testEffectiveTagPolicyContent is an effective tag policy as returned by the AWS Organizations DescribeEffectivePolicy API.
+const testEffectiveTagPolicyContent = `{
+  "tags": {
+    "costcenter": {
+      "tag_value": [
+        "100",
+        "200",
+        "300*"
+      ],
+      "tag_key": "CostCenter",
+      "enforced_for": [
+        "secretsmanager:*",
+        "ec2:instance"
+      ]
+    },
+    "project": {
+      "tag_key": "Project"
+    }
+  }
+}`
+
+func TestParseTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content     string
+		expectError bool
+	}{
+		"valid": {
+			content: testTagPolicyContent,
+		},
+		"valid effective policy": {
+			content: testEffectiveTagPolicyContent,
+		},
+		"empty": {
+			content: `{}`,
+		},
+		"invalid JSON": {
+			content:     `{"tags":`,
+			expectError: true,
+		},
+		"invalid tag value": {
+			content:     `{"tags":{"costcenter":{"tag_value":{"@@assign":"100"}}}}`,
+			expectError: true,
+		},
+		"invalid effective tag value": {
+			content:     `{"tags":{"costcenter":{"tag_key":"CostCenter","tag_value":"100"}}}`,
+			expectError: true,
+		},
+		"unsupported operator": {
+			content:     `{"tags":{"costcenter":{"tag_value":{"@@append":["100"]}}}}`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseTagPolicy(testCase.content)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ParseTagPolicy() err %t, want %t: %v", got, want, err)
+			}
+		})
+	}
+}
+
+func TestTagPolicyCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	policy, err := ParseTagPolicy(testTagPolicyContent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	effectivePolicy, err := ParseTagPolicy(testEffectiveTagPolicyContent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		policy *TagPolicy
+		tags   KeyValueTags
+		want   []string
+	}{
+		"nil policy": {
+			tags: New(ctx, map[string]string{
+				"costcenter": "999",
+			}),
+		},
+		"no tags": {
+			policy: policy,
+			tags:   New(ctx, map[string]string{}),
+		},
+		"compliant": {
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "100",
+				"Project":    "test",
+				"Other":      "any",
+			}),
+		},
+		"wildcard value": {
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "3001",
+			}),
+		},
+		"key capitalization": {
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"costcenter": "200",
+				"PROJECT":    "test",
+			}),
+			want: []string{
+				`tag key "PROJECT" does not match the capitalization "Project" required by tag policy`,
+				`tag key "costcenter" does not match the capitalization "CostCenter" required by tag policy (enforced for secretsmanager:*)`,
+			},
+		},
+		"value not allowed": {
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "400",
+			}),
+			want: []string{
+				`tag "CostCenter" value "400" is not one of the values allowed by tag policy: 100, 200, 300* (enforced for secretsmanager:*)`,
+			},
+		},
+		"effective policy compliant": {
+			policy: effectivePolicy,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "3001",
+				"Project":    "test",
+			}),
+		},
+		"effective policy key capitalization": {
+			policy: effectivePolicy,
+			tags: New(ctx, map[string]string{
+				"project": "test",
+			}),
+			want: []string{
+				`tag key "project" does not match the capitalization "Project" required by tag policy`,
+			},
+		},
+		"effective policy value not allowed": {
+			policy: effectivePolicy,
+			tags: New(ctx, map[string]string{
+				"CostCenter": "400",
+			}),
+			want: []string{
+				`tag "CostCenter" value "400" is not one of the values allowed by tag policy: 100, 200, 300* (enforced for secretsmanager:*, ec2:instance)`,
+			},
+		},
+		"system tags": {
+			policy: policy,
+			tags: New(ctx, map[string]string{
+				"aws:costcenter": "400",
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policy.Check(testCase.tags)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -37,3 +37,22 @@ var TagsSchemaForceNew = sync.OnceValue(func() *schema.Schema {
 		Elem:     &schema.Schema{Type: schema.TypeString},
 	}
 })
+
+// SkipDefaultTagsSchema returns the schema to use for a resource's `skip_default_tags` attribute.
+var SkipDefaultTagsSchema = sync.OnceValue(func() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether to exclude the provider's default_tags from this resource.",
+	}
+})
+
+// SkipDefaultTagsSchemaForceNew returns the schema to use for a resource's `skip_default_tags` attribute where changes recreate the resource.
+var SkipDefaultTagsSchemaForceNew = sync.OnceValue(func() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Whether to exclude the provider's default_tags from this resource.",
+	}
+})
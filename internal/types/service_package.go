@@ -16,6 +16,15 @@ import (
 type ServicePackageResourceTags struct {
 	IdentifierAttribute string // The attribute for the identifier for UpdateTags etc.
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
+	TaggingAPI          bool   // Use the Resource Groups Tagging API instead of the service package's ListTags and UpdateTags
+}
+
+// ServicePackageResourceARNLookup represents resource-level lookup-by-ARN information.
+// It is used to find the resource type and existence of resources identified only by their Amazon Resource Name (ARN).
+type ServicePackageResourceARNLookup struct {
+	Service      string                                                // The ARN service namespace, e.g. "dynamodb"
+	ResourceType string                                                // The ARN resource type, e.g. "table"; empty if the ARN's resource has no type
+	Find         func(ctx context.Context, meta any, arn string) error // Returns a NotFound error if the resource does not exist
 }
 
 // ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
@@ -38,10 +47,11 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory   func(context.Context) (resource.ResourceWithConfigure, error)
+	TypeName  string
+	Name      string
+	Tags      *ServicePackageResourceTags
+	ARNLookup *ServicePackageResourceARNLookup
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -56,8 +66,9 @@ type ServicePackageSDKDataSource struct {
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory   func() *schema.Resource
+	TypeName  string
+	Name      string
+	Tags      *ServicePackageResourceTags
+	ARNLookup *ServicePackageResourceARNLookup
 }